@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -10,30 +11,46 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/startup-job-board/backend/internal/application/port"
 	adminusecase "github.com/startup-job-board/backend/internal/application/usecase/admin"
 	authusecase "github.com/startup-job-board/backend/internal/application/usecase/auth"
+	backgroundjobusecase "github.com/startup-job-board/backend/internal/application/usecase/backgroundjob"
 	billingusecase "github.com/startup-job-board/backend/internal/application/usecase/billing"
 	contactusecase "github.com/startup-job-board/backend/internal/application/usecase/contact"
 	fileusecase "github.com/startup-job-board/backend/internal/application/usecase/file"
+	followusecase "github.com/startup-job-board/backend/internal/application/usecase/follow"
 	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	outboxusecase "github.com/startup-job-board/backend/internal/application/usecase/outbox"
 	startupusecase "github.com/startup-job-board/backend/internal/application/usecase/startup"
 	teamusecase "github.com/startup-job-board/backend/internal/application/usecase/team"
+	webhookusecase "github.com/startup-job-board/backend/internal/application/usecase/webhook"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/internal/infrastructure/auth"
 	oauthinfra "github.com/startup-job-board/backend/internal/infrastructure/auth/oauth"
+	"github.com/startup-job-board/backend/internal/infrastructure/cache"
 	"github.com/startup-job-board/backend/internal/infrastructure/config"
 	"github.com/startup-job-board/backend/internal/infrastructure/email"
+	"github.com/startup-job-board/backend/internal/infrastructure/eventbus"
+	"github.com/startup-job-board/backend/internal/infrastructure/health"
+	"github.com/startup-job-board/backend/internal/infrastructure/imaging"
+	"github.com/startup-job-board/backend/internal/infrastructure/metrics"
 	"github.com/startup-job-board/backend/internal/infrastructure/monitoring"
 	"github.com/startup-job-board/backend/internal/infrastructure/payment"
-	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/migration"
 	"github.com/startup-job-board/backend/internal/infrastructure/persistence/postgres"
 	"github.com/startup-job-board/backend/internal/infrastructure/persistence/seed"
+	"github.com/startup-job-board/backend/internal/infrastructure/ratelimit"
+	"github.com/startup-job-board/backend/internal/infrastructure/scheduler"
 	"github.com/startup-job-board/backend/internal/infrastructure/storage"
+	"github.com/startup-job-board/backend/internal/infrastructure/verification"
+	"github.com/startup-job-board/backend/internal/infrastructure/webhook"
 	"github.com/startup-job-board/backend/internal/presentation/http/handler"
 	"github.com/startup-job-board/backend/internal/presentation/http/router"
 	"github.com/startup-job-board/backend/internal/presentation/http/validator"
 	"github.com/startup-job-board/backend/pkg/logger"
-	"gorm.io/gorm"
+	"github.com/startup-job-board/backend/pkg/ssrf"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 )
 
 func main() {
@@ -42,12 +59,16 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	logger := logger.NewLogger()
+	logger := logger.New(logger.Options{Level: cfg.Log.Level, Format: cfg.Log.Format})
+	fatal := func(msg string, args ...interface{}) {
+		logger.Error(msg, args...)
+		os.Exit(1)
+	}
 
 	// config.Load() already loaded .env; New Relic reads NEW_RELIC_* from the environment.
 	nrApp, err := monitoring.NewApplication()
 	if err != nil {
-		log.Fatalf("Failed to initialize New Relic: %v", err)
+		fatal("Failed to initialize New Relic: %v", err)
 	}
 	defer monitoring.Shutdown(nrApp)
 	if nrApp != nil {
@@ -56,13 +77,42 @@ func main() {
 		logger.Warn("New Relic enabled but NEW_RELIC_LICENSE_KEY is empty; skipping APM")
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: cfg.Tracing.ServiceName,
+		Exporter:    cfg.Tracing.Exporter,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		fatal("Failed to initialize tracing: %v", err)
+	}
+
 	db, err := config.NewDatabase(cfg.Database)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("Failed to connect to database: %v", err)
+	}
+	// Bind values are left out of query spans; they can hold emails and hashes.
+	if err := db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics(), gormtracing.WithoutQueryVariables())); err != nil {
+		fatal("Failed to instrument database: %v", err)
 	}
 
-	if err := autoMigrate(db); err != nil {
-		log.Fatalf("Failed to run migrations: %v", err)
+	sqlDB, err := db.DB()
+	if err != nil {
+		fatal("Failed to access database pool: %v", err)
+	}
+	migrator, err := migration.NewMigrator(sqlDB, migration.Files, logger)
+	if err != nil {
+		fatal("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), migrator, os.Args[2:], os.Stdout); err != nil {
+			fatal("Migrate failed: %v", err)
+		}
+		return
+	}
+	if cfg.Database.MigrateOnStart {
+		if _, err := migrator.Up(context.Background()); err != nil {
+			fatal("Failed to run migrations: %v", err)
+		}
 	}
 
 	userRepo := postgres.NewUserRepository(db)
@@ -75,23 +125,91 @@ func main() {
 	teamInvitationRepo := postgres.NewTeamInvitationRepository(db)
 	oauthAccountRepo := postgres.NewOAuthAccountRepository(db)
 	oauthLoginCodeRepo := postgres.NewOAuthLoginCodeRepository(db)
+	emailVerificationRepo := postgres.NewEmailVerificationTokenRepository(db)
+	passwordResetRepo := postgres.NewPasswordResetTokenRepository(db)
 	fileRepo := postgres.NewFileRepository(db)
+	uploadIntentRepo := postgres.NewUploadIntentRepository(db)
+	fileRefRepo := postgres.NewFileReferenceRepository(db)
 	contactRepo := postgres.NewContactRepository(db)
+	startupVerificationRepo := postgres.NewStartupVerificationRepository(db)
+	followRepo := postgres.NewStartupFollowRepository(db)
+	followNotificationRepo := postgres.NewFollowNotificationRepository(db)
+	apiTokenRepo := postgres.NewStartupAPITokenRepository(db)
+	webhookEndpointRepo := postgres.NewWebhookEndpointRepository(db)
+	webhookDeliveryRepo := postgres.NewWebhookDeliveryRepository(db)
+	claimRepo := postgres.NewStartupClaimRepository(db)
+	slugHistoryRepo := postgres.NewSlugHistoryRepository(db)
+	outboxRepo := postgres.NewOutboxRepository(db)
+	backgroundJobRepo := postgres.NewBackgroundJobRepository(db)
+	txManager := postgres.NewTxManager(db)
 
 	if err := seed.SystemRoles(context.Background(), roleRepo); err != nil {
-		log.Fatalf("Failed to seed system roles: %v", err)
+		fatal("Failed to seed system roles: %v", err)
 	}
 
 	jwtService := auth.NewJWTService(cfg.JWT)
+	actionTokenService := auth.NewActionTokenService(cfg.JobReminders.ActionSecret)
 	tokenGen := auth.NewTokenGenerator()
 	storageService, err := storage.NewStorageService(cfg)
+	if err != nil && !isProduction(cfg.Environment) {
+		logger.Warn("Failed to initialize %s storage: %v. Falling back to local storage in %s.", cfg.Storage.Type, err, cfg.Storage.Local.Dir)
+		storageService, err = storage.NewLocalStorage(cfg.Storage.Local, cfg.Storage.SigningSecret, cfg.Storage.SignedURLTTL)
+	}
 	if err != nil {
 		logger.Warn("Failed to initialize storage: %v. File uploads will be disabled.", err)
 		storageService = nil
 	}
-	emailService := email.NewResendEmailService(cfg.Email)
+	// Only object stores can issue presigned uploads; leave the interface nil
+	// otherwise so the use cases can report it.
+	var directStorage port.DirectUploadStorage
+	if direct, ok := storageService.(port.DirectUploadStorage); ok {
+		directStorage = direct
+	}
+	var localFiles http.Handler
+	if local, ok := storageService.(*storage.LocalStorage); ok {
+		localFiles = local.Handler()
+	}
+	// Metrics are always recorded; /metrics is only routed when enabled.
+	appMetrics := metrics.NewPrometheus()
+	appMetrics.WatchDB(sqlDB)
+	emailTemplates := email.BuiltinTemplates()
+	if cfg.Email.TemplatesDir != "" {
+		emailTemplates = os.DirFS(cfg.Email.TemplatesDir)
+	}
+	emailRenderer, err := email.NewRenderer(emailTemplates, cfg.Email.DefaultLocale)
+	if err != nil {
+		fatal("Failed to load email templates: %v", err)
+	}
+	emailTransport, err := email.NewTransport(cfg.Email)
+	if err != nil {
+		fatal("Failed to initialize email transport: %v", err)
+	}
+	emailService := email.NewInstrumentedEmailService(email.NewService(emailRenderer, emailTransport, cfg.Email.From), appMetrics)
 	authService := service.NewAuthorizationService(userRepo, teamMemberRepo, roleRepo, startupRepo, memberRepo)
+	slugService := service.NewSlugService(startupRepo, teamRepo, slugHistoryRepo)
+	emailVerificationPolicy := service.NewEmailVerificationPolicy(userRepo, cfg.Auth.RequireVerifiedEmail)
 	stripeClient := payment.NewStripeClient(cfg.Stripe)
+	domainVerifier := verification.NewDomainVerifier(net.DefaultResolver, ssrf.NewPublicHTTPClient(10*time.Second))
+	webhookSender := webhook.NewHTTPSender(ssrf.NewPublicHTTPClient(cfg.Webhooks.Timeout))
+	eventRecorder := outboxusecase.NewRecordEventUseCase(outboxRepo)
+
+	// Subscribers of the domain events use cases record in the outbox.
+	eventBus := eventbus.NewBus()
+	webhookPublisher := webhookusecase.NewPublishEventUseCase(webhookEndpointRepo, webhookDeliveryRepo, startupRepo, txManager)
+	eventBus.Subscribe("webhooks", webhookPublisher.HandleEvent, webhookPublisher.EventTypes()...)
+	relayEventsUC := outboxusecase.NewRelayEventsUseCase(outboxRepo, eventBus, cfg.Outbox.MaxAttempts, logger)
+	pruneEventsUC := outboxusecase.NewPruneEventsUseCase(outboxRepo, cfg.Outbox.Retention, logger)
+
+	// Background jobs are enqueued with the change that needs them and run
+	// by the handler registered for their kind.
+	jobQueue := backgroundjobusecase.NewEnqueueJobUseCase(backgroundJobRepo, cfg.JobQueue.MaxAttempts)
+	jobHandlers := backgroundjobusecase.NewHandlers()
+	backgroundjobusecase.Register(jobHandlers, teamusecase.NewSendInvitationEmailUseCase(teamInvitationRepo, teamRepo, emailService, cfg.AppURL).Execute)
+	backgroundjobusecase.Register(jobHandlers, authusecase.NewSendVerificationEmailUseCase(userRepo, emailVerificationRepo, emailService, cfg.AppURL, cfg.Auth.VerificationTTL).Execute)
+	backgroundjobusecase.Register(jobHandlers, authusecase.NewSendPasswordResetEmailUseCase(userRepo, passwordResetRepo, emailService, cfg.AppURL, cfg.Auth.PasswordResetTTL, cfg.Auth.PasswordResetCooldown).Execute)
+	backgroundjobusecase.Register(jobHandlers, authusecase.NewSendPasswordChangedEmailUseCase(userRepo, emailService, cfg.AppURL).Execute)
+	runJobsUC := backgroundjobusecase.NewRunJobsUseCase(backgroundJobRepo, jobHandlers, cfg.JobQueue.Concurrency, cfg.JobQueue.Timeout, logger)
+	pruneJobsUC := backgroundjobusecase.NewPruneJobsUseCase(backgroundJobRepo, cfg.JobQueue.Retention, logger)
 
 	googleRedirect := cfg.OAuth.RedirectBaseURL + "/google/callback"
 	oauthRegistry := oauthinfra.NewRegistry(
@@ -100,7 +218,15 @@ func main() {
 		oauthinfra.NewStubProvider("github"),
 	)
 
-	registerUC := authusecase.NewRegisterUseCase(userRepo, jwtService, logger)
+	// Use cases always invalidate; the router only serves from the cache when enabled.
+	responseCache := cache.NewMemoryResponseCache(cfg.ResponseCache.TTL, cfg.ResponseCache.MaxEntries)
+	var publicResponseCache port.ResponseCache
+	if cfg.ResponseCache.Enabled {
+		publicResponseCache = responseCache
+	}
+	appMetrics.WatchResponseCache(responseCache)
+
+	registerUC := authusecase.NewRegisterUseCase(userRepo, jwtService, txManager, jobQueue, logger)
 	loginUC := authusecase.NewLoginUseCase(userRepo, jwtService, logger)
 	refreshTokenUC := authusecase.NewRefreshTokenUseCase(userRepo, jwtService, logger)
 	logoutUC := authusecase.NewLogoutUseCase(userRepo, logger)
@@ -109,52 +235,120 @@ func main() {
 	completeOAuthUC := authusecase.NewCompleteOAuthUseCase(oauthRegistry, userRepo, oauthAccountRepo, jwtService, logger)
 	issueLoginCodeUC := authusecase.NewIssueOAuthLoginCodeUseCase(oauthLoginCodeRepo)
 	exchangeLoginCodeUC := authusecase.NewExchangeOAuthLoginCodeUseCase(oauthLoginCodeRepo, userRepo, jwtService)
+	verifyEmailUC := authusecase.NewVerifyEmailUseCase(emailVerificationRepo, userRepo, txManager)
+	resendVerificationUC := authusecase.NewResendVerificationEmailUseCase(userRepo, emailVerificationRepo, jobQueue, cfg.Auth.VerificationCooldown)
+	forgotPasswordUC := authusecase.NewForgotPasswordUseCase(jobQueue, logger)
+	resetPasswordUC := authusecase.NewResetPasswordUseCase(passwordResetRepo, userRepo, txManager, jobQueue)
+	changePasswordUC := authusecase.NewChangePasswordUseCase(userRepo, jwtService, txManager, jobQueue)
 
-	createStartupUC := startupusecase.NewCreateStartupUseCase(startupRepo, teamRepo, teamMemberRepo, roleRepo, memberRepo, userRepo, tokenGen, logger)
-	updateStartupUC := startupusecase.NewUpdateStartupUseCase(startupRepo, authService, logger)
-	getStartupUC := startupusecase.NewGetStartupUseCase(startupRepo, logger)
+	createStartupUC := startupusecase.NewCreateStartupUseCase(startupRepo, teamRepo, teamMemberRepo, roleRepo, memberRepo, userRepo, apiTokenRepo, tokenGen, slugService, emailVerificationPolicy, responseCache, logger)
+	updateStartupUC := startupusecase.NewUpdateStartupUseCase(startupRepo, fileRepo, fileRefRepo, slugService, authService, responseCache, logger)
+	getStartupUC := startupusecase.NewGetStartupUseCase(startupRepo, slugService, logger)
 	listStartupsUC := startupusecase.NewListStartupsUseCase(startupRepo, logger)
+	startVerificationUC := startupusecase.NewStartDomainVerificationUseCase(startupRepo, startupVerificationRepo, userRepo, authService, emailService, logger)
+	getVerificationUC := startupusecase.NewGetDomainVerificationUseCase(startupRepo, startupVerificationRepo, authService)
+	checkVerificationUC := startupusecase.NewCheckDomainVerificationUseCase(startupRepo, startupVerificationRepo, domainVerifier, authService, responseCache, logger)
+	followStartupUC := followusecase.NewFollowStartupUseCase(followRepo, startupRepo)
+	unfollowStartupUC := followusecase.NewUnfollowStartupUseCase(followRepo)
+	listFollowsUC := followusecase.NewListFollowsUseCase(followRepo, startupRepo)
+	followerCountUC := followusecase.NewFollowerCountUseCase(followRepo, authService)
+	unsubscribeFollowsUC := followusecase.NewUnsubscribeFollowsUseCase(followRepo, actionTokenService)
+	sendFollowDigestsUC := followusecase.NewSendFollowDigestsUseCase(followNotificationRepo, jobRepo, startupRepo, userRepo, emailService, actionTokenService, cfg.AppURL, logger)
+	reverifyDomainsUC := startupusecase.NewReverifyDomainsUseCase(startupRepo, startupVerificationRepo, domainVerifier, cfg.DomainRecheck.After, responseCache, logger)
 
-	createJobUC := jobusecase.NewCreateJobUseCase(jobRepo, startupRepo, memberRepo, authService, logger)
-	updateJobUC := jobusecase.NewUpdateJobUseCase(jobRepo, startupRepo, authService, logger)
+	createJobUC := jobusecase.NewCreateJobUseCase(jobRepo, startupRepo, memberRepo, followNotificationRepo, authService, emailVerificationPolicy, txManager, eventRecorder, responseCache, logger)
+	updateJobUC := jobusecase.NewUpdateJobUseCase(jobRepo, startupRepo, authService, txManager, eventRecorder, responseCache, logger)
 	listJobsUC := jobusecase.NewListJobsUseCase(jobRepo, startupRepo, logger)
-	deleteJobUC := jobusecase.NewDeleteJobUseCase(jobRepo, authService, logger)
+	deleteJobUC := jobusecase.NewDeleteJobUseCase(jobRepo, authService, txManager, eventRecorder, responseCache, logger)
+	applyRelayUC := jobusecase.NewApplyRelayAddressUseCase(jobRepo, cfg.ApplyRelay.Domain, logger)
+	forwardApplicationUC := jobusecase.NewForwardApplicationUseCase(
+		jobRepo, emailService, cfg.ApplyRelay.Domain,
+		cfg.ApplyRelay.SenderLimit, cfg.ApplyRelay.SenderWindow, logger,
+	)
 
-	uploadFileUC := fileusecase.NewUploadFileUseCase(fileRepo, storageService, logger)
+	sendJobRemindersUC := jobusecase.NewSendJobRemindersUseCase(
+		jobRepo, startupRepo, userRepo, authService, emailService, actionTokenService,
+		jobusecase.JobReminderSettings{
+			ExpiryLead: cfg.JobReminders.ExpiryLead,
+			StaleAfter: cfg.JobReminders.StaleAfter,
+			LinkTTL:    cfg.JobReminders.LinkTTL,
+		},
+		cfg.AppURL, logger,
+	)
+	applyJobActionUC := jobusecase.NewApplyJobActionUseCase(jobRepo, actionTokenService, txManager, eventRecorder, responseCache, logger)
+
+	imageProcessor := imaging.NewProcessor()
+	uploadFileUC := fileusecase.NewUploadFileUseCase(fileRepo, storageService, imageProcessor, logger)
+	presignUploadUC := fileusecase.NewPresignUploadUseCase(uploadIntentRepo, directStorage, logger)
+	completeUploadUC := fileusecase.NewCompleteUploadUseCase(uploadIntentRepo, fileRepo, storageService, directStorage, imageProcessor, txManager, logger)
+	listFilesUC := fileusecase.NewListFilesUseCase(fileRepo, teamMemberRepo, storageService, authService)
+	deleteFileUC := fileusecase.NewDeleteFileUseCase(fileRepo, fileRefRepo, teamMemberRepo, storageService, authService, logger)
+	reportStrayObjectsUC := fileusecase.NewReportStrayObjectsUseCase(fileRepo, storageService, authService)
+	collectOrphanFilesUC := fileusecase.NewCollectOrphanFilesUseCase(fileRepo, fileRefRepo, uploadIntentRepo, storageService, cfg.FileGC.GracePeriod, logger)
 	createContactUC := contactusecase.NewCreateContactUseCase(contactRepo, logger)
 	createCheckoutUC := billingusecase.NewCreateCheckoutUseCase(stripeClient, jobRepo, startupRepo, userRepo, authService, cfg.Stripe, cfg.AppURL, logger)
-	handleWebhookUC := billingusecase.NewHandleWebhookUseCase(stripeClient, jobRepo, startupRepo, logger)
+	handleWebhookUC := billingusecase.NewHandleWebhookUseCase(stripeClient, jobRepo, startupRepo, txManager, eventRecorder, responseCache, appMetrics, logger)
 
-	createTeamUC := teamusecase.NewCreateTeamUseCase(teamRepo, teamMemberRepo, roleRepo, logger)
+	createTeamUC := teamusecase.NewCreateTeamUseCase(teamRepo, teamMemberRepo, roleRepo, slugService, logger)
 	listMyTeamsUC := teamusecase.NewListMyTeamsUseCase(teamRepo)
 	getTeamUC := teamusecase.NewGetTeamUseCase(teamRepo, authService)
-	updateTeamUC := teamusecase.NewUpdateTeamUseCase(teamRepo, authService)
+	updateTeamUC := teamusecase.NewUpdateTeamUseCase(teamRepo, slugService, authService, logger)
 	listMembersUC := teamusecase.NewListMembersUseCase(teamMemberRepo, userRepo, roleRepo, authService)
-	inviteMemberUC := teamusecase.NewInviteMemberUseCase(teamInvitationRepo, teamRepo, teamMemberRepo, userRepo, roleRepo, emailService, tokenGen, authService, cfg.AppURL, logger)
-	acceptInviteUC := teamusecase.NewAcceptInvitationUseCase(teamInvitationRepo, teamMemberRepo, userRepo)
+	inviteMemberUC := teamusecase.NewInviteMemberUseCase(teamInvitationRepo, teamMemberRepo, userRepo, roleRepo, tokenGen, authService, txManager, eventRecorder, jobQueue)
+	acceptInviteUC := teamusecase.NewAcceptInvitationUseCase(teamInvitationRepo, teamMemberRepo, userRepo, txManager, eventRecorder)
 	updateMemberUC := teamusecase.NewUpdateMemberUseCase(teamMemberRepo, roleRepo, authService)
 	removeMemberUC := teamusecase.NewRemoveMemberUseCase(teamMemberRepo, authService)
 	listRolesUC := teamusecase.NewListRolesUseCase(roleRepo, authService)
-	linkStartupUC := teamusecase.NewLinkStartupUseCase(startupRepo, authService)
-	unlinkStartupUC := teamusecase.NewUnlinkStartupUseCase(startupRepo, authService)
+	linkStartupUC := teamusecase.NewLinkStartupUseCase(startupRepo, authService, responseCache)
+	unlinkStartupUC := teamusecase.NewUnlinkStartupUseCase(startupRepo, authService, responseCache)
 	listTeamStartupsUC := teamusecase.NewListTeamStartupsUseCase(startupRepo, authService)
+	listAPITokensUC := teamusecase.NewListAPITokensUseCase(apiTokenRepo, startupRepo, authService)
+	createAPITokenUC := teamusecase.NewCreateAPITokenUseCase(apiTokenRepo, startupRepo, tokenGen, authService)
+	rotateAPITokenUC := teamusecase.NewRotateAPITokenUseCase(apiTokenRepo, startupRepo, tokenGen, authService)
+	revokeAPITokenUC := teamusecase.NewRevokeAPITokenUseCase(apiTokenRepo, startupRepo, authService)
+	listWebhooksUC := webhookusecase.NewListEndpointsUseCase(webhookEndpointRepo, authService)
+	createWebhookUC := webhookusecase.NewCreateEndpointUseCase(webhookEndpointRepo, authService)
+	updateWebhookUC := webhookusecase.NewUpdateEndpointUseCase(webhookEndpointRepo, authService)
+	deleteWebhookUC := webhookusecase.NewDeleteEndpointUseCase(webhookEndpointRepo, authService)
+	listWebhookDeliveriesUC := webhookusecase.NewListDeliveriesUseCase(webhookEndpointRepo, webhookDeliveryRepo, authService)
+	redeliverWebhookUC := webhookusecase.NewRedeliverUseCase(webhookEndpointRepo, webhookDeliveryRepo, authService)
+	deliverWebhooksUC := webhookusecase.NewDeliverWebhooksUseCase(webhookEndpointRepo, webhookDeliveryRepo, webhookSender, cfg.Webhooks.MaxAttempts, logger)
 
 	adminListUsersUC := adminusecase.NewListUsersUseCase(userRepo, authService)
 	adminUpdateUserUC := adminusecase.NewUpdateUserUseCase(userRepo, authService)
 	adminListTeamsUC := adminusecase.NewListTeamsUseCase(teamRepo, authService)
-	adminCreateStartupUC := adminusecase.NewCreateOrphanStartupUseCase(startupRepo, tokenGen, authService, logger)
-	adminLinkTeamUC := adminusecase.NewLinkStartupTeamUseCase(startupRepo, teamRepo, authService)
+	adminCreateStartupUC := adminusecase.NewCreateOrphanStartupUseCase(startupRepo, apiTokenRepo, tokenGen, slugService, authService, responseCache, logger)
+	adminLinkTeamUC := adminusecase.NewLinkStartupTeamUseCase(startupRepo, teamRepo, authService, responseCache)
+	listBackgroundJobsUC := backgroundjobusecase.NewListJobsUseCase(backgroundJobRepo, authService)
+	retryBackgroundJobUC := backgroundjobusecase.NewRetryJobUseCase(backgroundJobRepo, authService)
+	cancelBackgroundJobUC := backgroundjobusecase.NewCancelJobUseCase(backgroundJobRepo, authService)
+	submitClaimUC := teamusecase.NewSubmitClaimUseCase(claimRepo, startupRepo, userRepo, authService, emailService, logger)
+	verifyClaimUC := teamusecase.NewVerifyClaimUseCase(claimRepo, startupRepo, teamRepo, teamMemberRepo, roleRepo, slugService, domainVerifier, authService, txManager, responseCache, logger)
+	getClaimUC := teamusecase.NewGetClaimUseCase(claimRepo, startupRepo, authService)
+	listMyClaimsUC := teamusecase.NewListMyClaimsUseCase(claimRepo, startupRepo)
+	listClaimsUC := teamusecase.NewListClaimsUseCase(claimRepo, startupRepo, authService)
+	approveClaimUC := teamusecase.NewApproveClaimUseCase(claimRepo, startupRepo, teamRepo, teamMemberRepo, roleRepo, slugService, authService, txManager, responseCache, logger)
+	rejectClaimUC := teamusecase.NewRejectClaimUseCase(claimRepo, startupRepo, authService, logger)
 
 	v := validator.NewValidator()
-	secureCookies := cfg.Environment == "production" || cfg.Environment == "prod"
+	secureCookies := isProduction(cfg.Environment)
 	authHandler := handler.NewAuthHandler(
 		registerUC, loginUC, refreshTokenUC, logoutUC, getMeUC,
 		startOAuthUC, completeOAuthUC, issueLoginCodeUC, exchangeLoginCodeUC,
+		verifyEmailUC, resendVerificationUC, forgotPasswordUC, resetPasswordUC, changePasswordUC,
 		cfg.AppURL, secureCookies, v,
 	)
 	startupHandler := handler.NewStartupHandler(createStartupUC, updateStartupUC, getStartupUC, listStartupsUC, v)
-	jobHandler := handler.NewJobHandler(createJobUC, updateJobUC, listJobsUC, deleteJobUC, jobRepo, startupRepo, v)
-	fileHandler := handler.NewFileHandler(uploadFileUC)
+	jobHandler := handler.NewJobHandler(createJobUC, updateJobUC, listJobsUC, deleteJobUC, applyRelayUC, jobRepo, startupRepo, v)
+	inboundHandler := handler.NewInboundMailHandler(forwardApplicationUC, cfg.ApplyRelay.InboundSecret, v)
+	jobActionHandler := handler.NewJobActionHandler(applyJobActionUC, v)
+	verificationHandler := handler.NewDomainVerificationHandler(startVerificationUC, getVerificationUC, checkVerificationUC, v)
+	followHandler := handler.NewFollowHandler(followStartupUC, unfollowStartupUC, listFollowsUC, followerCountUC, unsubscribeFollowsUC, v)
+	apiTokenHandler := handler.NewAPITokenHandler(listAPITokensUC, createAPITokenUC, rotateAPITokenUC, revokeAPITokenUC, v)
+	webhookHandler := handler.NewWebhookHandler(listWebhooksUC, createWebhookUC, updateWebhookUC, deleteWebhookUC, listWebhookDeliveriesUC, redeliverWebhookUC, v)
+	claimHandler := handler.NewClaimHandler(submitClaimUC, verifyClaimUC, getClaimUC, listMyClaimsUC, listClaimsUC, approveClaimUC, rejectClaimUC, v)
+	fileHandler := handler.NewFileHandler(uploadFileUC, listFilesUC, deleteFileUC, reportStrayObjectsUC)
+	uploadHandler := handler.NewUploadHandler(presignUploadUC, completeUploadUC, v)
 	contactHandler := handler.NewContactHandler(createContactUC, v)
 	billingHandler := handler.NewBillingHandler(createCheckoutUC, handleWebhookUC, startupRepo, authService, v)
 	teamHandler := handler.NewTeamHandler(
@@ -163,68 +357,142 @@ func main() {
 		listRolesUC, linkStartupUC, unlinkStartupUC, listTeamStartupsUC, v,
 	)
 	adminHandler := handler.NewAdminHandler(adminListUsersUC, adminUpdateUserUC, adminListTeamsUC, adminCreateStartupUC, adminLinkTeamUC, v)
+	backgroundJobHandler := handler.NewBackgroundJobHandler(listBackgroundJobsUC, retryBackgroundJobUC, cancelBackgroundJobUC)
+	emailTemplateHandler := handler.NewEmailTemplateHandler(
+		adminusecase.NewListEmailTemplatesUseCase(emailRenderer, authService),
+		adminusecase.NewPreviewEmailUseCase(emailRenderer, authService), v,
+	)
+
+	// Billing and email are optional outside production, so there they only
+	// mark readiness degraded.
+	healthChecker := health.NewChecker(cfg.Health.CheckTimeout)
+	healthChecker.Add(health.Database(sqlDB))
+	healthChecker.Add(health.Migrations(migrator))
+	healthChecker.Add(health.Storage(storageService))
+	healthChecker.Add(health.Configured("stripe", isProduction(cfg.Environment), map[string]string{
+		"STRIPE_SECRET_KEY":     cfg.Stripe.SecretKey,
+		"STRIPE_WEBHOOK_SECRET": cfg.Stripe.WebhookSecret,
+	}))
+	// SMTP is checked when the transport is built; the dev transports need nothing.
+	emailSettings := map[string]string{}
+	if cfg.Email.Transport == "resend" {
+		emailSettings["RESEND_API_KEY"] = cfg.Email.ResendAPIKey
+	}
+	healthChecker.Add(health.Configured("email", isProduction(cfg.Environment), emailSettings))
+	healthHandler := handler.NewHealthHandler(healthChecker)
+
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	var rateLimitStore port.RateLimitStore
+	switch cfg.RateLimit.Store {
+	case "postgres":
+		pgStore := ratelimit.NewPostgresStore(db)
+		go scheduler.Every(bgCtx, 10*time.Minute, "rate-limit-cleanup", logger, pgStore.DeleteExpired)
+		rateLimitStore = pgStore
+	default:
+		rateLimitStore = ratelimit.NewMemoryStore(bgCtx)
+	}
 
+	var routeMetrics port.Metrics
+	if cfg.Metrics.Enabled {
+		routeMetrics = appMetrics
+	}
 	r := router.NewRouter(router.RouterDeps{
-		AuthHandler:    authHandler,
-		StartupHandler: startupHandler,
-		JobHandler:     jobHandler,
-		FileHandler:    fileHandler,
-		ContactHandler: contactHandler,
-		BillingHandler: billingHandler,
-		TeamHandler:    teamHandler,
-		AdminHandler:   adminHandler,
-		JWTService:     jwtService,
-		AuthService:    authService,
-		StartupRepo:    startupRepo,
-		AllowedOrigins: cfg.CORS.AllowedOrigins,
-		RateLimit:      cfg.RateLimit,
-		InternalKey:    cfg.InternalKey,
-		TrustedProxies: cfg.TrustedProxies,
-		NewRelicApp:    nrApp,
+		Context:              bgCtx,
+		AuthHandler:          authHandler,
+		StartupHandler:       startupHandler,
+		JobHandler:           jobHandler,
+		FileHandler:          fileHandler,
+		UploadHandler:        uploadHandler,
+		ContactHandler:       contactHandler,
+		BillingHandler:       billingHandler,
+		TeamHandler:          teamHandler,
+		AdminHandler:         adminHandler,
+		InboundHandler:       inboundHandler,
+		JobActionHandler:     jobActionHandler,
+		VerificationHandler:  verificationHandler,
+		FollowHandler:        followHandler,
+		APITokenHandler:      apiTokenHandler,
+		WebhookHandler:       webhookHandler,
+		HealthHandler:        healthHandler,
+		BackgroundJobHandler: backgroundJobHandler,
+		EmailTemplateHandler: emailTemplateHandler,
+		LocalFiles:           localFiles,
+		ClaimHandler:         claimHandler,
+		JWTService:           jwtService,
+		AuthService:          authService,
+		StartupRepo:          startupRepo,
+		APITokenRepo:         apiTokenRepo,
+		AllowedOrigins:       cfg.CORS.AllowedOrigins,
+		RateLimit:            cfg.RateLimit,
+		RateLimitStore:       rateLimitStore,
+		ResponseCache:        publicResponseCache,
+		InternalKey:          cfg.InternalKey,
+		TrustedProxies:       cfg.TrustedProxies,
+		NewRelicApp:          nrApp,
+		OpenAPI:              cfg.OpenAPI,
+		Metrics:              routeMetrics,
+		ServiceName:          cfg.Tracing.ServiceName,
+		MetricsHandler:       appMetrics.Handler(),
+		MetricsToken:         cfg.Metrics.Token,
+		Logger:               logger,
 	})
 
 	srv := &http.Server{Addr: ":" + cfg.Port, Handler: r}
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+			fatal("Failed to start server: %v", err)
 		}
 	}()
 
 	logger.Info("Server started on port %s", cfg.Port)
 
+	if cfg.JobReminders.Enabled {
+		go scheduler.Every(bgCtx, cfg.JobReminders.Interval, "job-reminders", logger, sendJobRemindersUC.Execute)
+	}
+	if cfg.DomainRecheck.Enabled {
+		go scheduler.Every(bgCtx, cfg.DomainRecheck.Interval, "domain-recheck", logger, reverifyDomainsUC.Execute)
+	}
+	go scheduler.Every(bgCtx, cfg.Outbox.Interval, "outbox-relay", logger, relayEventsUC.Execute)
+	go scheduler.Every(bgCtx, time.Hour, "outbox-prune", logger, pruneEventsUC.Execute)
+	go scheduler.Every(bgCtx, cfg.JobQueue.PollInterval, "background-jobs", logger, runJobsUC.Execute)
+	go scheduler.Every(bgCtx, time.Hour, "background-job-prune", logger, pruneJobsUC.Execute)
+	if cfg.Webhooks.Enabled {
+		go scheduler.Every(bgCtx, cfg.Webhooks.Interval, "webhook-delivery", logger, deliverWebhooksUC.Execute)
+	}
+	if cfg.FollowDigest.Enabled {
+		go scheduler.Every(bgCtx, cfg.FollowDigest.Interval, "follow-digest", logger, sendFollowDigestsUC.Execute)
+	}
+	if cfg.FileGC.Enabled {
+		go scheduler.Every(bgCtx, cfg.FileGC.Interval, "file-gc", logger, collectOrphanFilesUC.Execute)
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Shutting down server...")
+	healthChecker.SetShuttingDown()
+	if cfg.Health.ShutdownDelay > 0 {
+		time.Sleep(cfg.Health.ShutdownDelay)
+	}
+	stopBackground()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		fatal("Server forced to shutdown: %v", err)
+	}
+	if err := runJobsUC.Wait(ctx); err != nil {
+		logger.Warn("Background jobs still running at exit; they will be retried: %v", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Warn("Failed to flush traces: %v", err)
 	}
 	logger.Info("Server exited")
 }
 
-func autoMigrate(db *gorm.DB) error {
-	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS \"pgcrypto\"").Error; err != nil {
-		log.Printf("Warning: Could not create pgcrypto extension (might already exist): %v", err)
-	}
-
-	return db.AutoMigrate(
-		&gorm_model.User{},
-		&gorm_model.Startup{},
-		&gorm_model.StartupMember{},
-		&gorm_model.Invitation{},
-		&gorm_model.Job{},
-		&gorm_model.File{},
-		&gorm_model.Contact{},
-		&gorm_model.Team{},
-		&gorm_model.TeamMember{},
-		&gorm_model.Role{},
-		&gorm_model.RoleScope{},
-		&gorm_model.TeamInvitation{},
-		&gorm_model.OAuthAccount{},
-		&gorm_model.OAuthLoginCode{},
-	)
+func isProduction(environment string) bool {
+	return environment == "production" || environment == "prod"
 }
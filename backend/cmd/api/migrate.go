@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/migration"
+)
+
+const migrateUsage = `usage: api migrate <command>
+
+commands:
+  up             apply all pending migrations
+  down [n]       roll back the last n migrations (default 1)
+  to <version>   migrate up or down to version
+  status         list migrations and when they were applied`
+
+// runMigrate implements the migrate subcommand.
+func runMigrate(ctx context.Context, migrator *migration.Migrator, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		n, err := migrator.Up(ctx)
+		fmt.Fprintf(out, "applied %d migration(s)\n", n)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			parsed, err := strconv.Atoi(args[1])
+			if err != nil || parsed < 1 {
+				return fmt.Errorf("down: %q is not a positive number", args[1])
+			}
+			steps = parsed
+		}
+		n, err := migrator.Down(ctx, steps)
+		fmt.Fprintf(out, "rolled back %d migration(s)\n", n)
+		return err
+	case "to":
+		if len(args) < 2 {
+			return fmt.Errorf("to: missing version\n\n%s", migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("to: %q is not a version", args[1])
+		}
+		n, err := migrator.To(ctx, version)
+		fmt.Fprintf(out, "ran %d migration(s)\n", n)
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED\tNOTE")
+		for _, s := range statuses {
+			applied, note := "pending", ""
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			switch {
+			case s.Modified:
+				note = "file changed since applied"
+			case s.Unknown:
+				note = "not in this binary"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, applied, note)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q\n\n%s", args[0], migrateUsage)
+	}
+}
@@ -4,6 +4,7 @@ type RegisterInput struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=12"` // minimum 12 characters
 	Name     string `json:"name" validate:"required,min=2"`
+	Locale   string `json:"-"` // from Accept-Language
 }
 
 type LoginInput struct {
@@ -22,15 +23,34 @@ type AuthOutput struct {
 }
 
 type UserOutput struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
-	Role  string `json:"role"`
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Role          string `json:"role"`
+	EmailVerified bool   `json:"email_verified"`
 }
 
 type ExchangeOAuthCodeInput struct {
 	Code string `json:"code" validate:"required"`
 }
 
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ForgotPasswordInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordInput struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=12"`
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=12"`
+}
+
 
 
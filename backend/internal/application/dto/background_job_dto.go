@@ -0,0 +1,27 @@
+package dto
+
+import "encoding/json"
+
+type BackgroundJobOutput struct {
+	ID          string          `json:"id"`
+	Queue       string          `json:"queue"`
+	Kind        string          `json:"kind"`
+	Status      string          `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       string          `json:"run_at"`
+	UniqueKey   *string         `json:"unique_key"`
+	LastError   string          `json:"last_error,omitempty"`
+	StartedAt   *string         `json:"started_at"`
+	FinishedAt  *string         `json:"finished_at"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// BackgroundJobFilter narrows the admin job list; empty fields match any job.
+type BackgroundJobFilter struct {
+	Queue  string
+	Kind   string
+	Status string
+}
@@ -0,0 +1,20 @@
+package dto
+
+type EmailTemplateOutput struct {
+	Name    string   `json:"name"`
+	Locales []string `json:"locales"`
+	// SampleData is what previews render without data; it shows the keys a template uses.
+	SampleData interface{} `json:"sample_data"`
+}
+
+type PreviewEmailInput struct {
+	Locale string `json:"locale" validate:"omitempty,max=35"`
+	// Data replaces the sample data; keys match the template's fields.
+	Data map[string]interface{} `json:"data"`
+}
+
+type EmailPreviewOutput struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
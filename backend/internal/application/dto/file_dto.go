@@ -1,13 +1,46 @@
 package dto
 
 type FileOutput struct {
-	ID        string `json:"id"`
-	FileName  string `json:"file_name"`
-	FileSize  int64  `json:"file_size"`
-	MimeType  string `json:"mime_type"`
-	URL       string `json:"url"`
-	CreatedAt string `json:"created_at"`
+	ID        string              `json:"id"`
+	FileName  string              `json:"file_name"`
+	FileSize  int64               `json:"file_size"`
+	MimeType  string              `json:"mime_type"`
+	URL       string              `json:"url"`
+	Variants  []FileVariantOutput `json:"variants,omitempty"`
+	CreatedAt string              `json:"created_at"`
 }
 
+// FileVariantOutput is a resized rendition (64/128/256px bounding box) in
+// WebP or PNG; prefer these over FileOutput.URL for thumbnails and lists.
+type FileVariantOutput struct {
+	Size     int    `json:"size"`
+	Format   string `json:"format"`
+	MimeType string `json:"mime_type"`
+	URL      string `json:"url"`
+}
 
+type PresignUploadInput struct {
+	FileName    string `json:"file_name" validate:"required,max=255"`
+	ContentType string `json:"content_type" validate:"required,oneof=image/jpeg image/png image/gif image/webp application/pdf"`
+	Size        int64  `json:"size" validate:"required,min=1"`
+}
 
+// PresignUploadOutput describes a multipart form POST to storage: send every
+// entry of Fields, then the file itself as the last field, named "file".
+type PresignUploadOutput struct {
+	ID        string            `json:"id"`
+	URL       string            `json:"url"`
+	Method    string            `json:"method"`
+	Fields    map[string]string `json:"fields"`
+	MaxSize   int64             `json:"max_size"`
+	ExpiresAt string            `json:"expires_at"`
+}
+
+// StrayObjectsOutput lists stored objects that no file record accounts for.
+// Keys holds at most the first 1000; Count and TotalSize cover all of them.
+type StrayObjectsOutput struct {
+	Count     int      `json:"count"`
+	TotalSize int64    `json:"total_size"`
+	Keys      []string `json:"keys"`
+	Truncated bool     `json:"truncated"`
+}
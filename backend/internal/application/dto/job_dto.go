@@ -31,7 +31,7 @@ type UpdateJobInput struct {
 	Currency         *string `json:"currency" validate:"omitempty,len=3"`
 	ApplicationURL   *string `json:"application_url" validate:"omitempty,url"`
 	ApplicationEmail *string `json:"application_email" validate:"omitempty,email"`
-	Status           *string `json:"status" validate:"omitempty,oneof=active closed"`
+	Status           *string `json:"status" validate:"omitempty,oneof=active closed filled"`
 	ExpiresAt        *string `json:"expires_at" validate:"omitempty"`
 }
 
@@ -58,3 +58,31 @@ type JobOutput struct {
 	CreatedAt        string  `json:"created_at"`
 	UpdatedAt        string  `json:"updated_at"`
 }
+
+// InboundApplicationInput is the normalized payload from the inbound mail webhook.
+type InboundApplicationInput struct {
+	From    string   `json:"from" validate:"required"`
+	To      []string `json:"to" validate:"required,min=1"`
+	Subject string   `json:"subject" validate:"max=998"`
+	Text    string   `json:"text"`
+	HTML    string   `json:"html"`
+}
+
+type InboundApplicationOutput struct {
+	Forwarded bool   `json:"forwarded"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// JobActionOutput describes a one-click job action link and, once applied, its result.
+type JobActionOutput struct {
+	JobID     string  `json:"job_id"`
+	Title     string  `json:"title"`
+	Action    string  `json:"action"`
+	Status    string  `json:"status"`
+	ExpiresAt *string `json:"expires_at"`
+	Applied   bool    `json:"applied"`
+}
+
+type JobActionInput struct {
+	Token string `json:"token" validate:"required"`
+}
@@ -7,6 +7,7 @@ type InviteMemberInput struct {
 	Email     string `json:"email" validate:"required,email"`
 	Role      string `json:"role" validate:"required,oneof=admin member recruiter"`
 	Message   string `json:"message"`
+	Locale    string `json:"-"` // from Accept-Language
 }
 
 type InvitationOutput struct {
@@ -41,6 +41,8 @@ type StartupOutput struct {
 	Status          string  `json:"status"`
 	Plan            string  `json:"plan"`
 	PlanExpiresAt   *string `json:"plan_expires_at"`
+	Verified        bool    `json:"verified"`
+	VerifiedAt      *string `json:"verified_at"`
 	// APIToken is only set on create (plaintext shown once); never on list/get.
 	APIToken        string  `json:"api_token,omitempty"`
 	CreatedAt       string  `json:"created_at"`
@@ -49,3 +51,51 @@ type StartupOutput struct {
 
 
 
+
+type StartDomainVerificationInput struct {
+	Method string `json:"method" validate:"required,oneof=dns file email"`
+	// Email is required for the email method and must be on the website's domain.
+	Email string `json:"email" validate:"omitempty,email"`
+}
+
+type CheckDomainVerificationInput struct {
+	// Code is the value emailed for the email method; ignored otherwise.
+	Code string `json:"code"`
+}
+
+type DomainVerificationOutput struct {
+	StartupID      string  `json:"startup_id"`
+	Domain         string  `json:"domain"`
+	Method         string  `json:"method"`
+	Status         string  `json:"status"` // pending, verified, expired
+	DNSRecordName  string  `json:"dns_record_name,omitempty"`
+	DNSRecordValue string  `json:"dns_record_value,omitempty"`
+	FileURL        string  `json:"file_url,omitempty"`
+	FileContent    string  `json:"file_content,omitempty"`
+	Email          string  `json:"email,omitempty"`
+	ExpiresAt      string  `json:"expires_at"`
+	VerifiedAt     *string `json:"verified_at"`
+	LastCheckedAt  *string `json:"last_checked_at"`
+}
+
+type FollowOutput struct {
+	StartupID   string  `json:"startup_id"`
+	StartupName string  `json:"startup_name"`
+	StartupSlug string  `json:"startup_slug"`
+	LogoURL     *string `json:"logo_url"`
+	FollowedAt  string  `json:"followed_at"`
+}
+
+// UnsubscribeFollowsInput carries the signed link from a follow digest.
+type UnsubscribeFollowsInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type UnsubscribeFollowsOutput struct {
+	Unfollowed int64 `json:"unfollowed"`
+}
+
+type FollowerCountOutput struct {
+	StartupID     string `json:"startup_id"`
+	FollowerCount int64  `json:"follower_count"`
+}
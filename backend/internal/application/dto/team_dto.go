@@ -31,6 +31,9 @@ type TeamMemberOutput struct {
 type InviteTeamMemberInput struct {
 	Email  string `json:"email" validate:"required,email"`
 	RoleID string `json:"role_id" validate:"required"`
+	// Locale is the inviter's Accept-Language, used unless the invitee
+	// already has an account with a locale of their own.
+	Locale string `json:"-"`
 }
 
 type UpdateTeamMemberInput struct {
@@ -64,12 +67,13 @@ type AdminLinkStartupTeamInput struct {
 }
 
 type MeOutput struct {
-	ID     string            `json:"id"`
-	Email  string            `json:"email"`
-	Name   string            `json:"name"`
-	Role   string            `json:"role"`
-	Status string            `json:"status"`
-	Teams  []MeTeamMembership `json:"teams"`
+	ID            string             `json:"id"`
+	Email         string             `json:"email"`
+	Name          string             `json:"name"`
+	Role          string             `json:"role"`
+	Status        string             `json:"status"`
+	EmailVerified bool               `json:"email_verified"`
+	Teams         []MeTeamMembership `json:"teams"`
 }
 
 type MeTeamMembership struct {
@@ -79,3 +83,70 @@ type MeTeamMembership struct {
 	RoleSlug string   `json:"role_slug"`
 	Scopes   []string `json:"scopes"`
 }
+
+type CreateAPITokenInput struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=jobs:read jobs:write jobs:delete"`
+	// ExpiresAt is RFC3339; omitted means the token never expires.
+	ExpiresAt *string `json:"expires_at"`
+}
+
+type APITokenOutput struct {
+	ID         string   `json:"id"`
+	StartupID  string   `json:"startup_id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	Active     bool     `json:"active"`
+	ExpiresAt  *string  `json:"expires_at"`
+	LastUsedAt *string  `json:"last_used_at"`
+	RevokedAt  *string  `json:"revoked_at"`
+	CreatedAt  string   `json:"created_at"`
+	// Token is only set on create and rotate (plaintext shown once).
+	Token string `json:"token,omitempty"`
+}
+
+type CreateStartupClaimInput struct {
+	Method string `json:"method" validate:"required,oneof=email_domain website manual"`
+	// TeamID links the startup to an existing team the claimant manages; omit to create one.
+	TeamID  *string `json:"team_id"`
+	Message string  `json:"message" validate:"max=2000"`
+}
+
+type VerifyStartupClaimInput struct {
+	Code string `json:"code"`
+}
+
+type RejectStartupClaimInput struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+type StartupClaimOutput struct {
+	ID              string  `json:"id"`
+	StartupID       string  `json:"startup_id"`
+	StartupName     string  `json:"startup_name"`
+	UserID          string  `json:"user_id"`
+	TeamID          *string `json:"team_id"`
+	Method          string  `json:"method"`
+	Status          string  `json:"status"`
+	Domain          string  `json:"domain,omitempty"`
+	Message         string  `json:"message,omitempty"`
+	Email           string  `json:"email,omitempty"`
+	DNSRecordName   string  `json:"dns_record_name,omitempty"`
+	DNSRecordValue  string  `json:"dns_record_value,omitempty"`
+	FileURL         string  `json:"file_url,omitempty"`
+	FileContent     string  `json:"file_content,omitempty"`
+	RejectionReason string  `json:"rejection_reason,omitempty"`
+	ReviewedAt      *string `json:"reviewed_at"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	// Events is the audit trail; only included when fetching a single claim.
+	Events []StartupClaimEventOutput `json:"events,omitempty"`
+}
+
+type StartupClaimEventOutput struct {
+	Action    string  `json:"action"`
+	ActorID   *string `json:"actor_id"`
+	Note      string  `json:"note,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
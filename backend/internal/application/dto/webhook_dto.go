@@ -0,0 +1,41 @@
+package dto
+
+import "encoding/json"
+
+type CreateWebhookEndpointInput struct {
+	URL    string   `json:"url" validate:"required,url,max=2048"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=job.created job.updated job.closed job.boosted member.joined plan.changed"`
+}
+
+type UpdateWebhookEndpointInput struct {
+	URL    *string  `json:"url" validate:"omitempty,url,max=2048"`
+	Events []string `json:"events" validate:"omitempty,min=1,dive,oneof=job.created job.updated job.closed job.boosted member.joined plan.changed"`
+	Active *bool    `json:"active"`
+}
+
+type WebhookEndpointOutput struct {
+	ID        string   `json:"id"`
+	TeamID    string   `json:"team_id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Active    bool     `json:"active"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+	// Secret is only set on create (shown once); use it to verify X-Webhook-Signature.
+	Secret string `json:"secret,omitempty"`
+}
+
+type WebhookDeliveryOutput struct {
+	ID             string          `json:"id"`
+	EndpointID     string          `json:"endpoint_id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *string         `json:"next_attempt_at"`
+	ResponseStatus *int            `json:"response_status"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *string         `json:"delivered_at"`
+	CreatedAt      string          `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
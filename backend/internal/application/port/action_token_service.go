@@ -0,0 +1,18 @@
+package port
+
+import "time"
+
+// ActionClaims bind a one-click emailed link to a single resource and action.
+// Version lets callers invalidate outstanding links when the resource changes.
+type ActionClaims struct {
+	Subject   string
+	Action    string
+	Version   int64
+	ExpiresAt time.Time
+}
+
+// ActionTokenService signs and verifies login-free action links.
+type ActionTokenService interface {
+	Sign(claims ActionClaims) (string, error)
+	Verify(token string) (*ActionClaims, error)
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// BackgroundJobArgs is the payload of one kind of background job. It is
+// stored as JSON, so the fields a handler needs must be exported.
+type BackgroundJobArgs interface {
+	Kind() string
+}
+
+// EnqueueOptions override the queue defaults for one job.
+type EnqueueOptions struct {
+	Queue       string    // "default" when empty
+	RunAt       time.Time // now when zero
+	MaxAttempts int       // the queue's default when zero
+	// UniqueKey drops the job if a queued or running job already has the key.
+	UniqueKey string
+}
+
+// BackgroundJobQueue stores work to run outside the request. Inside
+// TxManager.WithinTx the job commits or rolls back with the caller's
+// changes. Jobs run at least once, so handlers must be safe to repeat.
+type BackgroundJobQueue interface {
+	// Enqueue returns false when the job was dropped for its unique key.
+	Enqueue(ctx context.Context, args BackgroundJobArgs, opts EnqueueOptions) (bool, error)
+}
@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// DomainVerifier checks published proof of control for a website domain.
+// Both methods return nil only when the token is found.
+type DomainVerifier interface {
+	CheckDNS(ctx context.Context, domain, token string) error
+	CheckWellKnownFile(ctx context.Context, domain, token string) error
+}
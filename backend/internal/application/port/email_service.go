@@ -2,15 +2,129 @@ package port
 
 import (
 	"context"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/domain/entity"
 )
 
+// Email templates and the data each one renders. Templates live with the
+// email service; a new email adds a name, its data type and the template files.
+const (
+	EmailTeamInvitation     = "team_invitation"
+	EmailStartupInvitation  = "startup_invitation"
+	EmailJoinRequest        = "join_request"
+	EmailJobReminder        = "job_reminder"
+	EmailDomainVerification = "domain_verification"
+	EmailFollowDigest       = "follow_digest"
+	EmailVerification       = "email_verification"
+	EmailPasswordReset      = "password_reset"
+	EmailPasswordChanged    = "password_changed"
+)
+
 type EmailService interface {
-	SendInvitationEmail(ctx context.Context, invitation *entity.Invitation, startup *entity.Startup, inviteURL string) error
-	SendTeamInvitationEmail(ctx context.Context, toEmail, teamName, inviteURL string) error
-	SendJoinRequestNotification(ctx context.Context, member *entity.StartupMember, startup *entity.Startup) error
-	SendMemberApprovedEmail(ctx context.Context, member *entity.StartupMember, startup *entity.Startup) error
+	// Send renders the named template with data in the recipient's locale,
+	// falling back to the default locale, and sends it.
+	Send(ctx context.Context, template string, data interface{}, to EmailRecipient) error
+	// ForwardApplicationEmail relays a candidate's message sent to a job's masked
+	// apply+<token> address to the startup's real application email.
+	ForwardApplicationEmail(ctx context.Context, toEmail string, msg ApplicationMessage) error
+}
+
+// EmailRecipient is who an email goes to. Locale is a tag such as "de" or
+// "pt-BR"; empty uses the default.
+type EmailRecipient struct {
+	Email  string
+	Locale string
+}
+
+// EmailRenderer renders templates without sending them, for previews.
+type EmailRenderer interface {
+	Templates() []EmailTemplate
+	Render(template, locale string, data interface{}) (*RenderedEmail, error)
+}
+
+// EmailTemplate describes one template: the locales it has and example data.
+type EmailTemplate struct {
+	Name       string
+	Locales    []string
+	SampleData interface{}
+}
+
+type RenderedEmail struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type TeamInvitationEmail struct {
+	TeamName  string
+	InviteURL string
+}
+
+type StartupInvitationEmail struct {
+	StartupName string
+	Role        string
+	InviteURL   string
 }
 
+type JoinRequestEmail struct {
+	StartupName string
+}
+
+type EmailVerificationEmail struct {
+	Name      string
+	VerifyURL string
+	ExpiresAt time.Time
+}
+
+type PasswordResetEmail struct {
+	Name      string
+	ResetURL  string
+	ExpiresAt time.Time
+}
+
+// PasswordChangedEmail tells the owner their password changed, with a way
+// back in if it was not them.
+type PasswordChangedEmail struct {
+	Name      string
+	ChangedAt time.Time
+	ResetURL  string
+}
 
+type DomainVerificationEmail struct {
+	StartupName string
+	Domain      string
+	Code        string
+}
 
+// JobReminderEmail is an expiry or stale-listing nudge with signed one-click links.
+type JobReminderEmail struct {
+	Kind        entity.JobReminderKind
+	JobTitle    string
+	StartupName string
+	ExpiresAt   *time.Time
+	ExtendURL   string
+	FillURL     string
+	CloseURL    string
+}
+
+type FollowDigestEmail struct {
+	Items          []FollowDigestItem
+	UnsubscribeURL string // unfollows every startup; no login needed
+}
+
+// FollowDigestItem is one new job from a followed startup.
+type FollowDigestItem struct {
+	StartupName string
+	JobTitle    string
+	JobURL      string
+}
+
+// ApplicationMessage is an inbound candidate email addressed to a job relay address.
+type ApplicationMessage struct {
+	From     string
+	Subject  string
+	Text     string
+	HTML     string
+	JobTitle string
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+// TxManager runs fn in a database transaction that repositories called with
+// the ctx it receives take part in. Nested calls join the outer transaction.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventRecorder appends a domain event to the outbox, with data encoded as
+// its JSON payload. Inside TxManager.WithinTx the event commits or rolls
+// back with the caller's changes.
+type EventRecorder interface {
+	Record(ctx context.Context, event *entity.DomainEvent, data interface{}) error
+}
+
+// EventHandler reacts to one domain event. Delivery is at least once, so
+// handlers must tolerate seeing the same event ID again.
+type EventHandler func(ctx context.Context, event *entity.DomainEvent) error
+
+// EventDispatcher hands an event to its subscribers.
+type EventDispatcher interface {
+	Dispatch(ctx context.Context, event *entity.DomainEvent) error
+}
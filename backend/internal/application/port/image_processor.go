@@ -0,0 +1,26 @@
+package port
+
+// ImageVariant is one resized rendition of an uploaded image.
+type ImageVariant struct {
+	Size     int // longest edge bound in pixels
+	Format   string
+	MimeType string
+	Data     []byte
+}
+
+// ProcessedImage is an upload after it has been decoded and re-encoded.
+// Only pixels survive the round trip, so metadata (EXIF, comments) and any
+// trailing payloads are gone.
+type ProcessedImage struct {
+	Data      []byte
+	MimeType  string
+	Extension string
+	Width     int
+	Height    int
+	Variants  []ImageVariant
+}
+
+type ImageProcessor interface {
+	// Process fails when data is not a decodable JPEG, PNG, GIF or WebP image.
+	Process(data []byte, sizes []int) (*ProcessedImage, error)
+}
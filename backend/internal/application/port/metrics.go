@@ -0,0 +1,16 @@
+package port
+
+import "time"
+
+// Metrics records operational measurements. Implementations must be safe
+// for concurrent use and cheap enough to call on every request.
+type Metrics interface {
+	// ObserveHTTPRequest records one request by route template, not raw path.
+	ObserveHTTPRequest(method, route string, status int, duration time.Duration)
+	RateLimitRejected(bucket string)
+	// StripeWebhookHandled records the outcome of one Stripe event:
+	// processed, ignored, failed or invalid_signature.
+	StripeWebhookHandled(eventType, outcome string)
+	// EmailSent records one send attempt; a nil err counts as success.
+	EmailSent(kind string, err error)
+}
@@ -7,6 +7,8 @@ type ExternalProfile struct {
 	ProviderUserID string
 	Email          string
 	Name           string
+	// EmailVerified is true when the provider vouches that the user owns Email.
+	EmailVerified bool
 }
 
 // OAuthProvider abstracts Google / Apple / GitHub (etc.) auth-code flows.
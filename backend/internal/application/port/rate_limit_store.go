@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimitResult describes the state of a key's window after a Take.
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// Reset is how long until the window frees up capacity again.
+	Reset time.Duration
+}
+
+// RateLimitStore counts requests per key. Shared implementations let every
+// replica enforce the same budget.
+type RateLimitStore interface {
+	// Take records one request for key unless limit requests were already
+	// recorded within window; a rejected request is not recorded.
+	Take(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error)
+}
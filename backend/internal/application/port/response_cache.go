@@ -0,0 +1,30 @@
+package port
+
+// CacheScope groups cached public responses by the data they are built from.
+type CacheScope string
+
+const (
+	CacheScopeJobs     CacheScope = "jobs"
+	CacheScopeStartups CacheScope = "startups"
+)
+
+// PublicCache lets use cases drop cached public reads after they change the
+// data behind them.
+type PublicCache interface {
+	Invalidate(scopes ...CacheScope)
+}
+
+// CachedResponse is a successful anonymous response kept for replay.
+type CachedResponse struct {
+	Status      int
+	ContentType string
+	ETag        string
+	Body        []byte
+}
+
+// ResponseCache stores public responses under a normalized request key.
+type ResponseCache interface {
+	PublicCache
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, scope CacheScope, resp *CachedResponse)
+}
@@ -1,12 +1,66 @@
 package port
 
-import "context"
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// PrivateKeyPrefix marks objects that must never be publicly readable, such
+// as resumes. GetURL returns a short-lived signed link for them.
+const PrivateKeyPrefix = "private/"
+
+func IsPrivateKey(key string) bool {
+	return strings.HasPrefix(key, PrivateKeyPrefix)
+}
 
 type StorageService interface {
 	Upload(ctx context.Context, file []byte, key string, contentType string) (string, error)
 	Delete(ctx context.Context, key string) error
+	// GetURL returns the object's public URL, or an expiring signed URL for
+	// keys under PrivateKeyPrefix.
 	GetURL(ctx context.Context, key string) (string, error)
 }
 
+// StoragePinger is implemented by backends that can confirm their bucket or
+// directory is reachable without touching any object.
+type StoragePinger interface {
+	Ping(ctx context.Context) error
+}
+
+// PresignedUpload is a browser form POST straight to object storage. The
+// client sends Fields followed by the file as the "file" field.
+type PresignedUpload struct {
+	URL    string
+	Fields map[string]string
+}
+
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// DirectUploadStorage is implemented by backends that accept uploads without
+// the bytes passing through the API.
+type DirectUploadStorage interface {
+	// PresignUpload returns a POST policy limited to exactly key, the given
+	// content type and at most maxSize bytes.
+	PresignUpload(ctx context.Context, key, contentType string, maxSize int64, ttl time.Duration) (*PresignedUpload, error)
+	// Stat returns nil, nil when the object does not exist.
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Read returns at most limit bytes from the start of the object.
+	Read(ctx context.Context, key string, limit int64) ([]byte, error)
+}
 
+type StoredObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
 
+// ObjectLister is implemented by backends that can enumerate their objects.
+type ObjectLister interface {
+	// ListObjects calls fn for every stored object; an error from fn stops
+	// the walk and is returned.
+	ListObjects(ctx context.Context, fn func(obj StoredObject) error) error
+}
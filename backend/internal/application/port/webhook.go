@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+// WebhookSender makes one signed delivery attempt.
+type WebhookSender interface {
+	Send(ctx context.Context, url, secret string, delivery *entity.WebhookDelivery) (*WebhookResponse, error)
+}
+
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+}
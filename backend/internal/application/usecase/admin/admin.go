@@ -12,6 +12,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -25,6 +26,9 @@ func NewListUsersUseCase(userRepo repository.UserRepository, authService *servic
 }
 
 func (uc *ListUsersUseCase) Execute(ctx context.Context, actorID string, page, pageSize int, search string) ([]dto.UserOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "admin.ListUsers")
+	defer span.End()
+
 	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
 	if err != nil || !ok {
 		return nil, 0, errors.NewForbiddenError("platform admin required")
@@ -35,7 +39,7 @@ func (uc *ListUsersUseCase) Execute(ctx context.Context, actorID string, page, p
 	}
 	out := make([]dto.UserOutput, len(users))
 	for i, u := range users {
-		out[i] = dto.UserOutput{ID: u.ID, Email: u.Email, Name: u.Name, Role: string(u.Role)}
+		out[i] = dto.UserOutput{ID: u.ID, Email: u.Email, Name: u.Name, Role: string(u.Role), EmailVerified: u.IsEmailVerified()}
 	}
 	return out, total, nil
 }
@@ -50,6 +54,9 @@ func NewUpdateUserUseCase(userRepo repository.UserRepository, authService *servi
 }
 
 func (uc *UpdateUserUseCase) Execute(ctx context.Context, actorID, targetID string, input dto.AdminUpdateUserInput) (*dto.UserOutput, error) {
+	ctx, span := tracing.Start(ctx, "admin.UpdateUser")
+	defer span.End()
+
 	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
 	if err != nil || !ok {
 		return nil, errors.NewForbiddenError("platform admin required")
@@ -68,7 +75,9 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, actorID, targetID stri
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, err
 	}
-	return &dto.UserOutput{ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role)}, nil
+	return &dto.UserOutput{
+		ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role), EmailVerified: user.IsEmailVerified(),
+	}, nil
 }
 
 type ListTeamsUseCase struct {
@@ -81,6 +90,9 @@ func NewListTeamsUseCase(teamRepo repository.TeamRepository, authService *servic
 }
 
 func (uc *ListTeamsUseCase) Execute(ctx context.Context, actorID string, page, pageSize int) ([]*dto.TeamOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "admin.ListTeams")
+	defer span.End()
+
 	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
 	if err != nil || !ok {
 		return nil, 0, errors.NewForbiddenError("platform admin required")
@@ -102,21 +114,30 @@ func (uc *ListTeamsUseCase) Execute(ctx context.Context, actorID string, page, p
 
 type CreateOrphanStartupUseCase struct {
 	startupRepo repository.StartupRepository
+	tokenRepo   repository.StartupAPITokenRepository
 	tokenGen    port.TokenService
+	slugs       *service.SlugService
 	authService *service.AuthorizationService
+	cache       port.PublicCache
 	logger      logger.Logger
 }
 
 func NewCreateOrphanStartupUseCase(
 	startupRepo repository.StartupRepository,
+	tokenRepo repository.StartupAPITokenRepository,
 	tokenGen port.TokenService,
+	slugs *service.SlugService,
 	authService *service.AuthorizationService,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *CreateOrphanStartupUseCase {
-	return &CreateOrphanStartupUseCase{startupRepo: startupRepo, tokenGen: tokenGen, authService: authService, logger: logger}
+	return &CreateOrphanStartupUseCase{startupRepo: startupRepo, tokenRepo: tokenRepo, tokenGen: tokenGen, slugs: slugs, authService: authService, cache: cache, logger: logger}
 }
 
 func (uc *CreateOrphanStartupUseCase) Execute(ctx context.Context, actorID string, input dto.CreateStartupInput) (*dto.StartupOutput, error) {
+	ctx, span := tracing.Start(ctx, "admin.CreateOrphanStartup")
+	defer span.End()
+
 	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
 	if err != nil || !ok {
 		return nil, errors.NewForbiddenError("platform admin required")
@@ -125,22 +146,26 @@ func (uc *CreateOrphanStartupUseCase) Execute(ctx context.Context, actorID strin
 	if err != nil {
 		return nil, err
 	}
-	baseSlug := utils.GenerateSlug(input.Name)
-	slug := utils.GenerateUniqueSlug(baseSlug, func(s string) bool {
-		_, e := uc.startupRepo.FindBySlug(ctx, s)
-		return e == nil
-	})
+	slug := uc.slugs.StartupSlug(ctx, input.Name, "")
 	now := time.Now()
 	startup := &entity.Startup{
 		ID: uuid.New().String(), Name: input.Name, Slug: slug, Description: input.Description,
 		Website: input.Website, FoundedYear: input.FoundedYear, Industry: input.Industry,
-		CompanySize: input.CompanySize, Location: input.Location, APIToken: utils.HashToken(tokenStr),
+		CompanySize: input.CompanySize, Location: input.Location,
 		AllowPublicJoin: input.AllowPublicJoin, Status: entity.StartupStatusActive,
 		TeamID: nil, Plan: string(entity.StartupPlanFree), CreatedAt: now, UpdatedAt: now,
 	}
 	if err := uc.startupRepo.Create(ctx, startup); err != nil {
 		return nil, err
 	}
+	if err := uc.tokenRepo.Create(ctx, &entity.StartupAPIToken{
+		ID: uuid.New().String(), StartupID: startup.ID, Name: entity.DefaultAPITokenName,
+		TokenHash: utils.HashToken(tokenStr), Prefix: tokenStr[:entity.APITokenPrefixLen],
+		Scopes: entity.APITokenScopes(), CreatedBy: &actorID, CreatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 	return &dto.StartupOutput{
 		ID: startup.ID, Name: startup.Name, Slug: startup.Slug, Description: startup.Description,
 		Website: startup.Website, FoundedYear: startup.FoundedYear, Industry: startup.Industry,
@@ -155,17 +180,22 @@ type LinkStartupTeamUseCase struct {
 	startupRepo repository.StartupRepository
 	teamRepo    repository.TeamRepository
 	authService *service.AuthorizationService
+	cache       port.PublicCache
 }
 
 func NewLinkStartupTeamUseCase(
 	startupRepo repository.StartupRepository,
 	teamRepo repository.TeamRepository,
 	authService *service.AuthorizationService,
+	cache port.PublicCache,
 ) *LinkStartupTeamUseCase {
-	return &LinkStartupTeamUseCase{startupRepo: startupRepo, teamRepo: teamRepo, authService: authService}
+	return &LinkStartupTeamUseCase{startupRepo: startupRepo, teamRepo: teamRepo, authService: authService, cache: cache}
 }
 
 func (uc *LinkStartupTeamUseCase) Execute(ctx context.Context, actorID, startupID string, input dto.AdminLinkStartupTeamInput) error {
+	ctx, span := tracing.Start(ctx, "admin.LinkStartupTeam")
+	defer span.End()
+
 	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
 	if err != nil || !ok {
 		return errors.NewForbiddenError("platform admin required")
@@ -183,5 +213,9 @@ func (uc *LinkStartupTeamUseCase) Execute(ctx context.Context, actorID, startupI
 		startup.TeamID = nil
 	}
 	startup.UpdatedAt = time.Now()
-	return uc.startupRepo.Update(ctx, startup)
+	if err := uc.startupRepo.Update(ctx, startup); err != nil {
+		return err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
+	return nil
 }
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+type ListEmailTemplatesUseCase struct {
+	renderer    port.EmailRenderer
+	authService *service.AuthorizationService
+}
+
+func NewListEmailTemplatesUseCase(renderer port.EmailRenderer, authService *service.AuthorizationService) *ListEmailTemplatesUseCase {
+	return &ListEmailTemplatesUseCase{renderer: renderer, authService: authService}
+}
+
+func (uc *ListEmailTemplatesUseCase) Execute(ctx context.Context, actorID string) ([]dto.EmailTemplateOutput, error) {
+	ctx, span := tracing.Start(ctx, "admin.ListEmailTemplates")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	templates := uc.renderer.Templates()
+	out := make([]dto.EmailTemplateOutput, len(templates))
+	for i, t := range templates {
+		out[i] = dto.EmailTemplateOutput{Name: t.Name, Locales: t.Locales, SampleData: t.SampleData}
+	}
+	return out, nil
+}
+
+// PreviewEmailUseCase renders a template without sending it, with the
+// template's sample data unless the admin supplies their own.
+type PreviewEmailUseCase struct {
+	renderer    port.EmailRenderer
+	authService *service.AuthorizationService
+}
+
+func NewPreviewEmailUseCase(renderer port.EmailRenderer, authService *service.AuthorizationService) *PreviewEmailUseCase {
+	return &PreviewEmailUseCase{renderer: renderer, authService: authService}
+}
+
+func (uc *PreviewEmailUseCase) Execute(ctx context.Context, actorID, template string, input dto.PreviewEmailInput) (*dto.EmailPreviewOutput, error) {
+	ctx, span := tracing.Start(ctx, "admin.PreviewEmail")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	var found *port.EmailTemplate
+	for _, t := range uc.renderer.Templates() {
+		if t.Name == template {
+			found = &t
+			break
+		}
+	}
+	if found == nil {
+		return nil, errors.NewNotFoundError("email template")
+	}
+	data := found.SampleData
+	if input.Data != nil {
+		data = input.Data
+	}
+	rendered, err := uc.renderer.Render(template, input.Locale, data)
+	if err != nil {
+		return nil, errors.NewBadRequestError(err.Error())
+	}
+	return &dto.EmailPreviewOutput{Subject: rendered.Subject, HTML: rendered.HTML, Text: rendered.Text}, nil
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+// VerificationEmailJob emails a user a fresh verification link. The token
+// is created when the job runs so its plain form never sits in the queue.
+type VerificationEmailJob struct {
+	UserID string `json:"user_id"`
+}
+
+func (VerificationEmailJob) Kind() string { return "auth.verification_email" }
+
+var _ port.BackgroundJobArgs = VerificationEmailJob{}
+
+// enqueueVerificationEmail queues at most one pending email per user.
+func enqueueVerificationEmail(ctx context.Context, jobs port.BackgroundJobQueue, userID string) error {
+	job := VerificationEmailJob{UserID: userID}
+	_, err := jobs.Enqueue(ctx, job, port.EnqueueOptions{Queue: "email", UniqueKey: job.Kind() + ":" + userID})
+	return err
+}
+
+// SendVerificationEmailUseCase handles VerificationEmailJob. Users who are
+// verified by the time the job runs get no email.
+type SendVerificationEmailUseCase struct {
+	userRepo     repository.UserRepository
+	tokenRepo    repository.EmailVerificationTokenRepository
+	emailService port.EmailService
+	appURL       string
+	ttl          time.Duration
+}
+
+func NewSendVerificationEmailUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.EmailVerificationTokenRepository,
+	emailService port.EmailService,
+	appURL string,
+	ttl time.Duration,
+) *SendVerificationEmailUseCase {
+	return &SendVerificationEmailUseCase{
+		userRepo: userRepo, tokenRepo: tokenRepo, emailService: emailService,
+		appURL: strings.TrimRight(appURL, "/"), ttl: ttl,
+	}
+}
+
+func (uc *SendVerificationEmailUseCase) Execute(ctx context.Context, job VerificationEmailJob) error {
+	ctx, span := tracing.Start(ctx, "auth.SendVerificationEmail")
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	plain, err := utils.RandomHex(32)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	token := &entity.EmailVerificationToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(plain),
+		ExpiresAt: now.Add(uc.ttl),
+		CreatedAt: now,
+	}
+	if err := uc.tokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+	_ = uc.tokenRepo.DeleteExpired(ctx)
+
+	return uc.emailService.Send(ctx, port.EmailVerification, port.EmailVerificationEmail{
+		Name: user.Name, VerifyURL: uc.appURL + "/verify-email?token=" + plain, ExpiresAt: token.ExpiresAt,
+	}, port.EmailRecipient{Email: user.Email, Locale: user.Locale})
+}
+
+// VerifyEmailUseCase consumes a verification token and marks its user verified.
+type VerifyEmailUseCase struct {
+	tokenRepo repository.EmailVerificationTokenRepository
+	userRepo  repository.UserRepository
+	tx        port.TxManager
+}
+
+func NewVerifyEmailUseCase(
+	tokenRepo repository.EmailVerificationTokenRepository,
+	userRepo repository.UserRepository,
+	tx port.TxManager,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{tokenRepo: tokenRepo, userRepo: userRepo, tx: tx}
+}
+
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input dto.VerifyEmailInput) (*dto.UserOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.VerifyEmail")
+	defer span.End()
+
+	token, err := uc.tokenRepo.FindByTokenHash(ctx, utils.HashToken(input.Token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token == nil || !token.IsValid(now) {
+		return nil, errors.NewBadRequestError("invalid or expired verification token")
+	}
+
+	var user *entity.User
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.tokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+			return errors.NewBadRequestError("invalid or expired verification token")
+		}
+		found, err := uc.userRepo.FindByID(ctx, token.UserID)
+		if err != nil {
+			return errors.NewNotFoundError("user")
+		}
+		user = found
+		if user.IsEmailVerified() {
+			return nil
+		}
+		user.EmailVerifiedAt = &now
+		user.UpdatedAt = now
+		return uc.userRepo.Update(ctx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.UserOutput{
+		ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role),
+		EmailVerified: user.IsEmailVerified(),
+	}, nil
+}
+
+// ResendVerificationEmailUseCase queues another verification email, at most
+// once per cooldown.
+type ResendVerificationEmailUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.EmailVerificationTokenRepository
+	jobs      port.BackgroundJobQueue
+	cooldown  time.Duration
+}
+
+func NewResendVerificationEmailUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.EmailVerificationTokenRepository,
+	jobs port.BackgroundJobQueue,
+	cooldown time.Duration,
+) *ResendVerificationEmailUseCase {
+	return &ResendVerificationEmailUseCase{userRepo: userRepo, tokenRepo: tokenRepo, jobs: jobs, cooldown: cooldown}
+}
+
+func (uc *ResendVerificationEmailUseCase) Execute(ctx context.Context, userID string) error {
+	ctx, span := tracing.Start(ctx, "auth.ResendVerificationEmail")
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return errors.NewNotFoundError("user")
+	}
+	if user.IsEmailVerified() {
+		return errors.NewBadRequestError("email address is already verified")
+	}
+	latest, err := uc.tokenRepo.FindLatestByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if latest != nil && time.Since(latest.CreatedAt) < uc.cooldown {
+		return errors.ErrRateLimited
+	}
+	return enqueueVerificationEmail(ctx, uc.jobs, userID)
+}
@@ -0,0 +1,173 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	authusecase "github.com/startup-job-board/backend/internal/application/usecase/auth"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	apperrors "github.com/startup-job-board/backend/pkg/errors"
+)
+
+// tokenRow has the fields of both token entities, so memTokens can fake the
+// email verification and the password reset token repositories alike.
+type tokenRow struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type memTokens[T entity.EmailVerificationToken | entity.PasswordResetToken] struct {
+	tokens []*tokenRow
+}
+
+func (r *memTokens[T]) Create(ctx context.Context, token *T) error {
+	row := tokenRow(*token)
+	r.tokens = append(r.tokens, &row)
+	return nil
+}
+
+func (r *memTokens[T]) FindByTokenHash(ctx context.Context, tokenHash string) (*T, error) {
+	for _, row := range r.tokens {
+		if row.TokenHash == tokenHash {
+			t := T(*row)
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memTokens[T]) FindLatestByUser(ctx context.Context, userID string) (*T, error) {
+	for i := len(r.tokens) - 1; i >= 0; i-- {
+		if r.tokens[i].UserID == userID {
+			t := T(*r.tokens[i])
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memTokens[T]) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	for _, row := range r.tokens {
+		if row.ID == id && row.UsedAt == nil {
+			row.UsedAt = &usedAt
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+func (r *memTokens[T]) MarkAllUsed(ctx context.Context, userID string, usedAt time.Time) error {
+	for _, row := range r.tokens {
+		if row.UserID == userID && row.UsedAt == nil {
+			row.UsedAt = &usedAt
+		}
+	}
+	return nil
+}
+
+func (r *memTokens[T]) DeleteExpired(ctx context.Context) error { return nil }
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type memQueue struct {
+	jobs []port.BackgroundJobArgs
+}
+
+func (q *memQueue) Enqueue(ctx context.Context, args port.BackgroundJobArgs, opts port.EnqueueOptions) (bool, error) {
+	q.jobs = append(q.jobs, args)
+	return true, nil
+}
+
+type captureEmail struct {
+	sent []interface{}
+}
+
+func (e *captureEmail) Send(ctx context.Context, template string, data interface{}, to port.EmailRecipient) error {
+	e.sent = append(e.sent, data)
+	return nil
+}
+
+func (e *captureEmail) ForwardApplicationEmail(ctx context.Context, toEmail string, msg port.ApplicationMessage) error {
+	return nil
+}
+
+func TestEmailVerificationTokenIsHashedAndSingleUse(t *testing.T) {
+	ctx := context.Background()
+	users := &memUserRepo{users: map[string]*entity.User{
+		"user-1": {ID: "user-1", Email: "a@b.com", Name: "A", Role: entity.UserRoleCandidate, Status: entity.UserStatusActive},
+	}}
+	tokens := &memTokens[entity.EmailVerificationToken]{}
+	mail := &captureEmail{}
+	send := authusecase.NewSendVerificationEmailUseCase(users, tokens, mail, "https://joinus.example/", time.Hour)
+	verify := authusecase.NewVerifyEmailUseCase(tokens, users, inlineTx{})
+
+	if err := send.Execute(ctx, authusecase.VerificationEmailJob{UserID: "user-1"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if len(mail.sent) != 1 {
+		t.Fatalf("sent %d emails", len(mail.sent))
+	}
+	link, err := url.Parse(mail.sent[0].(port.EmailVerificationEmail).VerifyURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := link.Query().Get("token")
+	if plain == "" || tokens.tokens[0].TokenHash == plain {
+		t.Fatalf("token %q must be stored hashed", plain)
+	}
+
+	out, err := verify.Execute(ctx, dto.VerifyEmailInput{Token: plain})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if !out.EmailVerified || !users.users["user-1"].IsEmailVerified() {
+		t.Fatal("user should be verified")
+	}
+	if _, err := verify.Execute(ctx, dto.VerifyEmailInput{Token: plain}); err == nil {
+		t.Fatal("a token must not verify twice")
+	}
+
+	// Verified users get no further emails.
+	if err := send.Execute(ctx, authusecase.VerificationEmailJob{UserID: "user-1"}); err != nil || len(mail.sent) != 1 {
+		t.Fatalf("send after verify: err=%v sent=%d", err, len(mail.sent))
+	}
+}
+
+func TestResendVerificationEmailIsThrottled(t *testing.T) {
+	ctx := context.Background()
+	users := &memUserRepo{users: map[string]*entity.User{
+		"user-1": {ID: "user-1", Email: "a@b.com", Name: "A", Role: entity.UserRoleCandidate, Status: entity.UserStatusActive},
+	}}
+	tokens := &memTokens[entity.EmailVerificationToken]{}
+	queue := &memQueue{}
+	resend := authusecase.NewResendVerificationEmailUseCase(users, tokens, queue, time.Minute)
+
+	if err := resend.Execute(ctx, "user-1"); err != nil {
+		t.Fatalf("first resend: %v", err)
+	}
+	if len(queue.jobs) != 1 {
+		t.Fatalf("queued %d jobs", len(queue.jobs))
+	}
+
+	tokens.Create(ctx, &entity.EmailVerificationToken{ID: "t1", UserID: "user-1", CreatedAt: time.Now()})
+	err := resend.Execute(ctx, "user-1")
+	if appErr, ok := err.(*apperrors.AppError); !ok || appErr.Code != "RATE_LIMITED" {
+		t.Fatalf("err = %v, want RATE_LIMITED", err)
+	}
+	if len(queue.jobs) != 1 {
+		t.Fatalf("throttled resend queued a job")
+	}
+}
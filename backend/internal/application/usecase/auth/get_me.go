@@ -7,6 +7,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type GetMeUseCase struct {
@@ -31,6 +32,9 @@ func NewGetMeUseCase(
 }
 
 func (uc *GetMeUseCase) Execute(ctx context.Context, userID string) (*dto.MeOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.GetMe")
+	defer span.End()
+
 	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, errors.NewNotFoundError("user")
@@ -66,6 +70,7 @@ func (uc *GetMeUseCase) Execute(ctx context.Context, userID string) (*dto.MeOutp
 
 	return &dto.MeOutput{
 		ID: user.ID, Email: user.Email, Name: user.Name,
-		Role: string(user.Role), Status: string(user.Status), Teams: teams,
+		Role: string(user.Role), Status: string(user.Status),
+		EmailVerified: user.IsEmailVerified(), Teams: teams,
 	}, nil
 }
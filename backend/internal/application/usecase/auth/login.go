@@ -8,6 +8,7 @@ import (
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -30,6 +31,9 @@ func NewLoginUseCase(
 }
 
 func (uc *LoginUseCase) Execute(ctx context.Context, input dto.LoginInput) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.Login")
+	defer span.End()
+
 	user, err := uc.userRepo.FindByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, errors.ErrUnauthorized
@@ -59,10 +63,11 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input dto.LoginInput) (*dto
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		User: dto.UserOutput{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
+			ID:            user.ID,
+			Email:         user.Email,
+			Name:          user.Name,
+			Role:          string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
 		},
 	}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type LogoutUseCase struct {
@@ -19,6 +20,9 @@ func NewLogoutUseCase(userRepo repository.UserRepository, logger logger.Logger)
 }
 
 func (uc *LogoutUseCase) Execute(ctx context.Context, userID string) error {
+	ctx, span := tracing.Start(ctx, "auth.Logout")
+	defer span.End()
+
 	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return errors.ErrUnauthorized
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -57,14 +59,19 @@ func NewCompleteOAuthUseCase(
 	}
 }
 
-func (uc *CompleteOAuthUseCase) Execute(ctx context.Context, providerName, code string) (*dto.AuthOutput, error) {
+// Execute signs the provider's user in, creating an account on first use;
+// locale is only recorded on a new account.
+func (uc *CompleteOAuthUseCase) Execute(ctx context.Context, providerName, code, locale string) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.CompleteOAuth")
+	defer span.End()
+
 	provider, ok := uc.registry.Get(providerName)
 	if !ok {
 		return nil, errors.NewBadRequestError("unsupported oauth provider")
 	}
 	profile, err := provider.Exchange(ctx, code)
 	if err != nil {
-		uc.logger.Warn("oauth exchange failed for %s: %v", providerName, err)
+		uc.logger.WithContext(ctx).Warn("oauth exchange failed for %s: %v", providerName, err)
 		return nil, errors.NewUnauthorizedError("oauth authentication failed")
 	}
 	if profile.Email == "" || profile.ProviderUserID == "" {
@@ -96,7 +103,10 @@ func (uc *CompleteOAuthUseCase) Execute(ctx context.Context, providerName, code
 			user = &entity.User{
 				ID: uuid.New().String(), Email: profile.Email, Password: string(hash),
 				Name: name, Role: entity.UserRoleCandidate, Status: entity.UserStatusActive,
-				CreatedAt: now, UpdatedAt: now,
+				Locale: locale, CreatedAt: now, UpdatedAt: now,
+			}
+			if profile.EmailVerified {
+				user.EmailVerifiedAt = &now
 			}
 			if err := uc.userRepo.Create(ctx, user); err != nil {
 				return nil, err
@@ -115,6 +125,15 @@ func (uc *CompleteOAuthUseCase) Execute(ctx context.Context, providerName, code
 	if user.Status != entity.UserStatusActive {
 		return nil, errors.NewUnauthorizedError("account is not active")
 	}
+	// The provider has checked the address, which stands in for our own link.
+	if !user.IsEmailVerified() && profile.EmailVerified && strings.EqualFold(profile.Email, user.Email) {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+		user.UpdatedAt = now
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
 
 	access, err := uc.jwtService.GenerateAccessToken(user.ID, string(user.Role))
 	if err != nil {
@@ -126,7 +145,10 @@ func (uc *CompleteOAuthUseCase) Execute(ctx context.Context, providerName, code
 	}
 	return &dto.AuthOutput{
 		AccessToken: access, RefreshToken: refresh,
-		User: dto.UserOutput{ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role)},
+		User: dto.UserOutput{
+			ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
+		},
 	}, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 const oauthLoginCodeTTL = 60 * time.Second
@@ -28,6 +29,9 @@ func NewIssueOAuthLoginCodeUseCase(codeRepo repository.OAuthLoginCodeRepository)
 }
 
 func (uc *IssueOAuthLoginCodeUseCase) Execute(ctx context.Context, auth *dto.AuthOutput) (plainCode string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.IssueOAuthLoginCode")
+	defer span.End()
+
 	plain, err := randomOAuthCode()
 	if err != nil {
 		return "", errors.ErrInternalError
@@ -65,6 +69,9 @@ func NewExchangeOAuthLoginCodeUseCase(
 }
 
 func (uc *ExchangeOAuthLoginCodeUseCase) Execute(ctx context.Context, input dto.ExchangeOAuthCodeInput) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.ExchangeOAuthLoginCode")
+	defer span.End()
+
 	if input.Code == "" {
 		return nil, errors.NewBadRequestError("invalid or expired code")
 	}
@@ -103,6 +110,7 @@ func (uc *ExchangeOAuthLoginCodeUseCase) Execute(ctx context.Context, input dto.
 		RefreshToken: refreshToken,
 		User: dto.UserOutput{
 			ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
 		},
 	}, nil
 }
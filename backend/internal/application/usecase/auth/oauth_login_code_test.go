@@ -46,9 +46,19 @@ type memUserRepo struct {
 }
 
 func (r *memUserRepo) Create(ctx context.Context, user *entity.User) error { return nil }
-func (r *memUserRepo) Update(ctx context.Context, user *entity.User) error { return nil }
+func (r *memUserRepo) Update(ctx context.Context, user *entity.User) error {
+	cp := *user
+	r.users[user.ID] = &cp
+	return nil
+}
 func (r *memUserRepo) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
-	return nil, nil
+	for _, u := range r.users {
+		if u.Email == email {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, errors.New("not found")
 }
 func (r *memUserRepo) List(ctx context.Context, page, pageSize int, search string) ([]*entity.User, int64, error) {
 	return nil, 0, nil
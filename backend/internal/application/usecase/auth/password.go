@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordResetEmailJob emails a reset link to the account with Email, if
+// there is one. Like the verification email, the token is created when the
+// job runs.
+type PasswordResetEmailJob struct {
+	Email string `json:"email"`
+}
+
+func (PasswordResetEmailJob) Kind() string { return "auth.password_reset_email" }
+
+// PasswordChangedEmailJob tells a user their password was changed.
+type PasswordChangedEmailJob struct {
+	UserID    string    `json:"user_id"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (PasswordChangedEmailJob) Kind() string { return "auth.password_changed_email" }
+
+var (
+	_ port.BackgroundJobArgs = PasswordResetEmailJob{}
+	_ port.BackgroundJobArgs = PasswordChangedEmailJob{}
+)
+
+// setPassword hashes the new password and bumps the token version, so every
+// refresh token issued before the change stops working.
+func setPassword(user *entity.User, password string, now time.Time) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.ErrInternalError
+	}
+	user.Password = string(hash)
+	user.TokenVersion++
+	user.UpdatedAt = now
+	return nil
+}
+
+func enqueuePasswordChangedEmail(ctx context.Context, jobs port.BackgroundJobQueue, userID string, changedAt time.Time) error {
+	_, err := jobs.Enqueue(ctx, PasswordChangedEmailJob{UserID: userID, ChangedAt: changedAt}, port.EnqueueOptions{Queue: "email"})
+	return err
+}
+
+// ForgotPasswordUseCase queues a reset email for an address.
+type ForgotPasswordUseCase struct {
+	jobs   port.BackgroundJobQueue
+	logger logger.Logger
+}
+
+func NewForgotPasswordUseCase(jobs port.BackgroundJobQueue, logger logger.Logger) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{jobs: jobs, logger: logger}
+}
+
+// Execute never tells the caller whether the address has an account. Every
+// address gets the same single enqueue, so the response time doesn't tell
+// either; the account is looked up when the job runs.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, input dto.ForgotPasswordInput) {
+	ctx, span := tracing.Start(ctx, "auth.ForgotPassword")
+	defer span.End()
+
+	job := PasswordResetEmailJob{Email: strings.TrimSpace(input.Email)}
+	key := job.Kind() + ":" + strings.ToLower(job.Email)
+	if _, err := uc.jobs.Enqueue(ctx, job, port.EnqueueOptions{Queue: "email", UniqueKey: key}); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to queue password reset email: %v", err)
+	}
+}
+
+// SendPasswordResetEmailUseCase handles PasswordResetEmailJob. Unknown and
+// inactive accounts are skipped, as are accounts sent a link within the
+// cooldown.
+type SendPasswordResetEmailUseCase struct {
+	userRepo     repository.UserRepository
+	tokenRepo    repository.PasswordResetTokenRepository
+	emailService port.EmailService
+	appURL       string
+	ttl          time.Duration
+	cooldown     time.Duration
+}
+
+func NewSendPasswordResetEmailUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.PasswordResetTokenRepository,
+	emailService port.EmailService,
+	appURL string,
+	ttl time.Duration,
+	cooldown time.Duration,
+) *SendPasswordResetEmailUseCase {
+	return &SendPasswordResetEmailUseCase{
+		userRepo: userRepo, tokenRepo: tokenRepo, emailService: emailService,
+		appURL: strings.TrimRight(appURL, "/"), ttl: ttl, cooldown: cooldown,
+	}
+}
+
+func (uc *SendPasswordResetEmailUseCase) Execute(ctx context.Context, job PasswordResetEmailJob) error {
+	ctx, span := tracing.Start(ctx, "auth.SendPasswordResetEmail")
+	defer span.End()
+
+	// The repository reports an unknown address as an error, so a failed
+	// lookup is skipped rather than retried.
+	user, err := uc.userRepo.FindByEmail(ctx, job.Email)
+	if err != nil || user == nil || user.Status != entity.UserStatusActive {
+		return nil
+	}
+	latest, err := uc.tokenRepo.FindLatestByUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if latest != nil && time.Since(latest.CreatedAt) < uc.cooldown {
+		return nil
+	}
+
+	plain, err := utils.RandomHex(32)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	token := &entity.PasswordResetToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(plain),
+		ExpiresAt: now.Add(uc.ttl),
+		CreatedAt: now,
+	}
+	if err := uc.tokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+	_ = uc.tokenRepo.DeleteExpired(ctx)
+
+	return uc.emailService.Send(ctx, port.EmailPasswordReset, port.PasswordResetEmail{
+		Name: user.Name, ResetURL: uc.appURL + "/reset-password?token=" + plain, ExpiresAt: token.ExpiresAt,
+	}, port.EmailRecipient{Email: user.Email, Locale: user.Locale})
+}
+
+// ResetPasswordUseCase sets a new password with a reset token. The token,
+// and every other outstanding one for the user, is spent.
+type ResetPasswordUseCase struct {
+	tokenRepo repository.PasswordResetTokenRepository
+	userRepo  repository.UserRepository
+	tx        port.TxManager
+	jobs      port.BackgroundJobQueue
+}
+
+func NewResetPasswordUseCase(
+	tokenRepo repository.PasswordResetTokenRepository,
+	userRepo repository.UserRepository,
+	tx port.TxManager,
+	jobs port.BackgroundJobQueue,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{tokenRepo: tokenRepo, userRepo: userRepo, tx: tx, jobs: jobs}
+}
+
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input dto.ResetPasswordInput) error {
+	ctx, span := tracing.Start(ctx, "auth.ResetPassword")
+	defer span.End()
+
+	token, err := uc.tokenRepo.FindByTokenHash(ctx, utils.HashToken(input.Token))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if token == nil || !token.IsValid(now) {
+		return errors.NewBadRequestError("invalid or expired reset token")
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.tokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+			return errors.NewBadRequestError("invalid or expired reset token")
+		}
+		user, err := uc.userRepo.FindByID(ctx, token.UserID)
+		if err != nil {
+			return errors.NewNotFoundError("user")
+		}
+		if err := setPassword(user, input.Password, now); err != nil {
+			return err
+		}
+		// The token arrived by email, which proves the address as well as a
+		// verification link would.
+		if !user.IsEmailVerified() {
+			user.EmailVerifiedAt = &now
+		}
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if err := uc.tokenRepo.MarkAllUsed(ctx, user.ID, now); err != nil {
+			return err
+		}
+		return enqueuePasswordChangedEmail(ctx, uc.jobs, user.ID, now)
+	})
+}
+
+// ChangePasswordUseCase changes a signed-in user's password after checking
+// the current one. Other sessions lose their refresh tokens; the caller gets
+// fresh tokens so it stays signed in.
+type ChangePasswordUseCase struct {
+	userRepo   repository.UserRepository
+	jwtService port.JWTService
+	tx         port.TxManager
+	jobs       port.BackgroundJobQueue
+}
+
+func NewChangePasswordUseCase(
+	userRepo repository.UserRepository,
+	jwtService port.JWTService,
+	tx port.TxManager,
+	jobs port.BackgroundJobQueue,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{userRepo: userRepo, jwtService: jwtService, tx: tx, jobs: jobs}
+}
+
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID string, input dto.ChangePasswordInput) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.ChangePassword")
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrUnauthorized
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.CurrentPassword)); err != nil {
+		return nil, errors.NewBadRequestError("current password is incorrect")
+	}
+	if input.NewPassword == input.CurrentPassword {
+		return nil, errors.NewBadRequestError("new password must be different from the current one")
+	}
+
+	now := time.Now()
+	if err := setPassword(user, input.NewPassword, now); err != nil {
+		return nil, err
+	}
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		return enqueuePasswordChangedEmail(ctx, uc.jobs, user.ID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uc.jwtService.GenerateAccessToken(user.ID, string(user.Role))
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := uc.jwtService.GenerateRefreshToken(user.ID, user.TokenVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AuthOutput{
+		AccessToken: access, RefreshToken: refresh,
+		User: dto.UserOutput{
+			ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
+		},
+	}, nil
+}
+
+// SendPasswordChangedEmailUseCase handles PasswordChangedEmailJob.
+type SendPasswordChangedEmailUseCase struct {
+	userRepo     repository.UserRepository
+	emailService port.EmailService
+	appURL       string
+}
+
+func NewSendPasswordChangedEmailUseCase(
+	userRepo repository.UserRepository,
+	emailService port.EmailService,
+	appURL string,
+) *SendPasswordChangedEmailUseCase {
+	return &SendPasswordChangedEmailUseCase{userRepo: userRepo, emailService: emailService, appURL: strings.TrimRight(appURL, "/")}
+}
+
+func (uc *SendPasswordChangedEmailUseCase) Execute(ctx context.Context, job PasswordChangedEmailJob) error {
+	ctx, span := tracing.Start(ctx, "auth.SendPasswordChangedEmail")
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	return uc.emailService.Send(ctx, port.EmailPasswordChanged, port.PasswordChangedEmail{
+		Name: user.Name, ChangedAt: job.ChangedAt, ResetURL: uc.appURL + "/forgot-password",
+	}, port.EmailRecipient{Email: user.Email, Locale: user.Locale})
+}
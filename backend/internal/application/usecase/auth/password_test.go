@@ -0,0 +1,125 @@
+package auth_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	authusecase "github.com/startup-job-board/backend/internal/application/usecase/auth"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func passwordUser(t *testing.T, password string) *memUserRepo {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &memUserRepo{users: map[string]*entity.User{
+		"user-1": {
+			ID: "user-1", Email: "a@b.com", Name: "A", Password: string(hash),
+			Role: entity.UserRoleCandidate, Status: entity.UserStatusActive, TokenVersion: 3,
+		},
+	}}
+}
+
+func TestResetPasswordIsSingleUseAndRevokesSessions(t *testing.T) {
+	ctx := context.Background()
+	users := passwordUser(t, "old-password-123")
+	tokens := &memTokens[entity.PasswordResetToken]{}
+	mail := &captureEmail{}
+	queue := &memQueue{}
+	send := authusecase.NewSendPasswordResetEmailUseCase(users, tokens, mail, "https://joinus.example", time.Hour, 0)
+	reset := authusecase.NewResetPasswordUseCase(tokens, users, inlineTx{}, queue)
+
+	for i := 0; i < 2; i++ {
+		if err := send.Execute(ctx, authusecase.PasswordResetEmailJob{Email: "a@b.com"}); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	link, err := url.Parse(mail.sent[0].(port.PasswordResetEmail).ResetURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := link.Query().Get("token")
+	if plain == "" || tokens.tokens[0].TokenHash == plain {
+		t.Fatalf("token %q must be stored hashed", plain)
+	}
+
+	if err := reset.Execute(ctx, dto.ResetPasswordInput{Token: plain, Password: "new-password-456"}); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	user := users.users["user-1"]
+	if user.TokenVersion != 4 {
+		t.Fatalf("token version = %d, want 4", user.TokenVersion)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte("new-password-456")) != nil {
+		t.Fatal("password was not changed")
+	}
+	if len(queue.jobs) != 1 || queue.jobs[0].Kind() != (authusecase.PasswordChangedEmailJob{}).Kind() {
+		t.Fatalf("queued %v, want one password changed email", queue.jobs)
+	}
+
+	if err := reset.Execute(ctx, dto.ResetPasswordInput{Token: plain, Password: "third-password-789"}); err == nil {
+		t.Fatal("a reset token must not work twice")
+	}
+	second, _ := url.Parse(mail.sent[1].(port.PasswordResetEmail).ResetURL)
+	if err := reset.Execute(ctx, dto.ResetPasswordInput{Token: second.Query().Get("token"), Password: "third-password-789"}); err == nil {
+		t.Fatal("a reset must spend the user's other tokens")
+	}
+}
+
+// TestForgotPasswordQueuesTheSameJobForEveryAddress keeps the request from
+// revealing which addresses have accounts; the job decides.
+func TestForgotPasswordQueuesTheSameJobForEveryAddress(t *testing.T) {
+	ctx := context.Background()
+	users := passwordUser(t, "old-password-123")
+	tokens := &memTokens[entity.PasswordResetToken]{}
+	mail := &captureEmail{}
+	queue := &memQueue{}
+	forgot := authusecase.NewForgotPasswordUseCase(queue, nil)
+	send := authusecase.NewSendPasswordResetEmailUseCase(users, tokens, mail, "https://joinus.example", time.Hour, time.Minute)
+
+	for _, address := range []string{"a@b.com", "nobody@b.com", "a@b.com"} {
+		forgot.Execute(ctx, dto.ForgotPasswordInput{Email: address})
+	}
+	if len(queue.jobs) != 3 {
+		t.Fatalf("queued %d jobs, want one per request", len(queue.jobs))
+	}
+	for _, job := range queue.jobs {
+		if err := send.Execute(ctx, job.(authusecase.PasswordResetEmailJob)); err != nil {
+			t.Fatalf("send %v: %v", job, err)
+		}
+	}
+	// Nothing goes to the unknown address, and the repeat is inside the cooldown.
+	if len(mail.sent) != 1 || len(tokens.tokens) != 1 {
+		t.Fatalf("sent %d emails with %d tokens, want 1", len(mail.sent), len(tokens.tokens))
+	}
+}
+
+func TestChangePasswordChecksCurrentPassword(t *testing.T) {
+	ctx := context.Background()
+	users := passwordUser(t, "old-password-123")
+	queue := &memQueue{}
+	change := authusecase.NewChangePasswordUseCase(users, &mockJWT{}, inlineTx{}, queue)
+
+	_, err := change.Execute(ctx, "user-1", dto.ChangePasswordInput{CurrentPassword: "wrong-password", NewPassword: "new-password-456"})
+	if err == nil || users.users["user-1"].TokenVersion != 3 {
+		t.Fatalf("wrong current password: err=%v version=%d", err, users.users["user-1"].TokenVersion)
+	}
+
+	out, err := change.Execute(ctx, "user-1", dto.ChangePasswordInput{CurrentPassword: "old-password-123", NewPassword: "new-password-456"})
+	if err != nil {
+		t.Fatalf("change: %v", err)
+	}
+	if users.users["user-1"].TokenVersion != 4 || out.RefreshToken == "" {
+		t.Fatalf("version = %d, refresh = %q", users.users["user-1"].TokenVersion, out.RefreshToken)
+	}
+	if len(queue.jobs) != 1 {
+		t.Fatalf("queued %d jobs, want the password changed email", len(queue.jobs))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type RefreshTokenUseCase struct {
@@ -30,6 +31,9 @@ func NewRefreshTokenUseCase(
 }
 
 func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.RefreshToken")
+	defer span.End()
+
 	userID, version, err := uc.jwtService.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return nil, errors.ErrUnauthorized
@@ -68,10 +72,11 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string)
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
 		User: dto.UserOutput{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
+			ID:            user.ID,
+			Email:         user.Email,
+			Name:          user.Name,
+			Role:          string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
 		},
 	}, nil
 }
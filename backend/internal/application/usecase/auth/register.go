@@ -11,28 +11,40 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// RegisterUseCase creates a password account with an unverified email
+// address and queues the verification email in the same transaction.
 type RegisterUseCase struct {
 	userRepo   repository.UserRepository
 	jwtService port.JWTService
+	tx         port.TxManager
+	jobs       port.BackgroundJobQueue
 	logger     logger.Logger
 }
 
 func NewRegisterUseCase(
 	userRepo repository.UserRepository,
 	jwtService port.JWTService,
+	tx port.TxManager,
+	jobs port.BackgroundJobQueue,
 	logger logger.Logger,
 ) *RegisterUseCase {
 	return &RegisterUseCase{
 		userRepo:   userRepo,
 		jwtService: jwtService,
+		tx:         tx,
+		jobs:       jobs,
 		logger:     logger,
 	}
 }
 
 func (uc *RegisterUseCase) Execute(ctx context.Context, input dto.RegisterInput) (*dto.AuthOutput, error) {
+	ctx, span := tracing.Start(ctx, "auth.Register")
+	defer span.End()
+
 	existing, _ := uc.userRepo.FindByEmail(ctx, input.Email)
 	if existing != nil {
 		return nil, errors.NewBadRequestError("user with this email already exists")
@@ -50,11 +62,18 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input dto.RegisterInput)
 		Name:      input.Name,
 		Role:      entity.UserRoleCandidate,
 		Status:    entity.UserStatusActive,
+		Locale:    input.Locale,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	if err := uc.userRepo.Create(ctx, user); err != nil {
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		return enqueueVerificationEmail(ctx, uc.jobs, user.ID)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -72,6 +91,7 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input dto.RegisterInput)
 		RefreshToken: refresh,
 		User: dto.UserOutput{
 			ID: user.ID, Email: user.Email, Name: user.Name, Role: string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
 		},
 	}, nil
 }
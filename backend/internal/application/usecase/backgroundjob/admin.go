@@ -0,0 +1,142 @@
+package backgroundjob
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+type ListJobsUseCase struct {
+	jobRepo     repository.BackgroundJobRepository
+	authService *service.AuthorizationService
+}
+
+func NewListJobsUseCase(jobRepo repository.BackgroundJobRepository, authService *service.AuthorizationService) *ListJobsUseCase {
+	return &ListJobsUseCase{jobRepo: jobRepo, authService: authService}
+}
+
+func (uc *ListJobsUseCase) Execute(ctx context.Context, actorID string, filter dto.BackgroundJobFilter, page, pageSize int) ([]*dto.BackgroundJobOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "backgroundjob.ListJobs")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, 0, errors.NewForbiddenError("platform admin required")
+	}
+	jobs, total, err := uc.jobRepo.List(ctx, repository.BackgroundJobFilter{
+		Queue: filter.Queue, Kind: filter.Kind, Status: entity.BackgroundJobStatus(filter.Status),
+	}, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]*dto.BackgroundJobOutput, len(jobs))
+	for i, j := range jobs {
+		out[i] = toJobOutput(j)
+	}
+	return out, total, nil
+}
+
+// RetryJobUseCase queues a dead or cancelled job again, due now, with its
+// attempts reset.
+type RetryJobUseCase struct {
+	jobRepo     repository.BackgroundJobRepository
+	authService *service.AuthorizationService
+}
+
+func NewRetryJobUseCase(jobRepo repository.BackgroundJobRepository, authService *service.AuthorizationService) *RetryJobUseCase {
+	return &RetryJobUseCase{jobRepo: jobRepo, authService: authService}
+}
+
+func (uc *RetryJobUseCase) Execute(ctx context.Context, actorID, jobID string) (*dto.BackgroundJobOutput, error) {
+	ctx, span := tracing.Start(ctx, "backgroundjob.RetryJob")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	job, err := uc.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("background job")
+	}
+	if !job.CanRetry() {
+		return nil, errors.NewBadRequestError("only dead or cancelled jobs can be retried")
+	}
+	requeued, err := uc.jobRepo.Requeue(ctx, job.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !requeued {
+		return nil, errors.NewBadRequestError("job changed or another active job has the same unique key")
+	}
+	return findJobOutput(ctx, uc.jobRepo, job.ID)
+}
+
+// CancelJobUseCase stops a queued or running job. A running attempt is not
+// interrupted, but its result is discarded and it is not retried.
+type CancelJobUseCase struct {
+	jobRepo     repository.BackgroundJobRepository
+	authService *service.AuthorizationService
+}
+
+func NewCancelJobUseCase(jobRepo repository.BackgroundJobRepository, authService *service.AuthorizationService) *CancelJobUseCase {
+	return &CancelJobUseCase{jobRepo: jobRepo, authService: authService}
+}
+
+func (uc *CancelJobUseCase) Execute(ctx context.Context, actorID, jobID string) (*dto.BackgroundJobOutput, error) {
+	ctx, span := tracing.Start(ctx, "backgroundjob.CancelJob")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	job, err := uc.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("background job")
+	}
+	if !job.IsActive() {
+		return nil, errors.NewBadRequestError("only queued or running jobs can be cancelled")
+	}
+	cancelled, err := uc.jobRepo.Cancel(ctx, job.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		return nil, errors.NewBadRequestError("job finished before it could be cancelled")
+	}
+	return findJobOutput(ctx, uc.jobRepo, job.ID)
+}
+
+func findJobOutput(ctx context.Context, jobRepo repository.BackgroundJobRepository, id string) (*dto.BackgroundJobOutput, error) {
+	job, err := jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toJobOutput(job), nil
+}
+
+func toJobOutput(j *entity.BackgroundJob) *dto.BackgroundJobOutput {
+	out := &dto.BackgroundJobOutput{
+		ID: j.ID, Queue: j.Queue, Kind: j.Kind, Status: string(j.Status), Attempts: j.Attempts,
+		MaxAttempts: j.MaxAttempts, RunAt: j.RunAt.Format(time.RFC3339), UniqueKey: j.UniqueKey,
+		LastError: j.LastError, CreatedAt: j.CreatedAt.Format(time.RFC3339), UpdatedAt: j.UpdatedAt.Format(time.RFC3339),
+		Payload: json.RawMessage(j.Payload),
+	}
+	if j.StartedAt != nil {
+		started := j.StartedAt.Format(time.RFC3339)
+		out.StartedAt = &started
+	}
+	if j.FinishedAt != nil {
+		finished := j.FinishedAt.Format(time.RFC3339)
+		out.FinishedAt = &finished
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package backgroundjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+)
+
+// DefaultQueue is the queue jobs go to unless EnqueueOptions names another.
+const DefaultQueue = "default"
+
+// EnqueueJobUseCase implements port.BackgroundJobQueue on the
+// background_jobs table; RunJobsUseCase runs what it stores.
+type EnqueueJobUseCase struct {
+	jobRepo     repository.BackgroundJobRepository
+	maxAttempts int
+}
+
+func NewEnqueueJobUseCase(jobRepo repository.BackgroundJobRepository, maxAttempts int) *EnqueueJobUseCase {
+	return &EnqueueJobUseCase{jobRepo: jobRepo, maxAttempts: maxAttempts}
+}
+
+var _ port.BackgroundJobQueue = (*EnqueueJobUseCase)(nil)
+
+func (uc *EnqueueJobUseCase) Enqueue(ctx context.Context, args port.BackgroundJobArgs, opts port.EnqueueOptions) (bool, error) {
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return false, fmt.Errorf("encode %s job: %w", args.Kind(), err)
+	}
+	now := time.Now()
+	job := &entity.BackgroundJob{
+		ID: uuid.New().String(), Queue: opts.Queue, Kind: args.Kind(), Payload: string(payload),
+		Status: entity.BackgroundJobQueued, MaxAttempts: opts.MaxAttempts, RunAt: opts.RunAt,
+		CreatedAt: now, UpdatedAt: now,
+	}
+	if job.Queue == "" {
+		job.Queue = DefaultQueue
+	}
+	if job.MaxAttempts <= 0 {
+		job.MaxAttempts = uc.maxAttempts
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = now
+	}
+	if opts.UniqueKey != "" {
+		job.UniqueKey = &opts.UniqueKey
+	}
+	return uc.jobRepo.Create(ctx, job)
+}
@@ -0,0 +1,43 @@
+package backgroundjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+type handlerFunc func(ctx context.Context, payload []byte) error
+
+// Handlers maps each job kind to the code that runs it. Handlers are
+// registered once at startup, before the worker starts.
+type Handlers struct {
+	byKind map[string]handlerFunc
+}
+
+func NewHandlers() *Handlers {
+	return &Handlers{byKind: map[string]handlerFunc{}}
+}
+
+// Register adds the handler for the kind of T, decoding each job's payload
+// into a T before calling handle. Registering a kind twice panics.
+func Register[T port.BackgroundJobArgs](h *Handlers, handle func(ctx context.Context, args T) error) {
+	var zero T
+	kind := zero.Kind()
+	if _, dup := h.byKind[kind]; dup {
+		panic("backgroundjob: handler for " + kind + " registered twice")
+	}
+	h.byKind[kind] = func(ctx context.Context, payload []byte) error {
+		var args T
+		if err := json.Unmarshal(payload, &args); err != nil {
+			return fmt.Errorf("decode %s job: %w", kind, err)
+		}
+		return handle(ctx, args)
+	}
+}
+
+func (h *Handlers) lookup(kind string) (handlerFunc, bool) {
+	fn, ok := h.byKind[kind]
+	return fn, ok
+}
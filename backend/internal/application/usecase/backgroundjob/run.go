@@ -0,0 +1,208 @@
+package backgroundjob
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const (
+	retryBase = 10 * time.Second
+	retryMax  = 6 * time.Hour
+	// leaseMargin is how long past its timeout a job stays leased, so a slow
+	// Finish is not mistaken for a lost worker.
+	leaseMargin = 30 * time.Second
+)
+
+// retryDelay is the exponential backoff after the given (1-based) failed attempt:
+// 10s, 20s, 40s, ... capped at 6h.
+func retryDelay(attempt int) time.Duration {
+	d := retryBase
+	for i := 1; i < attempt && d < retryMax; i++ {
+		d *= 2
+	}
+	if d > retryMax {
+		d = retryMax
+	}
+	return d
+}
+
+// RunJobsUseCase is the queue worker. Each Execute claims as many due jobs
+// per queue as the queue's concurrency limit leaves room for and runs them
+// in the background; a failed job is retried with backoff until it runs out
+// of attempts and is marked dead. Limits apply per process.
+type RunJobsUseCase struct {
+	jobRepo  repository.BackgroundJobRepository
+	handlers *Handlers
+	queues   map[string]int // queue name to concurrency limit
+	timeout  time.Duration
+	logger   logger.Logger
+
+	mu       sync.Mutex
+	inflight map[string]int
+	wg       sync.WaitGroup
+}
+
+func NewRunJobsUseCase(
+	jobRepo repository.BackgroundJobRepository,
+	handlers *Handlers,
+	queues map[string]int,
+	timeout time.Duration,
+	logger logger.Logger,
+) *RunJobsUseCase {
+	return &RunJobsUseCase{
+		jobRepo: jobRepo, handlers: handlers, queues: queues, timeout: timeout, logger: logger,
+		inflight: map[string]int{},
+	}
+}
+
+func (uc *RunJobsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "backgroundjob.RunJobs")
+	defer span.End()
+
+	for queue, limit := range uc.queues {
+		if err := uc.claim(ctx, queue, limit); err != nil {
+			return fmt.Errorf("queue %s: %w", queue, err)
+		}
+	}
+	return nil
+}
+
+// Wait blocks until running jobs finish or ctx is done. Jobs left running
+// are claimed again by another worker once their lease expires.
+func (uc *RunJobsUseCase) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		uc.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (uc *RunJobsUseCase) claim(ctx context.Context, queue string, limit int) error {
+	uc.mu.Lock()
+	free := limit - uc.inflight[queue]
+	uc.mu.Unlock()
+	if free <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	jobs, err := uc.jobRepo.ClaimDue(ctx, queue, now, now.Add(uc.timeout+leaseMargin), free)
+	if err != nil {
+		return err
+	}
+	uc.mu.Lock()
+	uc.inflight[queue] += len(jobs)
+	uc.mu.Unlock()
+	for _, job := range jobs {
+		uc.wg.Add(1)
+		// Shutdown waits for running jobs rather than cutting them short.
+		go uc.run(context.WithoutCancel(ctx), job)
+	}
+	return nil
+}
+
+func (uc *RunJobsUseCase) run(ctx context.Context, job *entity.BackgroundJob) {
+	defer func() {
+		uc.mu.Lock()
+		uc.inflight[job.Queue]--
+		uc.mu.Unlock()
+		uc.wg.Done()
+	}()
+	ctx = logger.ContextWith(ctx, "job_id", job.ID, "job_kind", job.Kind)
+	ctx, span := tracing.Start(ctx, "backgroundjob.RunJob")
+	defer span.End()
+
+	var err error
+	if job.Attempts > job.MaxAttempts {
+		// A worker died holding the lease on the final attempt.
+		err = fmt.Errorf("lease expired on the final attempt")
+	} else {
+		err = uc.perform(ctx, job)
+	}
+
+	now := time.Now()
+	job.UpdatedAt = now
+	job.LockedUntil = nil
+	job.LastError = ""
+	switch {
+	case err == nil:
+		job.Status = entity.BackgroundJobSucceeded
+		job.FinishedAt = &now
+	case job.Attempts >= job.MaxAttempts:
+		job.Status = entity.BackgroundJobDead
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+		uc.logger.WithContext(ctx).Error("Background job %s (%s) is dead after %d attempts: %v", job.ID, job.Kind, job.Attempts, err)
+	default:
+		job.Status = entity.BackgroundJobQueued
+		job.LastError = err.Error()
+		job.RunAt = now.Add(retryDelay(job.Attempts))
+		uc.logger.WithContext(ctx).Warn("Background job %s (%s) failed (attempt %d), retrying: %v", job.ID, job.Kind, job.Attempts, err)
+	}
+	// A job cancelled or claimed again meanwhile keeps its newer state.
+	finished, err := uc.jobRepo.Finish(ctx, job)
+	if err != nil {
+		// The lease expires and the job runs again.
+		uc.logger.WithContext(ctx).Error("Background job %s: failed to record result: %v", job.ID, err)
+	} else if !finished {
+		uc.logger.WithContext(ctx).Info("Background job %s changed while running; result discarded", job.ID)
+	}
+}
+
+// perform runs the job's handler under the job timeout, turning a panic
+// into an error so one bad handler can't take the worker down.
+func (uc *RunJobsUseCase) perform(ctx context.Context, job *entity.BackgroundJob) (err error) {
+	handle, ok := uc.handlers.lookup(job.Kind)
+	if !ok {
+		// Retried rather than failed outright: during a rolling deploy an
+		// older worker may claim a kind only newer ones know.
+		return fmt.Errorf("no handler for job kind %q", job.Kind)
+	}
+	ctx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handle(ctx, []byte(job.Payload))
+}
+
+// PruneJobsUseCase deletes succeeded and cancelled jobs once they are older
+// than the retention period; dead ones are kept for inspection.
+type PruneJobsUseCase struct {
+	jobRepo   repository.BackgroundJobRepository
+	retention time.Duration
+	logger    logger.Logger
+}
+
+func NewPruneJobsUseCase(jobRepo repository.BackgroundJobRepository, retention time.Duration, logger logger.Logger) *PruneJobsUseCase {
+	return &PruneJobsUseCase{jobRepo: jobRepo, retention: retention, logger: logger}
+}
+
+func (uc *PruneJobsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "backgroundjob.PruneJobs")
+	defer span.End()
+
+	n, err := uc.jobRepo.DeleteFinishedBefore(ctx, time.Now().Add(-uc.retention))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		uc.logger.WithContext(ctx).Info("Background jobs: pruned %d finished jobs", n)
+	}
+	return nil
+}
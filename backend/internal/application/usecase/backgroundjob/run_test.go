@@ -0,0 +1,202 @@
+package backgroundjob_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/application/usecase/backgroundjob"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// memoryJobs is a BackgroundJobRepository with the claim and finish rules of
+// the Postgres one, minus unique keys and leases.
+type memoryJobs struct {
+	mu   sync.Mutex
+	jobs []*entity.BackgroundJob
+}
+
+func (m *memoryJobs) Create(_ context.Context, job *entity.BackgroundJob) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs = append(m.jobs, job)
+	return true, nil
+}
+
+func (m *memoryJobs) FindByID(_ context.Context, id string) (*entity.BackgroundJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, j := range m.jobs {
+		if j.ID == id {
+			copied := *j
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *memoryJobs) List(context.Context, repository.BackgroundJobFilter, int, int) ([]*entity.BackgroundJob, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *memoryJobs) ClaimDue(_ context.Context, queue string, now, lockUntil time.Time, limit int) ([]*entity.BackgroundJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var due []*entity.BackgroundJob
+	for _, j := range m.jobs {
+		if j.Queue == queue && j.Status == entity.BackgroundJobQueued && !j.RunAt.After(now) && len(due) < limit {
+			j.Status = entity.BackgroundJobRunning
+			j.Attempts++
+			j.LockedUntil = &lockUntil
+			copied := *j
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (m *memoryJobs) Finish(_ context.Context, job *entity.BackgroundJob) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, j := range m.jobs {
+		if j.ID == job.ID && j.Status == entity.BackgroundJobRunning && j.Attempts == job.Attempts {
+			copied := *job
+			m.jobs[i] = &copied
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryJobs) Requeue(context.Context, string, time.Time) (bool, error) { return false, nil }
+
+func (m *memoryJobs) Cancel(_ context.Context, id string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, j := range m.jobs {
+		if j.ID == id && j.IsActive() {
+			j.Status = entity.BackgroundJobCancelled
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryJobs) DeleteFinishedBefore(context.Context, time.Time) (int64, error) { return 0, nil }
+
+// makeDue pulls every retry forward so the next run picks it up.
+func (m *memoryJobs) makeDue() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, j := range m.jobs {
+		j.RunAt = time.Time{}
+	}
+}
+
+type greetArgs struct {
+	Name string `json:"name"`
+}
+
+func (greetArgs) Kind() string { return "test.greet" }
+
+func runOnce(t *testing.T, worker *backgroundjob.RunJobsUseCase) {
+	t.Helper()
+	if err := worker.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := worker.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorkerRetriesThenMarksJobDead(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryJobs{}
+	queue := backgroundjob.NewEnqueueJobUseCase(repo, 3)
+	if _, err := queue.Enqueue(ctx, greetArgs{Name: "ada"}, port.EnqueueOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var seen []string
+	handlers := backgroundjob.NewHandlers()
+	backgroundjob.Register(handlers, func(_ context.Context, args greetArgs) error {
+		seen = append(seen, args.Name)
+		return errors.New("smtp unavailable")
+	})
+	worker := backgroundjob.NewRunJobsUseCase(repo, handlers, map[string]int{backgroundjob.DefaultQueue: 2}, time.Minute,
+		logger.New(logger.Options{Output: io.Discard}))
+
+	for run := 0; run < 5; run++ {
+		runOnce(t, worker)
+		if got := repo.jobs[0]; run == 0 && (got.Status != entity.BackgroundJobQueued || !got.RunAt.After(time.Now())) {
+			t.Fatalf("after the first failure job = %+v, want queued with a later run_at", got)
+		}
+		repo.makeDue()
+	}
+
+	got := repo.jobs[0]
+	if got.Status != entity.BackgroundJobDead || got.Attempts != 3 || got.LastError != "smtp unavailable" || got.FinishedAt == nil {
+		t.Fatalf("job = %+v", got)
+	}
+	if len(seen) != 3 || seen[0] != "ada" {
+		t.Fatalf("handler saw %v", seen)
+	}
+}
+
+func TestWorkerLimitsConcurrencyPerQueueAndKeepsCancellation(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryJobs{}
+	queue := backgroundjob.NewEnqueueJobUseCase(repo, 3)
+	for _, name := range []string{"first", "second"} {
+		if _, err := queue.Enqueue(ctx, greetArgs{Name: name}, port.EnqueueOptions{Queue: "email"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	handlers := backgroundjob.NewHandlers()
+	backgroundjob.Register(handlers, func(_ context.Context, args greetArgs) error {
+		started <- args.Name
+		<-release
+		return nil
+	})
+	worker := backgroundjob.NewRunJobsUseCase(repo, handlers, map[string]int{"email": 1}, time.Minute,
+		logger.New(logger.Options{Output: io.Discard}))
+
+	if err := worker.Execute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if name := <-started; name != "first" {
+		t.Fatalf("started %s first", name)
+	}
+	// The queue is full, so this run must not claim the second job.
+	if err := worker.Execute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Cancel(ctx, repo.jobs[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	if err := worker.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := repo.jobs[0].Status; got != entity.BackgroundJobCancelled {
+		t.Fatalf("cancelled job ended %s", got)
+	}
+	if got := repo.jobs[1]; got.Status != entity.BackgroundJobQueued || got.Attempts != 0 {
+		t.Fatalf("second job = %+v, want untouched", got)
+	}
+
+	runOnce(t, worker)
+	if got := repo.jobs[1]; got.Status != entity.BackgroundJobSucceeded || got.Attempts != 1 {
+		t.Fatalf("second job = %+v", got)
+	}
+}
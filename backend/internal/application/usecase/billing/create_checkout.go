@@ -11,6 +11,7 @@ import (
 	"github.com/startup-job-board/backend/internal/infrastructure/payment"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 const (
@@ -54,6 +55,9 @@ func NewCreateCheckoutUseCase(
 }
 
 func (uc *CreateCheckoutUseCase) Execute(ctx context.Context, input dto.CreateCheckoutInput, userID string) (*dto.CheckoutOutput, error) {
+	ctx, span := tracing.Start(ctx, "billing.CreateCheckout")
+	defer span.End()
+
 	switch input.Product {
 	case ProductJobBoost:
 		return uc.checkoutJobBoost(ctx, input, userID)
@@ -89,7 +93,7 @@ func (uc *CreateCheckoutUseCase) checkoutJobBoost(ctx context.Context, input dto
 		customerEmail = user.Email
 	}
 
-	result, err := uc.stripeClient.CreateCheckoutSession(payment.CheckoutSessionInput{
+	result, err := uc.stripeClient.CreateCheckoutSession(ctx, payment.CheckoutSessionInput{
 		Mode:          "payment",
 		PriceID:       uc.stripeCfg.PriceJobBoost,
 		SuccessURL:    uc.appURL + "/billing/success?session_id={CHECKOUT_SESSION_ID}",
@@ -103,7 +107,7 @@ func (uc *CreateCheckoutUseCase) checkoutJobBoost(ctx context.Context, input dto
 		},
 	})
 	if err != nil {
-		uc.logger.Error("Failed to create job boost checkout session: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to create job boost checkout session: %v", err)
 		return nil, errors.NewBadRequestError("failed to create checkout session")
 	}
 
@@ -158,9 +162,9 @@ func (uc *CreateCheckoutUseCase) checkoutStartupPro(ctx context.Context, input d
 		}
 	}
 
-	result, err := uc.stripeClient.CreateCheckoutSession(sessionInput)
+	result, err := uc.stripeClient.CreateCheckoutSession(ctx, sessionInput)
 	if err != nil {
-		uc.logger.Error("Failed to create startup pro checkout session: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to create startup pro checkout session: %v", err)
 		return nil, errors.NewBadRequestError("failed to create checkout session")
 	}
 
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/infrastructure/payment"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/stripe/stripe-go/v82"
 )
 
@@ -26,6 +28,10 @@ type HandleWebhookUseCase struct {
 	stripeClient *payment.StripeClient
 	jobRepo      repository.JobRepository
 	startupRepo  repository.StartupRepository
+	tx           port.TxManager
+	events       port.EventRecorder
+	cache        port.PublicCache
+	metrics      port.Metrics
 	logger       logger.Logger
 }
 
@@ -33,41 +39,59 @@ func NewHandleWebhookUseCase(
 	stripeClient *payment.StripeClient,
 	jobRepo repository.JobRepository,
 	startupRepo repository.StartupRepository,
+	tx port.TxManager,
+	events port.EventRecorder,
+	cache port.PublicCache,
+	metrics port.Metrics,
 	logger logger.Logger,
 ) *HandleWebhookUseCase {
 	return &HandleWebhookUseCase{
 		stripeClient: stripeClient,
 		jobRepo:      jobRepo,
 		startupRepo:  startupRepo,
+		tx:           tx,
+		events:       events,
+		cache:        cache,
+		metrics:      metrics,
 		logger:       logger,
 	}
 }
 
 func (uc *HandleWebhookUseCase) Execute(ctx context.Context, payload []byte, signatureHeader string) error {
+	ctx, span := tracing.Start(ctx, "billing.HandleWebhook")
+	defer span.End()
+
 	event, err := uc.stripeClient.ConstructWebhookEvent(payload, signatureHeader)
 	if err != nil {
+		uc.metrics.StripeWebhookHandled("unknown", "invalid_signature")
 		return errors.NewBadRequestError("invalid webhook signature")
 	}
 
+	outcome := "processed"
 	switch event.Type {
 	case stripe.EventTypeCheckoutSessionCompleted:
-		return uc.handleCheckoutCompleted(ctx, event)
+		err = uc.handleCheckoutCompleted(ctx, event)
 	case stripe.EventTypeCustomerSubscriptionUpdated:
-		return uc.handleSubscriptionUpdated(ctx, event)
+		err = uc.handleSubscriptionUpdated(ctx, event)
 	case stripe.EventTypeCustomerSubscriptionDeleted:
-		return uc.handleSubscriptionDeleted(ctx, event)
+		err = uc.handleSubscriptionDeleted(ctx, event)
 	case stripe.EventTypeInvoicePaymentSucceeded:
-		return uc.handleInvoicePaymentSucceeded(ctx, event)
+		err = uc.handleInvoicePaymentSucceeded(ctx, event)
 	default:
 		// Unhandled event types are acknowledged without action.
-		return nil
+		outcome = "ignored"
+	}
+	if err != nil {
+		outcome = "failed"
 	}
+	uc.metrics.StripeWebhookHandled(string(event.Type), outcome)
+	return err
 }
 
 func (uc *HandleWebhookUseCase) handleCheckoutCompleted(ctx context.Context, event stripe.Event) error {
 	var session stripe.CheckoutSession
 	if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
-		uc.logger.Error("Failed to parse checkout session: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to parse checkout session: %v", err)
 		return err
 	}
 
@@ -77,7 +101,7 @@ func (uc *HandleWebhookUseCase) handleCheckoutCompleted(ctx context.Context, eve
 	case ProductStartupPro:
 		return uc.provisionStartupPro(ctx, session)
 	default:
-		uc.logger.Warn("Checkout session completed with unknown product metadata: %v", session.Metadata)
+		uc.logger.WithContext(ctx).Warn("Checkout session completed with unknown product metadata: %v", session.Metadata)
 		return nil
 	}
 }
@@ -90,7 +114,7 @@ func (uc *HandleWebhookUseCase) provisionJobBoost(ctx context.Context, session s
 
 	job, err := uc.jobRepo.FindByID(ctx, jobID)
 	if err != nil {
-		uc.logger.Error("Job boost webhook: job %s not found: %v", jobID, err)
+		uc.logger.WithContext(ctx).Error("Job boost webhook: job %s not found: %v", jobID, err)
 		return err
 	}
 
@@ -98,12 +122,23 @@ func (uc *HandleWebhookUseCase) provisionJobBoost(ctx context.Context, session s
 	job.BoostedUntil = &boostedUntil
 	job.UpdatedAt = time.Now()
 
-	if err := uc.jobRepo.Update(ctx, job); err != nil {
-		uc.logger.Error("Failed to update job boost for %s: %v", jobID, err)
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.jobRepo.Update(ctx, job); err != nil {
+			return err
+		}
+		return uc.events.Record(ctx, &entity.DomainEvent{
+			Type: entity.DomainEventJobBoosted, AggregateType: "job", AggregateID: job.ID, StartupID: job.StartupID,
+		}, map[string]interface{}{
+			"job_id": job.ID, "startup_id": job.StartupID, "boosted_until": boostedUntil.Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to update job boost for %s: %v", jobID, err)
 		return err
 	}
+	uc.cache.Invalidate(port.CacheScopeJobs)
 
-	uc.logger.Info("Job %s boosted until %s", jobID, boostedUntil.Format(time.RFC3339))
+	uc.logger.WithContext(ctx).Info("Job %s boosted until %s", jobID, boostedUntil.Format(time.RFC3339))
 	return nil
 }
 
@@ -115,10 +150,11 @@ func (uc *HandleWebhookUseCase) provisionStartupPro(ctx context.Context, session
 
 	startup, err := uc.startupRepo.FindByID(ctx, startupID)
 	if err != nil {
-		uc.logger.Error("Startup pro webhook: startup %s not found: %v", startupID, err)
+		uc.logger.WithContext(ctx).Error("Startup pro webhook: startup %s not found: %v", startupID, err)
 		return err
 	}
 
+	previousPlan := startup.Plan
 	planExpiresAt := time.Now().Add(startupProDuration)
 	startup.Plan = string(entity.StartupPlanPro)
 	startup.PlanExpiresAt = &planExpiresAt
@@ -130,19 +166,20 @@ func (uc *HandleWebhookUseCase) provisionStartupPro(ctx context.Context, session
 	}
 	startup.UpdatedAt = time.Now()
 
-	if err := uc.startupRepo.Update(ctx, startup); err != nil {
-		uc.logger.Error("Failed to activate startup pro for %s: %v", startupID, err)
+	if err := uc.saveStartup(ctx, startup, previousPlan); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to activate startup pro for %s: %v", startupID, err)
 		return err
 	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 
-	uc.logger.Info("Startup %s upgraded to Pro until %s", startupID, planExpiresAt.Format(time.RFC3339))
+	uc.logger.WithContext(ctx).Info("Startup %s upgraded to Pro until %s", startupID, planExpiresAt.Format(time.RFC3339))
 	return nil
 }
 
 func (uc *HandleWebhookUseCase) handleSubscriptionUpdated(ctx context.Context, event stripe.Event) error {
 	var sub stripe.Subscription
 	if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
-		uc.logger.Error("Failed to parse subscription: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to parse subscription: %v", err)
 		return err
 	}
 
@@ -152,6 +189,7 @@ func (uc *HandleWebhookUseCase) handleSubscriptionUpdated(ctx context.Context, e
 		return nil
 	}
 
+	previousPlan := startup.Plan
 	switch sub.Status {
 	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
 		planExpiresAt := time.Now().Add(startupProDuration)
@@ -163,10 +201,11 @@ func (uc *HandleWebhookUseCase) handleSubscriptionUpdated(ctx context.Context, e
 	}
 	startup.UpdatedAt = time.Now()
 
-	if err := uc.startupRepo.Update(ctx, startup); err != nil {
-		uc.logger.Error("Failed to sync subscription update for startup %s: %v", startup.ID, err)
+	if err := uc.saveStartup(ctx, startup, previousPlan); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to sync subscription update for startup %s: %v", startup.ID, err)
 		return err
 	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 
 	return nil
 }
@@ -174,7 +213,7 @@ func (uc *HandleWebhookUseCase) handleSubscriptionUpdated(ctx context.Context, e
 func (uc *HandleWebhookUseCase) handleSubscriptionDeleted(ctx context.Context, event stripe.Event) error {
 	var sub stripe.Subscription
 	if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
-		uc.logger.Error("Failed to parse subscription: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to parse subscription: %v", err)
 		return err
 	}
 
@@ -183,23 +222,25 @@ func (uc *HandleWebhookUseCase) handleSubscriptionDeleted(ctx context.Context, e
 		return nil
 	}
 
+	previousPlan := startup.Plan
 	startup.Plan = string(entity.StartupPlanFree)
 	startup.PlanExpiresAt = nil
 	startup.UpdatedAt = time.Now()
 
-	if err := uc.startupRepo.Update(ctx, startup); err != nil {
-		uc.logger.Error("Failed to downgrade startup %s after subscription deletion: %v", startup.ID, err)
+	if err := uc.saveStartup(ctx, startup, previousPlan); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to downgrade startup %s after subscription deletion: %v", startup.ID, err)
 		return err
 	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 
-	uc.logger.Info("Startup %s downgraded to Free (subscription canceled)", startup.ID)
+	uc.logger.WithContext(ctx).Info("Startup %s downgraded to Free (subscription canceled)", startup.ID)
 	return nil
 }
 
 func (uc *HandleWebhookUseCase) handleInvoicePaymentSucceeded(ctx context.Context, event stripe.Event) error {
 	var invoice stripe.Invoice
 	if err := json.Unmarshal(event.Data.Raw, &invoice); err != nil {
-		uc.logger.Error("Failed to parse invoice: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to parse invoice: %v", err)
 		return err
 	}
 
@@ -214,19 +255,44 @@ func (uc *HandleWebhookUseCase) handleInvoicePaymentSucceeded(ctx context.Contex
 	}
 
 	// Renewal payment succeeded: extend the plan for another billing cycle.
+	previousPlan := startup.Plan
 	planExpiresAt := time.Now().Add(startupProDuration)
 	startup.Plan = string(entity.StartupPlanPro)
 	startup.PlanExpiresAt = &planExpiresAt
 	startup.UpdatedAt = time.Now()
 
-	if err := uc.startupRepo.Update(ctx, startup); err != nil {
-		uc.logger.Error("Failed to extend startup pro plan for %s: %v", startup.ID, err)
+	if err := uc.saveStartup(ctx, startup, previousPlan); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to extend startup pro plan for %s: %v", startup.ID, err)
 		return err
 	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 
 	return nil
 }
 
+// saveStartup updates the startup and, in the same transaction, records
+// startup.plan_changed when the plan tier actually changed (renewals that
+// just extend the expiry are not reported).
+func (uc *HandleWebhookUseCase) saveStartup(ctx context.Context, startup *entity.Startup, previousPlan string) error {
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.startupRepo.Update(ctx, startup); err != nil {
+			return err
+		}
+		if startup.Plan == previousPlan {
+			return nil
+		}
+		data := map[string]interface{}{
+			"startup_id": startup.ID, "plan": startup.Plan, "previous_plan": previousPlan, "plan_expires_at": nil,
+		}
+		if startup.PlanExpiresAt != nil {
+			data["plan_expires_at"] = startup.PlanExpiresAt.Format(time.RFC3339)
+		}
+		return uc.events.Record(ctx, &entity.DomainEvent{
+			Type: entity.DomainEventStartupPlanChanged, AggregateType: "startup", AggregateID: startup.ID, StartupID: startup.ID,
+		}, data)
+	})
+}
+
 // invoiceSubscriptionID extracts the related subscription ID from an invoice,
 // accounting for the API's move of this reference under Parent.SubscriptionDetails.
 func invoiceSubscriptionID(invoice *stripe.Invoice) string {
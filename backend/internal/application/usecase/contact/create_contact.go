@@ -9,6 +9,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type CreateContactUseCase struct {
@@ -27,6 +28,9 @@ func NewCreateContactUseCase(
 }
 
 func (uc *CreateContactUseCase) Execute(ctx context.Context, input dto.CreateContactInput) (*dto.ContactOutput, error) {
+	ctx, span := tracing.Start(ctx, "contact.CreateContact")
+	defer span.End()
+
 	now := time.Now()
 
 	contact := &entity.Contact{
@@ -40,7 +44,7 @@ func (uc *CreateContactUseCase) Execute(ctx context.Context, input dto.CreateCon
 	}
 
 	if err := uc.contactRepo.Create(ctx, contact); err != nil {
-		uc.logger.Error("Failed to create contact: %v", err)
+		uc.logger.WithContext(ctx).Error("Failed to create contact: %v", err)
 		return nil, err
 	}
 
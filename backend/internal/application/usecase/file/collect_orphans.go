@@ -0,0 +1,191 @@
+package file
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const (
+	orphanBatchSize = 100
+	// strayMinAge skips objects that may belong to an upload still in flight.
+	strayMinAge     = time.Hour
+	strayKeyBatch   = 500
+	strayReportKeys = 1000
+)
+
+// CollectOrphanFilesUseCase is the periodic file GC. It deletes files nothing
+// has referenced for the grace period, abandoned direct uploads, and logs
+// objects in storage that have no record at all (those are never deleted
+// automatically).
+type CollectOrphanFilesUseCase struct {
+	fileRepo       repository.FileRepository
+	refRepo        repository.FileReferenceRepository
+	intentRepo     repository.UploadIntentRepository
+	storageService port.StorageService
+	grace          time.Duration
+	logger         logger.Logger
+}
+
+func NewCollectOrphanFilesUseCase(
+	fileRepo repository.FileRepository,
+	refRepo repository.FileReferenceRepository,
+	intentRepo repository.UploadIntentRepository,
+	storageService port.StorageService,
+	grace time.Duration,
+	logger logger.Logger,
+) *CollectOrphanFilesUseCase {
+	return &CollectOrphanFilesUseCase{
+		fileRepo: fileRepo, refRepo: refRepo, intentRepo: intentRepo,
+		storageService: storageService, grace: grace, logger: logger,
+	}
+}
+
+func (uc *CollectOrphanFilesUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "file.CollectOrphanFiles")
+	defer span.End()
+
+	if uc.storageService == nil {
+		return nil
+	}
+	// Reference writes on logo updates are best effort; catch up first so a
+	// missed write can't get a live logo deleted.
+	if _, err := uc.refRepo.ReconcileStartupLogos(ctx); err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-uc.grace)
+	files, err := uc.fileRepo.ListUnreferenced(ctx, cutoff, orphanBatchSize)
+	if err != nil {
+		return err
+	}
+	deleted := 0
+	for _, f := range files {
+		if inUse, err := uc.refRepo.ExistsForFile(ctx, f.ID); err != nil || inUse {
+			continue
+		}
+		// Objects first: if that fails the row stays and the next run retries.
+		if err := deleteObjects(ctx, uc.storageService, f); err != nil {
+			uc.logger.WithContext(ctx).Warn("Failed to delete objects of orphan file %s: %v", f.ID, err)
+			continue
+		}
+		if err := uc.fileRepo.Delete(ctx, f.ID); err != nil {
+			uc.logger.WithContext(ctx).Warn("Failed to delete orphan file %s: %v", f.ID, err)
+			continue
+		}
+		deleted++
+	}
+
+	intents, err := uc.intentRepo.ListStale(ctx, cutoff, orphanBatchSize)
+	if err != nil {
+		return err
+	}
+	expired := 0
+	for _, intent := range intents {
+		if err := uc.storageService.Delete(ctx, intent.StorageKey); err != nil {
+			uc.logger.WithContext(ctx).Warn("Failed to delete abandoned upload %s: %v", intent.StorageKey, err)
+			continue
+		}
+		if err := uc.intentRepo.Delete(ctx, intent.ID); err != nil {
+			uc.logger.WithContext(ctx).Warn("Failed to delete upload intent %s: %v", intent.ID, err)
+			continue
+		}
+		expired++
+	}
+	if deleted > 0 || expired > 0 {
+		uc.logger.WithContext(ctx).Info("File GC removed %d orphan files and %d abandoned uploads", deleted, expired)
+	}
+
+	if lister, ok := uc.storageService.(port.ObjectLister); ok {
+		report, err := findStrayObjects(ctx, uc.fileRepo, lister)
+		if err != nil {
+			return err
+		}
+		if report.Count > 0 {
+			uc.logger.WithContext(ctx).Warn("Storage holds %d objects (%d bytes) with no file record, e.g. %v", report.Count, report.TotalSize, report.Keys[:min(len(report.Keys), 5)])
+		}
+	}
+	return nil
+}
+
+// ReportStrayObjectsUseCase lets platform admins see what findStrayObjects
+// found, to clean up by hand.
+type ReportStrayObjectsUseCase struct {
+	fileRepo       repository.FileRepository
+	storageService port.StorageService
+	authService    *service.AuthorizationService
+}
+
+func NewReportStrayObjectsUseCase(fileRepo repository.FileRepository, storageService port.StorageService, authService *service.AuthorizationService) *ReportStrayObjectsUseCase {
+	return &ReportStrayObjectsUseCase{fileRepo: fileRepo, storageService: storageService, authService: authService}
+}
+
+func (uc *ReportStrayObjectsUseCase) Execute(ctx context.Context, actorID string) (*dto.StrayObjectsOutput, error) {
+	ctx, span := tracing.Start(ctx, "file.ReportStrayObjects")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	lister, ok := uc.storageService.(port.ObjectLister)
+	if !ok {
+		return nil, errors.NewBadRequestError("the configured storage cannot list objects")
+	}
+	return findStrayObjects(ctx, uc.fileRepo, lister)
+}
+
+// findStrayObjects walks storage and reports objects that no file, variant
+// or upload intent accounts for.
+func findStrayObjects(ctx context.Context, fileRepo repository.FileRepository, lister port.ObjectLister) (*dto.StrayObjectsOutput, error) {
+	report := &dto.StrayObjectsOutput{Keys: []string{}}
+	sizes := make(map[string]int64, strayKeyBatch)
+	batch := make([]string, 0, strayKeyBatch)
+	flush := func() error {
+		unknown, err := fileRepo.UnknownKeys(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, key := range unknown {
+			report.Count++
+			report.TotalSize += sizes[key]
+			if len(report.Keys) < strayReportKeys {
+				report.Keys = append(report.Keys, key)
+			} else {
+				report.Truncated = true
+			}
+		}
+		batch = batch[:0]
+		clear(sizes)
+		return nil
+	}
+
+	youngest := time.Now().Add(-strayMinAge)
+	err := lister.ListObjects(ctx, func(obj port.StoredObject) error {
+		if obj.LastModified.After(youngest) {
+			return nil
+		}
+		batch = append(batch, obj.Key)
+		sizes[obj.Key] = obj.Size
+		if len(batch) == strayKeyBatch {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,219 @@
+package file
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const (
+	MaxDirectUploadSize = 10 * 1024 * 1024 // 10MB
+	PresignedUploadTTL  = 15 * time.Minute
+	// uploadCompletionGrace is how long after the policy expires a finished
+	// upload may still be completed.
+	uploadCompletionGrace = time.Hour
+	sniffLength           = 512
+)
+
+var directUploadExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// PresignUploadUseCase issues a storage policy so the client can upload the
+// file without the bytes passing through the API.
+type PresignUploadUseCase struct {
+	intentRepo repository.UploadIntentRepository
+	direct     port.DirectUploadStorage
+	logger     logger.Logger
+}
+
+func NewPresignUploadUseCase(intentRepo repository.UploadIntentRepository, direct port.DirectUploadStorage, logger logger.Logger) *PresignUploadUseCase {
+	return &PresignUploadUseCase{intentRepo: intentRepo, direct: direct, logger: logger}
+}
+
+func (uc *PresignUploadUseCase) Execute(ctx context.Context, userID string, input dto.PresignUploadInput) (*dto.PresignUploadOutput, error) {
+	ctx, span := tracing.Start(ctx, "file.PresignUpload")
+	defer span.End()
+
+	if uc.direct == nil {
+		return nil, errors.NewBadRequestError("direct uploads are not supported by the configured storage")
+	}
+	ext, ok := directUploadExtensions[input.ContentType]
+	if !ok {
+		return nil, errors.NewBadRequestError("unsupported content type")
+	}
+	if input.Size > MaxDirectUploadSize {
+		return nil, errors.NewBadRequestError("file size exceeds 10MB limit")
+	}
+
+	// Images land in a private staging prefix and are re-encoded on
+	// completion; documents are stored as-is but never publicly readable.
+	key := port.PrivateKeyPrefix + "incoming/" + uuid.New().String() + ext
+	if input.ContentType == "application/pdf" {
+		key = port.PrivateKeyPrefix + "uploads/" + uuid.New().String() + ext
+	}
+
+	now := time.Now()
+	intent := &entity.UploadIntent{
+		ID: uuid.New().String(), UserID: userID, StorageKey: key, FileName: input.FileName,
+		ContentType: input.ContentType, MaxSize: input.Size, ExpiresAt: now.Add(PresignedUploadTTL), CreatedAt: now,
+	}
+	presigned, err := uc.direct.PresignUpload(ctx, key, input.ContentType, input.Size, PresignedUploadTTL)
+	if err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to presign upload: %v", err)
+		return nil, errors.ErrInternalError
+	}
+	if err := uc.intentRepo.Create(ctx, intent); err != nil {
+		return nil, err
+	}
+	return &dto.PresignUploadOutput{
+		ID: intent.ID, URL: presigned.URL, Method: http.MethodPost, Fields: presigned.Fields,
+		MaxSize: intent.MaxSize, ExpiresAt: intent.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// CompleteUploadUseCase verifies what the client actually stored and records
+// it as a file. Nothing the client claimed at presign time is trusted.
+type CompleteUploadUseCase struct {
+	intentRepo     repository.UploadIntentRepository
+	fileRepo       repository.FileRepository
+	storageService port.StorageService
+	direct         port.DirectUploadStorage
+	imageProcessor port.ImageProcessor
+	tx             port.TxManager
+	logger         logger.Logger
+}
+
+func NewCompleteUploadUseCase(
+	intentRepo repository.UploadIntentRepository,
+	fileRepo repository.FileRepository,
+	storageService port.StorageService,
+	direct port.DirectUploadStorage,
+	imageProcessor port.ImageProcessor,
+	tx port.TxManager,
+	logger logger.Logger,
+) *CompleteUploadUseCase {
+	return &CompleteUploadUseCase{
+		intentRepo: intentRepo, fileRepo: fileRepo, storageService: storageService,
+		direct: direct, imageProcessor: imageProcessor, tx: tx, logger: logger,
+	}
+}
+
+func (uc *CompleteUploadUseCase) Execute(ctx context.Context, intentID, userID string) (*dto.FileOutput, error) {
+	ctx, span := tracing.Start(ctx, "file.CompleteUpload")
+	defer span.End()
+
+	if uc.direct == nil {
+		return nil, errors.NewBadRequestError("direct uploads are not supported by the configured storage")
+	}
+	intent, err := uc.intentRepo.FindByID(ctx, intentID)
+	if err != nil {
+		return nil, err
+	}
+	if intent == nil || intent.UserID != userID {
+		return nil, errors.NewNotFoundError("upload")
+	}
+	alreadyCompleted := errors.NewBadRequestError("upload has already been completed")
+	if intent.IsCompleted() {
+		return nil, alreadyCompleted
+	}
+	if time.Now().After(intent.ExpiresAt.Add(uploadCompletionGrace)) {
+		return nil, errors.NewBadRequestError("upload has expired")
+	}
+
+	info, err := uc.direct.Stat(ctx, intent.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, errors.NewBadRequestError("file has not been uploaded yet")
+	}
+	// The policy already enforces these; re-check in case it was misconfigured.
+	if info.Size < 1 || info.Size > intent.MaxSize {
+		uc.discard(ctx, intent.StorageKey)
+		return nil, errors.NewBadRequestError("uploaded file size does not match the request")
+	}
+
+	head, err := uc.direct.Read(ctx, intent.StorageKey, sniffLength)
+	if err != nil {
+		return nil, err
+	}
+	if http.DetectContentType(head) != intent.ContentType {
+		uc.discard(ctx, intent.StorageKey)
+		return nil, errors.NewBadRequestError("uploaded file content does not match its declared type")
+	}
+
+	var file *entity.File
+	var cleanup func()
+	if intent.ContentType == "application/pdf" {
+		file = &entity.File{
+			ID: uuid.New().String(), FileName: intent.FileName, FileSize: info.Size,
+			MimeType: intent.ContentType, StorageKey: intent.StorageKey,
+			UploadedBy: userID, CreatedAt: time.Now(),
+		}
+		cleanup = func() {}
+	} else {
+		data, err := uc.direct.Read(ctx, intent.StorageKey, intent.MaxSize)
+		if err != nil {
+			return nil, err
+		}
+		processed, err := uc.imageProcessor.Process(data, VariantSizes)
+		if err != nil {
+			uc.logger.WithContext(ctx).Warn("Rejected direct upload %s from user %s: %v", intent.ID, userID, err)
+			uc.discard(ctx, intent.StorageKey)
+			return nil, errors.NewBadRequestError("image could not be decoded; upload a valid JPEG, PNG, GIF or WebP file")
+		}
+		if file, cleanup, err = storeImage(ctx, uc.storageService, uc.logger, processed, intent.FileName, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Claiming the intent and creating the file commit together, so of two
+	// concurrent completions only one records a file.
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		claimed, err := uc.intentRepo.MarkCompleted(ctx, intent.ID, file.ID, time.Now())
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return alreadyCompleted
+		}
+		return uc.fileRepo.Create(ctx, file)
+	})
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	if file.StorageKey != intent.StorageKey {
+		// The re-encoded copy replaces the raw upload.
+		uc.discard(ctx, intent.StorageKey)
+	}
+
+	output := toFileOutput(file)
+	if output.URL == "" {
+		// Private objects get a short-lived link instead of a stored URL.
+		if output.URL, err = uc.storageService.GetURL(ctx, file.StorageKey); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+func (uc *CompleteUploadUseCase) discard(ctx context.Context, key string) {
+	if err := uc.storageService.Delete(ctx, key); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to delete rejected upload %s: %v", key, err)
+	}
+}
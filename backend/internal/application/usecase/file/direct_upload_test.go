@@ -0,0 +1,223 @@
+package file_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	fileusecase "github.com/startup-job-board/backend/internal/application/usecase/file"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	apperrors "github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+var pdfBytes = []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\ntrailer << /Root 1 0 R >>\n%%EOF\n")
+
+type memIntents struct {
+	repository.UploadIntentRepository
+	byID map[string]*entity.UploadIntent
+	// stale makes FindByID ignore completion, like a read racing another request.
+	stale bool
+}
+
+func (r *memIntents) Create(ctx context.Context, intent *entity.UploadIntent) error {
+	r.byID[intent.ID] = intent
+	return nil
+}
+
+func (r *memIntents) FindByID(ctx context.Context, id string) (*entity.UploadIntent, error) {
+	intent, ok := r.byID[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *intent
+	if r.stale {
+		copied.CompletedAt, copied.FileID = nil, nil
+	}
+	return &copied, nil
+}
+
+func (r *memIntents) MarkCompleted(ctx context.Context, id, fileID string, at time.Time) (bool, error) {
+	intent := r.byID[id]
+	if intent.CompletedAt != nil {
+		return false, nil
+	}
+	intent.FileID, intent.CompletedAt = &fileID, &at
+	return true, nil
+}
+
+type memFiles struct {
+	repository.FileRepository
+	created []*entity.File
+}
+
+func (r *memFiles) Create(ctx context.Context, file *entity.File) error {
+	r.created = append(r.created, file)
+	return nil
+}
+
+// memBucket serves as both the storage service and the direct-upload store.
+type memBucket struct {
+	port.StorageService
+	objects map[string][]byte
+}
+
+func (b *memBucket) Stat(ctx context.Context, key string) (*port.ObjectInfo, error) {
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, nil
+	}
+	return &port.ObjectInfo{Size: int64(len(data))}, nil
+}
+
+func (b *memBucket) Read(ctx context.Context, key string, limit int64) ([]byte, error) {
+	data := b.objects[key]
+	if int64(len(data)) > limit {
+		data = data[:limit]
+	}
+	return data, nil
+}
+
+func (b *memBucket) PresignUpload(ctx context.Context, key, contentType string, maxSize int64, ttl time.Duration) (*port.PresignedUpload, error) {
+	return &port.PresignedUpload{URL: "https://bucket.example/upload"}, nil
+}
+
+func (b *memBucket) Delete(ctx context.Context, key string) error {
+	delete(b.objects, key)
+	return nil
+}
+
+func (b *memBucket) GetURL(ctx context.Context, key string) (string, error) {
+	return "https://bucket.example/signed/" + key, nil
+}
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type uploadFixture struct {
+	intents *memIntents
+	files   *memFiles
+	bucket  *memBucket
+	uc      *fileusecase.CompleteUploadUseCase
+}
+
+func newUploadFixture() *uploadFixture {
+	f := &uploadFixture{
+		intents: &memIntents{byID: map[string]*entity.UploadIntent{}},
+		files:   &memFiles{},
+		bucket:  &memBucket{objects: map[string][]byte{}},
+	}
+	f.uc = fileusecase.NewCompleteUploadUseCase(f.intents, f.files, f.bucket, f.bucket, nil, inlineTx{}, logger.New(logger.Options{Output: io.Discard}))
+	return f
+}
+
+// intent records a presigned upload for ann and stores data under its key.
+func (f *uploadFixture) intent(id, contentType string, maxSize int64, data []byte) *entity.UploadIntent {
+	intent := &entity.UploadIntent{
+		ID: id, UserID: "ann", StorageKey: port.PrivateKeyPrefix + "uploads/" + id, FileName: id + ".pdf",
+		ContentType: contentType, MaxSize: maxSize, ExpiresAt: time.Now().Add(fileusecase.PresignedUploadTTL), CreatedAt: time.Now(),
+	}
+	f.intents.byID[id] = intent
+	if data != nil {
+		f.bucket.objects[intent.StorageKey] = data
+	}
+	return intent
+}
+
+func appErrorCode(err error) string {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return appErr.Code
+	}
+	return ""
+}
+
+func TestCompleteUploadRecordsFileOnce(t *testing.T) {
+	f := newUploadFixture()
+	f.intent("doc", "application/pdf", 1024, pdfBytes)
+	ctx := context.Background()
+
+	out, err := f.uc.Execute(ctx, "doc", "ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.MimeType != "application/pdf" || out.FileSize != int64(len(pdfBytes)) || out.URL == "" {
+		t.Fatalf("output = %+v", out)
+	}
+	if intent := f.intents.byID["doc"]; intent.FileID == nil || *intent.FileID != out.ID {
+		t.Fatalf("intent not marked with file %s", out.ID)
+	}
+
+	if _, err := f.uc.Execute(ctx, "doc", "ann"); appErrorCode(err) != "BAD_REQUEST" {
+		t.Fatalf("second completion: %v", err)
+	}
+	// A request that read the intent before the first one finished still
+	// loses the conditional claim.
+	f.intents.stale = true
+	if _, err := f.uc.Execute(ctx, "doc", "ann"); appErrorCode(err) != "BAD_REQUEST" {
+		t.Fatalf("racing completion: %v", err)
+	}
+	if len(f.files.created) != 1 {
+		t.Fatalf("created %d files, want 1", len(f.files.created))
+	}
+}
+
+func TestCompleteUploadRejectsMismatchedFiles(t *testing.T) {
+	f := newUploadFixture()
+	tooBig := f.intent("big", "application/pdf", 10, pdfBytes)
+	wrongType := f.intent("png", "image/png", 1024, pdfBytes)
+	f.intent("missing", "application/pdf", 1024, nil)
+
+	for _, id := range []string{"big", "png", "missing"} {
+		if _, err := f.uc.Execute(context.Background(), id, "ann"); appErrorCode(err) != "BAD_REQUEST" {
+			t.Errorf("%s: %v", id, err)
+		}
+	}
+	if _, ok := f.bucket.objects[tooBig.StorageKey]; ok {
+		t.Error("oversized upload was kept")
+	}
+	if _, ok := f.bucket.objects[wrongType.StorageKey]; ok {
+		t.Error("upload with the wrong content type was kept")
+	}
+	if len(f.files.created) != 0 {
+		t.Fatalf("created %d files", len(f.files.created))
+	}
+}
+
+func TestCompleteUploadRefusesExpiredAndForeignIntents(t *testing.T) {
+	f := newUploadFixture()
+	expired := f.intent("old", "application/pdf", 1024, pdfBytes)
+	expired.ExpiresAt = time.Now().Add(-2 * time.Hour)
+	f.intent("doc", "application/pdf", 1024, pdfBytes)
+
+	if _, err := f.uc.Execute(context.Background(), "old", "ann"); appErrorCode(err) != "BAD_REQUEST" {
+		t.Fatalf("expired intent: %v", err)
+	}
+	if _, err := f.uc.Execute(context.Background(), "doc", "bob"); appErrorCode(err) != "NOT_FOUND" {
+		t.Fatalf("another user's intent: %v", err)
+	}
+	if len(f.files.created) != 0 || f.intents.byID["doc"].IsCompleted() {
+		t.Fatal("refused completions must not record anything")
+	}
+}
+
+func TestPresignUploadStagesEveryTypePrivately(t *testing.T) {
+	intents := &memIntents{byID: map[string]*entity.UploadIntent{}}
+	uc := fileusecase.NewPresignUploadUseCase(intents, &memBucket{}, logger.New(logger.Options{Output: io.Discard}))
+	for _, contentType := range []string{"image/png", "application/pdf"} {
+		if _, err := uc.Execute(context.Background(), "ann", dto.PresignUploadInput{FileName: "f", ContentType: contentType, Size: 100}); err != nil {
+			t.Fatalf("%s: %v", contentType, err)
+		}
+	}
+	for _, intent := range intents.byID {
+		if !port.IsPrivateKey(intent.StorageKey) {
+			t.Errorf("%s staged at public key %q", intent.ContentType, intent.StorageKey)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package file
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+// ListFilesUseCase lists the caller's uploads, or with a team ID, everything
+// uploaded by the team's active members (team admins only).
+type ListFilesUseCase struct {
+	fileRepo       repository.FileRepository
+	teamMemberRepo repository.TeamMemberRepository
+	storageService port.StorageService
+	authService    *service.AuthorizationService
+}
+
+func NewListFilesUseCase(
+	fileRepo repository.FileRepository,
+	teamMemberRepo repository.TeamMemberRepository,
+	storageService port.StorageService,
+	authService *service.AuthorizationService,
+) *ListFilesUseCase {
+	return &ListFilesUseCase{fileRepo: fileRepo, teamMemberRepo: teamMemberRepo, storageService: storageService, authService: authService}
+}
+
+func (uc *ListFilesUseCase) Execute(ctx context.Context, userID, teamID string, page, pageSize int) ([]*dto.FileOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "file.ListFiles")
+	defer span.End()
+
+	uploaders := []string{userID}
+	if teamID != "" {
+		ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeTeamManage)
+		if err != nil || !ok {
+			return nil, 0, errors.NewNotFoundError("team")
+		}
+		members, err := uc.teamMemberRepo.FindByTeamID(ctx, teamID)
+		if err != nil {
+			return nil, 0, err
+		}
+		uploaders = uploaders[:0]
+		for _, m := range members {
+			if m.Status == entity.MemberStatusActive {
+				uploaders = append(uploaders, m.UserID)
+			}
+		}
+	}
+
+	files, total, err := uc.fileRepo.ListByUploaders(ctx, uploaders, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]*dto.FileOutput, len(files))
+	for i, f := range files {
+		out[i] = toFileOutput(f)
+		if out[i].URL == "" && uc.storageService != nil {
+			// Private objects get a fresh short-lived link on every read.
+			if out[i].URL, err = uc.storageService.GetURL(ctx, f.StorageKey); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	return out, total, nil
+}
+
+// DeleteFileUseCase removes a file and its stored objects. Files still in use
+// (e.g. as a startup logo) are refused rather than leaving a broken link.
+type DeleteFileUseCase struct {
+	fileRepo       repository.FileRepository
+	refRepo        repository.FileReferenceRepository
+	teamMemberRepo repository.TeamMemberRepository
+	storageService port.StorageService
+	authService    *service.AuthorizationService
+	logger         logger.Logger
+}
+
+func NewDeleteFileUseCase(
+	fileRepo repository.FileRepository,
+	refRepo repository.FileReferenceRepository,
+	teamMemberRepo repository.TeamMemberRepository,
+	storageService port.StorageService,
+	authService *service.AuthorizationService,
+	logger logger.Logger,
+) *DeleteFileUseCase {
+	return &DeleteFileUseCase{
+		fileRepo: fileRepo, refRepo: refRepo, teamMemberRepo: teamMemberRepo,
+		storageService: storageService, authService: authService, logger: logger,
+	}
+}
+
+func (uc *DeleteFileUseCase) Execute(ctx context.Context, fileID, userID string) error {
+	ctx, span := tracing.Start(ctx, "file.DeleteFile")
+	defer span.End()
+
+	file, err := uc.fileRepo.FindByID(ctx, fileID)
+	if err != nil || file == nil {
+		return errors.NewNotFoundError("file")
+	}
+	if !uc.canManage(ctx, file, userID) {
+		return errors.NewNotFoundError("file")
+	}
+	inUse, err := uc.refRepo.ExistsForFile(ctx, file.ID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.NewBadRequestError("file is still in use; remove it from the startup first")
+	}
+	if err := uc.fileRepo.Delete(ctx, file.ID); err != nil {
+		return err
+	}
+	// The row is gone, so a failure here only leaves a stray object for the
+	// orphan report.
+	if err := deleteObjects(ctx, uc.storageService, file); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to delete objects of file %s: %v", file.ID, err)
+	}
+	return nil
+}
+
+// canManage allows the uploader, and admins of any team the uploader is an
+// active member of.
+func (uc *DeleteFileUseCase) canManage(ctx context.Context, file *entity.File, userID string) bool {
+	if file.UploadedBy == userID {
+		return true
+	}
+	memberships, err := uc.teamMemberRepo.FindByUserID(ctx, file.UploadedBy)
+	if err != nil {
+		return false
+	}
+	for _, m := range memberships {
+		if m.Status != entity.MemberStatusActive {
+			continue
+		}
+		if ok, err := uc.authService.HasScope(ctx, userID, m.TeamID, entity.ScopeTeamManage); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteObjects removes a file's original and variants from storage. It
+// tries every key and returns the first error.
+func deleteObjects(ctx context.Context, storageService port.StorageService, file *entity.File) error {
+	if storageService == nil {
+		return errors.NewBadRequestError("storage is not configured")
+	}
+	keys := []string{file.StorageKey}
+	for _, v := range file.Variants {
+		keys = append(keys, v.StorageKey)
+	}
+	var firstErr error
+	for _, key := range keys {
+		if err := storageService.Delete(ctx, key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
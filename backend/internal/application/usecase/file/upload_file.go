@@ -2,6 +2,7 @@ package file
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"path/filepath"
 	"strings"
@@ -14,12 +15,17 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 const (
 	MaxFileSize = 2 * 1024 * 1024 // 2MB
 )
 
+// VariantSizes are the bounding boxes, in pixels, of the resized renditions
+// stored for every upload (each in WebP and PNG).
+var VariantSizes = []int{64, 128, 256}
+
 var allowedMimeTypes = map[string]bool{
 	"image/jpeg": true,
 	"image/png":  true,
@@ -38,22 +44,28 @@ var extensionMimeFallback = map[string]string{
 type UploadFileUseCase struct {
 	fileRepo       repository.FileRepository
 	storageService port.StorageService
+	imageProcessor port.ImageProcessor
 	logger         logger.Logger
 }
 
 func NewUploadFileUseCase(
 	fileRepo repository.FileRepository,
 	storageService port.StorageService,
+	imageProcessor port.ImageProcessor,
 	logger logger.Logger,
 ) *UploadFileUseCase {
 	return &UploadFileUseCase{
 		fileRepo:       fileRepo,
 		storageService: storageService,
+		imageProcessor: imageProcessor,
 		logger:         logger,
 	}
 }
 
 func (uc *UploadFileUseCase) Execute(ctx context.Context, fileData []byte, fileName string, mimeType string, userID string) (*dto.FileOutput, error) {
+	ctx, span := tracing.Start(ctx, "file.UploadFile")
+	defer span.End()
+
 	if uc.storageService == nil {
 		return nil, errors.NewBadRequestError("file storage is not configured")
 	}
@@ -62,45 +74,95 @@ func (uc *UploadFileUseCase) Execute(ctx context.Context, fileData []byte, fileN
 		return nil, errors.NewBadRequestError("file size exceeds 2MB limit")
 	}
 
-	resolvedMime, err := resolveImageMimeType(fileData, fileName, mimeType)
-	if err != nil {
+	if _, err := resolveImageMimeType(fileData, fileName, mimeType); err != nil {
 		return nil, err
 	}
 
-	ext := filepath.Ext(fileName)
-	storageKey := "uploads/" + uuid.New().String() + ext
+	// Sniffing only looks at the first bytes; decoding proves the whole file
+	// is an image, and re-encoding drops metadata and anything appended.
+	processed, err := uc.imageProcessor.Process(fileData, VariantSizes)
+	if err != nil {
+		uc.logger.WithContext(ctx).Warn("Rejected upload %q from user %s: %v", fileName, userID, err)
+		return nil, errors.NewBadRequestError("image could not be decoded; upload a valid JPEG, PNG, GIF or WebP file")
+	}
 
-	url, err := uc.storageService.Upload(ctx, fileData, storageKey, resolvedMime)
+	file, cleanup, err := storeImage(ctx, uc.storageService, uc.logger, processed, fileName, userID)
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.fileRepo.Create(ctx, file); err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	return toFileOutput(file), nil
+}
+
+// storeImage uploads a processed image and its variants under fresh keys and
+// returns the unsaved file record, plus a cleanup that removes what was
+// uploaded. On error nothing is left behind in storage.
+func storeImage(ctx context.Context, storageService port.StorageService, log logger.Logger, processed *port.ProcessedImage, fileName, userID string) (*entity.File, func(), error) {
+	fileID := uuid.New().String()
+	baseKey := "uploads/" + uuid.New().String()
+	storageKey := baseKey + processed.Extension
+
+	var uploaded []string
+	cleanup := func() {
+		for _, key := range uploaded {
+			if err := storageService.Delete(ctx, key); err != nil {
+				log.Warn("Failed to delete orphaned upload %s: %v", key, err)
+			}
+		}
+	}
+
+	url, err := storageService.Upload(ctx, processed.Data, storageKey, processed.MimeType)
+	if err != nil {
+		return nil, nil, err
+	}
+	uploaded = append(uploaded, storageKey)
 
 	file := &entity.File{
-		ID:         uuid.New().String(),
+		ID:         fileID,
 		FileName:   fileName,
-		FileSize:   int64(len(fileData)),
-		MimeType:   resolvedMime,
+		FileSize:   int64(len(processed.Data)),
+		MimeType:   processed.MimeType,
 		StorageKey: storageKey,
 		URL:        url,
 		UploadedBy: userID,
 		CreatedAt:  time.Now(),
 	}
 
-	if err := uc.fileRepo.Create(ctx, file); err != nil {
-		if uc.storageService != nil {
-			uc.storageService.Delete(ctx, storageKey)
+	for _, v := range processed.Variants {
+		key := fmt.Sprintf("%s_%d.%s", baseKey, v.Size, v.Format)
+		variantURL, err := storageService.Upload(ctx, v.Data, key, v.MimeType)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
 		}
-		return nil, err
+		uploaded = append(uploaded, key)
+		file.Variants = append(file.Variants, entity.FileVariant{
+			ID: uuid.New().String(), FileID: fileID, Size: v.Size, Format: v.Format,
+			MimeType: v.MimeType, StorageKey: key, URL: variantURL,
+		})
 	}
+	return file, cleanup, nil
+}
 
-	return &dto.FileOutput{
+func toFileOutput(file *entity.File) *dto.FileOutput {
+	output := &dto.FileOutput{
 		ID:        file.ID,
 		FileName:  file.FileName,
 		FileSize:  file.FileSize,
 		MimeType:  file.MimeType,
 		URL:       file.URL,
 		CreatedAt: file.CreatedAt.Format(time.RFC3339),
-	}, nil
+	}
+	for _, v := range file.Variants {
+		output.Variants = append(output.Variants, dto.FileVariantOutput{
+			Size: v.Size, Format: v.Format, MimeType: v.MimeType, URL: v.URL,
+		})
+	}
+	return output
 }
 
 func resolveImageMimeType(fileData []byte, fileName, clientMime string) (string, error) {
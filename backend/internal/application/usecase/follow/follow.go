@@ -0,0 +1,249 @@
+package follow
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+// digestUserBatch caps how many followers get a digest per run.
+const digestUserBatch = 200
+
+// unsubscribeAction marks action tokens for digest unsubscribe links.
+const unsubscribeAction = "follows.unsubscribe"
+
+// unsubscribeLinkTTL keeps links in older digests working for a while.
+const unsubscribeLinkTTL = 90 * 24 * time.Hour
+
+type FollowStartupUseCase struct {
+	followRepo  repository.StartupFollowRepository
+	startupRepo repository.StartupRepository
+}
+
+func NewFollowStartupUseCase(followRepo repository.StartupFollowRepository, startupRepo repository.StartupRepository) *FollowStartupUseCase {
+	return &FollowStartupUseCase{followRepo: followRepo, startupRepo: startupRepo}
+}
+
+func (uc *FollowStartupUseCase) Execute(ctx context.Context, userID, startupID string) error {
+	ctx, span := tracing.Start(ctx, "follow.FollowStartup")
+	defer span.End()
+
+	startup, err := uc.startupRepo.FindByID(ctx, startupID)
+	if err != nil || startup == nil || startup.Status != entity.StartupStatusActive {
+		return errors.NewNotFoundError("startup")
+	}
+	return uc.followRepo.Create(ctx, &entity.StartupFollow{
+		ID: uuid.New().String(), UserID: userID, StartupID: startupID, CreatedAt: time.Now(),
+	})
+}
+
+type UnfollowStartupUseCase struct {
+	followRepo repository.StartupFollowRepository
+}
+
+func NewUnfollowStartupUseCase(followRepo repository.StartupFollowRepository) *UnfollowStartupUseCase {
+	return &UnfollowStartupUseCase{followRepo: followRepo}
+}
+
+func (uc *UnfollowStartupUseCase) Execute(ctx context.Context, userID, startupID string) error {
+	ctx, span := tracing.Start(ctx, "follow.UnfollowStartup")
+	defer span.End()
+
+	return uc.followRepo.Delete(ctx, userID, startupID)
+}
+
+// UnsubscribeFollowsUseCase applies the signed unsubscribe link from a follow
+// digest: the user stops following every startup, so no more digests go out.
+type UnsubscribeFollowsUseCase struct {
+	followRepo   repository.StartupFollowRepository
+	actionTokens port.ActionTokenService
+}
+
+func NewUnsubscribeFollowsUseCase(followRepo repository.StartupFollowRepository, actionTokens port.ActionTokenService) *UnsubscribeFollowsUseCase {
+	return &UnsubscribeFollowsUseCase{followRepo: followRepo, actionTokens: actionTokens}
+}
+
+func (uc *UnsubscribeFollowsUseCase) Execute(ctx context.Context, token string) (*dto.UnsubscribeFollowsOutput, error) {
+	ctx, span := tracing.Start(ctx, "follow.UnsubscribeFollows")
+	defer span.End()
+
+	claims, err := uc.actionTokens.Verify(token)
+	if err != nil || claims.Action != unsubscribeAction {
+		return nil, errors.NewBadRequestError("invalid or expired link")
+	}
+	count, err := uc.followRepo.DeleteByUserID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.UnsubscribeFollowsOutput{Unfollowed: count}, nil
+}
+
+type ListFollowsUseCase struct {
+	followRepo  repository.StartupFollowRepository
+	startupRepo repository.StartupRepository
+}
+
+func NewListFollowsUseCase(followRepo repository.StartupFollowRepository, startupRepo repository.StartupRepository) *ListFollowsUseCase {
+	return &ListFollowsUseCase{followRepo: followRepo, startupRepo: startupRepo}
+}
+
+func (uc *ListFollowsUseCase) Execute(ctx context.Context, userID string, page, pageSize int) ([]*dto.FollowOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "follow.ListFollows")
+	defer span.End()
+
+	follows, total, err := uc.followRepo.ListByUserID(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]string, len(follows))
+	for i, f := range follows {
+		ids[i] = f.StartupID
+	}
+	startups, err := uc.startupRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[string]*entity.Startup, len(startups))
+	for _, s := range startups {
+		byID[s.ID] = s
+	}
+
+	out := make([]*dto.FollowOutput, 0, len(follows))
+	for _, f := range follows {
+		startup, ok := byID[f.StartupID]
+		if !ok {
+			continue
+		}
+		out = append(out, &dto.FollowOutput{
+			StartupID: startup.ID, StartupName: startup.Name, StartupSlug: startup.Slug,
+			LogoURL: startup.LogoURL, FollowedAt: f.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return out, total, nil
+}
+
+// FollowerCountUseCase exposes the aggregate count (never the followers) to
+// members holding startup:read.
+type FollowerCountUseCase struct {
+	followRepo  repository.StartupFollowRepository
+	authService *service.AuthorizationService
+}
+
+func NewFollowerCountUseCase(followRepo repository.StartupFollowRepository, authService *service.AuthorizationService) *FollowerCountUseCase {
+	return &FollowerCountUseCase{followRepo: followRepo, authService: authService}
+}
+
+func (uc *FollowerCountUseCase) Execute(ctx context.Context, userID, startupID string) (*dto.FollowerCountOutput, error) {
+	ctx, span := tracing.Start(ctx, "follow.FollowerCount")
+	defer span.End()
+
+	ok, err := uc.authService.CanAccessStartup(ctx, userID, startupID, entity.ScopeStartupRead)
+	if err != nil || !ok {
+		return nil, errors.NewNotFoundError("startup")
+	}
+	count, err := uc.followRepo.CountByStartupID(ctx, startupID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.FollowerCountOutput{StartupID: startupID, FollowerCount: count}, nil
+}
+
+// SendFollowDigestsUseCase emails each follower one digest of the new jobs
+// queued for them since the last run.
+type SendFollowDigestsUseCase struct {
+	notificationRepo repository.FollowNotificationRepository
+	jobRepo          repository.JobRepository
+	startupRepo      repository.StartupRepository
+	userRepo         repository.UserRepository
+	emailService     port.EmailService
+	actionTokens     port.ActionTokenService
+	appURL           string
+	logger           logger.Logger
+}
+
+func NewSendFollowDigestsUseCase(
+	notificationRepo repository.FollowNotificationRepository,
+	jobRepo repository.JobRepository,
+	startupRepo repository.StartupRepository,
+	userRepo repository.UserRepository,
+	emailService port.EmailService,
+	actionTokens port.ActionTokenService,
+	appURL string,
+	logger logger.Logger,
+) *SendFollowDigestsUseCase {
+	return &SendFollowDigestsUseCase{
+		notificationRepo: notificationRepo, jobRepo: jobRepo, startupRepo: startupRepo,
+		userRepo: userRepo, emailService: emailService, actionTokens: actionTokens,
+		appURL: strings.TrimRight(appURL, "/"), logger: logger,
+	}
+}
+
+func (uc *SendFollowDigestsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "follow.SendFollowDigests")
+	defer span.End()
+
+	userIDs, err := uc.notificationRepo.ListPendingUserIDs(ctx, digestUserBatch)
+	if err != nil {
+		return err
+	}
+	startupNames := map[string]string{}
+	for _, userID := range userIDs {
+		pending, err := uc.notificationRepo.ListPendingByUserID(ctx, userID)
+		if err != nil {
+			uc.logger.WithContext(ctx).Error("Follow digest: failed to load notifications for user %s: %v", userID, err)
+			continue
+		}
+		ids := make([]string, len(pending))
+		var items []port.FollowDigestItem
+		for i, n := range pending {
+			ids[i] = n.ID
+			// Jobs closed or removed before the digest went out are skipped.
+			job, err := uc.jobRepo.FindByID(ctx, n.JobID)
+			if err != nil || job == nil || job.Status != entity.JobStatusActive {
+				continue
+			}
+			name, ok := startupNames[n.StartupID]
+			if !ok {
+				if startup, err := uc.startupRepo.FindByID(ctx, n.StartupID); err == nil && startup != nil {
+					name = startup.Name
+				}
+				startupNames[n.StartupID] = name
+			}
+			items = append(items, port.FollowDigestItem{
+				StartupName: name, JobTitle: job.Title, JobURL: uc.appURL + "/jobs/" + job.ID,
+			})
+		}
+
+		user, err := uc.userRepo.FindByID(ctx, userID)
+		if len(items) > 0 && err == nil && user != nil && user.Status == entity.UserStatusActive {
+			token, err := uc.actionTokens.Sign(port.ActionClaims{
+				Subject: userID, Action: unsubscribeAction, ExpiresAt: time.Now().Add(unsubscribeLinkTTL),
+			})
+			if err != nil {
+				uc.logger.WithContext(ctx).Error("Follow digest: failed to sign unsubscribe link for user %s: %v", userID, err)
+				continue
+			}
+			digest := port.FollowDigestEmail{Items: items, UnsubscribeURL: uc.appURL + "/follows/unsubscribe?token=" + url.QueryEscape(token)}
+			if err := uc.emailService.Send(ctx, port.EmailFollowDigest, digest, port.EmailRecipient{Email: user.Email, Locale: user.Locale}); err != nil {
+				// Left pending; retried next run.
+				uc.logger.WithContext(ctx).Warn("Follow digest: failed to email user %s: %v", userID, err)
+				continue
+			}
+		}
+		if err := uc.notificationRepo.MarkSent(ctx, ids, time.Now()); err != nil {
+			uc.logger.WithContext(ctx).Error("Follow digest: failed to mark notifications sent for user %s: %v", userID, err)
+		}
+	}
+	return nil
+}
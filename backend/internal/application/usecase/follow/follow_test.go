@@ -0,0 +1,337 @@
+package follow_test
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	followusecase "github.com/startup-job-board/backend/internal/application/usecase/follow"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/auth"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// memFollows backs both the follow and the notification repositories, like
+// the two tables do in Postgres.
+type memFollows struct {
+	follows       []*entity.StartupFollow
+	notifications []*entity.FollowNotification
+}
+
+func (r *memFollows) Create(ctx context.Context, f *entity.StartupFollow) error {
+	for _, existing := range r.follows {
+		if existing.UserID == f.UserID && existing.StartupID == f.StartupID {
+			return nil
+		}
+	}
+	r.follows = append(r.follows, f)
+	return nil
+}
+
+func (r *memFollows) Delete(ctx context.Context, userID, startupID string) error {
+	kept := r.follows[:0]
+	for _, f := range r.follows {
+		if f.UserID != userID || f.StartupID != startupID {
+			kept = append(kept, f)
+		}
+	}
+	r.follows = kept
+	return nil
+}
+
+func (r *memFollows) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	var deleted int64
+	kept := r.follows[:0]
+	for _, f := range r.follows {
+		if f.UserID == userID {
+			deleted++
+			continue
+		}
+		kept = append(kept, f)
+	}
+	r.follows = kept
+	return deleted, nil
+}
+
+func (r *memFollows) ListByUserID(ctx context.Context, userID string, page, pageSize int) ([]*entity.StartupFollow, int64, error) {
+	var out []*entity.StartupFollow
+	for _, f := range r.follows {
+		if f.UserID == userID {
+			out = append(out, f)
+		}
+	}
+	return out, int64(len(out)), nil
+}
+
+func (r *memFollows) CountByStartupID(ctx context.Context, startupID string) (int64, error) {
+	var count int64
+	for _, f := range r.follows {
+		if f.StartupID == startupID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memFollows) EnqueueForJob(ctx context.Context, startupID, jobID string, at time.Time) (int64, error) {
+	var queued int64
+	for _, f := range r.follows {
+		if f.StartupID == startupID {
+			r.notifications = append(r.notifications, &entity.FollowNotification{
+				ID: uuid.New().String(), UserID: f.UserID, StartupID: startupID, JobID: jobID, CreatedAt: at,
+			})
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+func (r *memFollows) ListPendingUserIDs(ctx context.Context, limit int) ([]string, error) {
+	seen := map[string]bool{}
+	var ids []string
+	for _, n := range r.notifications {
+		if n.SentAt == nil && !seen[n.UserID] {
+			seen[n.UserID] = true
+			ids = append(ids, n.UserID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memFollows) ListPendingByUserID(ctx context.Context, userID string) ([]*entity.FollowNotification, error) {
+	var out []*entity.FollowNotification
+	for _, n := range r.notifications {
+		if n.SentAt == nil && n.UserID == userID {
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+func (r *memFollows) MarkSent(ctx context.Context, ids []string, at time.Time) error {
+	for _, n := range r.notifications {
+		for _, id := range ids {
+			if n.ID == id {
+				n.SentAt = &at
+			}
+		}
+	}
+	return nil
+}
+
+type memStartups struct {
+	repository.StartupRepository
+	batches int
+}
+
+func (r *memStartups) FindByID(ctx context.Context, id string) (*entity.Startup, error) {
+	return &entity.Startup{ID: id, Name: "Startup " + id, Status: entity.StartupStatusActive}, nil
+}
+
+func (r *memStartups) FindByIDs(ctx context.Context, ids []string) ([]*entity.Startup, error) {
+	r.batches++
+	out := make([]*entity.Startup, 0, len(ids))
+	for _, id := range ids {
+		s, _ := r.FindByID(ctx, id)
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+type memJobs struct {
+	repository.JobRepository
+	jobs map[string]*entity.Job
+}
+
+func (r *memJobs) Create(ctx context.Context, job *entity.Job) error {
+	r.jobs[job.ID] = job
+	return nil
+}
+
+func (r *memJobs) FindByID(ctx context.Context, id string) (*entity.Job, error) {
+	return r.jobs[id], nil
+}
+
+type memUsers struct {
+	repository.UserRepository
+}
+
+func (r *memUsers) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	return &entity.User{ID: id, Email: id + "@seeker.example", Status: entity.UserStatusActive}, nil
+}
+
+type digestMail struct {
+	port.EmailService
+	digests map[string]port.FollowDigestEmail
+}
+
+func (m *digestMail) Send(ctx context.Context, template string, data interface{}, to port.EmailRecipient) error {
+	m.digests[to.Email] = data.(port.FollowDigestEmail)
+	return nil
+}
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type noEvents struct{}
+
+func (noEvents) Record(ctx context.Context, event *entity.DomainEvent, data interface{}) error {
+	return nil
+}
+
+type noCache struct{}
+
+func (noCache) Invalidate(scopes ...port.CacheScope) {}
+
+type followFixture struct {
+	follows   *memFollows
+	follow    *followusecase.FollowStartupUseCase
+	unfollow  *followusecase.UnfollowStartupUseCase
+	createJob *jobusecase.CreateJobUseCase
+	digests   *followusecase.SendFollowDigestsUseCase
+	mail      *digestMail
+	unsub     *followusecase.UnsubscribeFollowsUseCase
+}
+
+func newFollowFixture() *followFixture {
+	log := logger.New(logger.Options{Output: io.Discard})
+	tokens := auth.NewActionTokenService("follow-test-secret")
+	follows := &memFollows{}
+	jobs := &memJobs{jobs: map[string]*entity.Job{}}
+	mail := &digestMail{digests: map[string]port.FollowDigestEmail{}}
+	return &followFixture{
+		follows:   follows,
+		follow:    followusecase.NewFollowStartupUseCase(follows, &memStartups{}),
+		unfollow:  followusecase.NewUnfollowStartupUseCase(follows),
+		createJob: jobusecase.NewCreateJobUseCase(jobs, &memStartups{}, nil, follows, nil, nil, inlineTx{}, noEvents{}, noCache{}, log),
+		digests:   followusecase.NewSendFollowDigestsUseCase(follows, jobs, &memStartups{}, &memUsers{}, mail, tokens, "https://joinus.example", log),
+		mail:      mail,
+		unsub:     followusecase.NewUnsubscribeFollowsUseCase(follows, tokens),
+	}
+}
+
+// postJob creates a job the way an API token for the startup would.
+func (f *followFixture) postJob(t *testing.T, startupID string) {
+	t.Helper()
+	if _, err := f.createJob.Execute(context.Background(), dto.CreateJobInput{Title: "Backend Engineer"}, "", startupID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *followFixture) sendDigests(t *testing.T) []string {
+	t.Helper()
+	f.mail.digests = map[string]port.FollowDigestEmail{}
+	if err := f.digests.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var to []string
+	for email := range f.mail.digests {
+		to = append(to, email)
+	}
+	sort.Strings(to)
+	return to
+}
+
+func TestFollowTwiceThenUnfollow(t *testing.T) {
+	f := newFollowFixture()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := f.follow.Execute(ctx, "ann", "acme"); err != nil {
+			t.Fatalf("follow #%d: %v", i+1, err)
+		}
+	}
+	if count, _ := f.follows.CountByStartupID(ctx, "acme"); count != 1 {
+		t.Fatalf("follower count = %d, want 1", count)
+	}
+
+	if err := f.unfollow.Execute(ctx, "ann", "acme"); err != nil {
+		t.Fatal(err)
+	}
+	f.postJob(t, "acme")
+	if to := f.sendDigests(t); len(to) != 0 {
+		t.Fatalf("digest sent to %v after unfollowing", to)
+	}
+}
+
+func TestNewJobNotifiesOnlyFollowers(t *testing.T) {
+	f := newFollowFixture()
+	ctx := context.Background()
+	_ = f.follow.Execute(ctx, "ann", "acme")
+	_ = f.follow.Execute(ctx, "bob", "acme")
+	_ = f.follow.Execute(ctx, "cat", "globex")
+
+	f.postJob(t, "acme")
+
+	to := f.sendDigests(t)
+	if len(to) != 2 || to[0] != "ann@seeker.example" || to[1] != "bob@seeker.example" {
+		t.Fatalf("digest sent to %v, want acme's followers", to)
+	}
+	if items := f.mail.digests["ann@seeker.example"].Items; len(items) != 1 || items[0].StartupName != "Startup acme" {
+		t.Fatalf("digest items = %+v", items)
+	}
+	if to := f.sendDigests(t); len(to) != 0 {
+		t.Fatalf("digest resent to %v", to)
+	}
+}
+
+func TestUnsubscribeLinkUnfollowsEverything(t *testing.T) {
+	f := newFollowFixture()
+	ctx := context.Background()
+	_ = f.follow.Execute(ctx, "ann", "acme")
+	_ = f.follow.Execute(ctx, "ann", "globex")
+	_ = f.follow.Execute(ctx, "bob", "acme")
+	f.postJob(t, "acme")
+	f.sendDigests(t)
+
+	link, err := url.Parse(f.mail.digests["ann@seeker.example"].UnsubscribeURL)
+	if err != nil || link.Path != "/follows/unsubscribe" {
+		t.Fatalf("unsubscribe url = %q", f.mail.digests["ann@seeker.example"].UnsubscribeURL)
+	}
+	if _, err := f.unsub.Execute(ctx, link.Query().Get("token")+"x"); err == nil {
+		t.Fatal("tampered token was accepted")
+	}
+	out, err := f.unsub.Execute(ctx, link.Query().Get("token"))
+	if err != nil || out.Unfollowed != 2 {
+		t.Fatalf("unsubscribe: out=%+v err=%v", out, err)
+	}
+
+	f.postJob(t, "acme")
+	if to := f.sendDigests(t); len(to) != 1 || to[0] != "bob@seeker.example" {
+		t.Fatalf("digest sent to %v, want only bob", to)
+	}
+}
+
+func TestListFollowsLoadsStartupsInOneQuery(t *testing.T) {
+	f := newFollowFixture()
+	ctx := context.Background()
+	for _, id := range []string{"acme", "globex", "initech"} {
+		if err := f.follow.Execute(ctx, "ann", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	startups := &memStartups{}
+	list := followusecase.NewListFollowsUseCase(f.follows, startups)
+	out, total, err := list.Execute(ctx, "ann", 1, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 || len(out) != 3 {
+		t.Fatalf("got %d of %d follows, want 3", len(out), total)
+	}
+	if startups.batches != 1 {
+		t.Fatalf("loaded startups in %d queries, want 1", startups.batches)
+	}
+}
@@ -0,0 +1,192 @@
+package job
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+// Reasons reported when an inbound message is accepted but not forwarded.
+const (
+	RelayDropUnknownAddress = "unknown_address"
+	RelayDropJobClosed      = "job_closed"
+	RelayDropNoDestination  = "no_destination"
+	RelayDropRateLimited    = "rate_limited"
+)
+
+// ApplyRelayAddressUseCase returns the public application address for a job.
+// When a relay domain is configured the real ApplicationEmail is never exposed.
+type ApplyRelayAddressUseCase struct {
+	jobRepo repository.JobRepository
+	domain  string
+	logger  logger.Logger
+}
+
+func NewApplyRelayAddressUseCase(jobRepo repository.JobRepository, domain string, logger logger.Logger) *ApplyRelayAddressUseCase {
+	return &ApplyRelayAddressUseCase{jobRepo: jobRepo, domain: domain, logger: logger}
+}
+
+// Execute returns nil when the job has no application email. Jobs created
+// before relay tokens existed get one assigned on first read.
+func (uc *ApplyRelayAddressUseCase) Execute(ctx context.Context, job *entity.Job) *string {
+	if job.ApplicationEmail == nil || *job.ApplicationEmail == "" {
+		return nil
+	}
+	if uc.domain == "" {
+		return job.ApplicationEmail
+	}
+	if job.ApplyRelayToken == "" {
+		token, err := utils.GenerateRelayToken()
+		if err != nil {
+			uc.logger.WithContext(ctx).Error("Failed to generate relay token for job %s: %v", job.ID, err)
+			return nil
+		}
+		if err := uc.jobRepo.SetApplyRelayToken(ctx, job.ID, token); err != nil {
+			uc.logger.WithContext(ctx).Error("Failed to assign relay token to job %s: %v", job.ID, err)
+			return nil
+		}
+		job.ApplyRelayToken = token
+	}
+	addr := utils.RelayAddress(job.ApplyRelayToken, uc.domain)
+	return &addr
+}
+
+// ForwardApplicationUseCase relays inbound mail sent to apply+<token>@domain to
+// the startup's real application email.
+type ForwardApplicationUseCase struct {
+	jobRepo      repository.JobRepository
+	emailService port.EmailService
+	domain       string
+	limiter      *senderLimiter
+	logger       logger.Logger
+}
+
+func NewForwardApplicationUseCase(
+	jobRepo repository.JobRepository,
+	emailService port.EmailService,
+	domain string,
+	senderLimit int,
+	senderWindow time.Duration,
+	logger logger.Logger,
+) *ForwardApplicationUseCase {
+	return &ForwardApplicationUseCase{
+		jobRepo:      jobRepo,
+		emailService: emailService,
+		domain:       domain,
+		limiter:      newSenderLimiter(senderLimit, senderWindow),
+		logger:       logger,
+	}
+}
+
+// Execute forwards the message or reports why it was dropped. Drops are not
+// errors: the mail provider should not retry them.
+func (uc *ForwardApplicationUseCase) Execute(ctx context.Context, input dto.InboundApplicationInput) (*dto.InboundApplicationOutput, error) {
+	ctx, span := tracing.Start(ctx, "job.ForwardApplication")
+	defer span.End()
+
+	if uc.domain == "" {
+		return nil, errors.NewBadRequestError("application relay is not configured")
+	}
+	sender, err := mail.ParseAddress(strings.TrimSpace(input.From))
+	if err != nil {
+		return nil, errors.NewBadRequestError("invalid sender address")
+	}
+
+	var token string
+	for _, rcpt := range input.To {
+		if t, ok := utils.ParseRelayAddress(rcpt, uc.domain); ok {
+			token = t
+			break
+		}
+	}
+	if token == "" {
+		return &dto.InboundApplicationOutput{Reason: RelayDropUnknownAddress}, nil
+	}
+
+	job, err := uc.jobRepo.FindByApplyRelayToken(ctx, token)
+	if err != nil || job == nil {
+		return &dto.InboundApplicationOutput{Reason: RelayDropUnknownAddress}, nil
+	}
+	if job.Status != entity.JobStatusActive || (job.ExpiresAt != nil && job.ExpiresAt.Before(time.Now())) {
+		return &dto.InboundApplicationOutput{Reason: RelayDropJobClosed}, nil
+	}
+	if job.ApplicationEmail == nil || *job.ApplicationEmail == "" {
+		return &dto.InboundApplicationOutput{Reason: RelayDropNoDestination}, nil
+	}
+	if !uc.limiter.allow(strings.ToLower(sender.Address)) {
+		uc.logger.WithContext(ctx).Warn("Apply relay rate limit hit for sender %s on job %s", sender.Address, job.ID)
+		return &dto.InboundApplicationOutput{Reason: RelayDropRateLimited}, nil
+	}
+
+	msg := port.ApplicationMessage{
+		From:     sender.Address,
+		Subject:  input.Subject,
+		Text:     input.Text,
+		HTML:     input.HTML,
+		JobTitle: job.Title,
+	}
+	if err := uc.emailService.ForwardApplicationEmail(ctx, *job.ApplicationEmail, msg); err != nil {
+		uc.logger.WithContext(ctx).Error("Failed to forward application for job %s: %v", job.ID, err)
+		return nil, errors.ErrInternalError
+	}
+	return &dto.InboundApplicationOutput{Forwarded: true}, nil
+}
+
+// senderLimiter is a per-sender sliding window, kept in memory like the HTTP rate limiter.
+type senderLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	sent   map[string][]time.Time
+}
+
+func newSenderLimiter(limit int, window time.Duration) *senderLimiter {
+	if limit <= 0 {
+		limit = 5
+	}
+	if window <= 0 {
+		window = time.Hour
+	}
+	return &senderLimiter{limit: limit, window: window, sent: make(map[string][]time.Time)}
+}
+
+func (l *senderLimiter) allow(sender string) bool {
+	now := time.Now()
+	cutoff := now.Add(-l.window)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	kept := l.sent[sender][:0]
+	for _, t := range l.sent[sender] {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) >= l.limit {
+		l.sent[sender] = kept
+		return false
+	}
+	l.sent[sender] = append(kept, now)
+
+	// Opportunistic cleanup so one-off senders don't accumulate forever.
+	if len(l.sent) > 10000 {
+		for k, times := range l.sent {
+			if len(times) == 0 || times[len(times)-1].Before(cutoff) {
+				delete(l.sent, k)
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package job_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+const relayDomain = "apply.joinus.example"
+
+type relayJobs struct {
+	repository.JobRepository
+	byToken map[string]*entity.Job
+}
+
+func (r *relayJobs) FindByApplyRelayToken(ctx context.Context, token string) (*entity.Job, error) {
+	return r.byToken[token], nil
+}
+
+type forwardedMail struct {
+	port.EmailService
+	to []string
+}
+
+func (m *forwardedMail) ForwardApplicationEmail(ctx context.Context, toEmail string, msg port.ApplicationMessage) error {
+	m.to = append(m.to, toEmail)
+	return nil
+}
+
+func relayJob(token string, mutate func(*entity.Job)) *entity.Job {
+	dest := "jobs@acme.example"
+	job := &entity.Job{ID: "job-" + token[:4], Title: "Engineer", Status: entity.JobStatusActive, ApplicationEmail: &dest, ApplyRelayToken: token}
+	if mutate != nil {
+		mutate(job)
+	}
+	return job
+}
+
+func inbound(from, token string) dto.InboundApplicationInput {
+	return dto.InboundApplicationInput{From: from, To: []string{utils.RelayAddress(token, relayDomain)}, Subject: "Application"}
+}
+
+func TestForwardApplicationDropsUnknownAndClosedJobs(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	jobs := &relayJobs{byToken: map[string]*entity.Job{
+		"aaaaaaaaaaaaaaaaaaaa": relayJob("aaaaaaaaaaaaaaaaaaaa", func(j *entity.Job) { j.Status = entity.JobStatusClosed }),
+		"bbbbbbbbbbbbbbbbbbbb": relayJob("bbbbbbbbbbbbbbbbbbbb", func(j *entity.Job) { j.ExpiresAt = &past }),
+	}}
+	mail := &forwardedMail{}
+	uc := jobusecase.NewForwardApplicationUseCase(jobs, mail, relayDomain, 5, time.Hour, logger.New(logger.Options{Output: io.Discard}))
+
+	for name, tc := range map[string]struct {
+		input dto.InboundApplicationInput
+		want  string
+	}{
+		"unknown token": {inbound("a@cand.example", "cccccccccccccccccccc"), jobusecase.RelayDropUnknownAddress},
+		"other domain":  {dto.InboundApplicationInput{From: "a@cand.example", To: []string{"apply+aaaaaaaaaaaaaaaaaaaa@evil.example"}}, jobusecase.RelayDropUnknownAddress},
+		"closed job":    {inbound("a@cand.example", "aaaaaaaaaaaaaaaaaaaa"), jobusecase.RelayDropJobClosed},
+		"expired job":   {inbound("a@cand.example", "bbbbbbbbbbbbbbbbbbbb"), jobusecase.RelayDropJobClosed},
+	} {
+		out, err := uc.Execute(context.Background(), tc.input)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if out.Forwarded || out.Reason != tc.want {
+			t.Errorf("%s: got %+v, want reason %s", name, out, tc.want)
+		}
+	}
+	if len(mail.to) != 0 {
+		t.Fatalf("forwarded %d messages, want none", len(mail.to))
+	}
+}
+
+func TestForwardApplicationLimitsEachSender(t *testing.T) {
+	token := "dddddddddddddddddddd"
+	jobs := &relayJobs{byToken: map[string]*entity.Job{token: relayJob(token, nil)}}
+	mail := &forwardedMail{}
+	uc := jobusecase.NewForwardApplicationUseCase(jobs, mail, relayDomain, 2, time.Hour, logger.New(logger.Options{Output: io.Discard}))
+	ctx := context.Background()
+
+	for i, from := range []string{"spam@cand.example", "Spam <SPAM@cand.example>"} {
+		if out, err := uc.Execute(ctx, inbound(from, token)); err != nil || !out.Forwarded {
+			t.Fatalf("message %d: out=%+v err=%v", i, out, err)
+		}
+	}
+	out, err := uc.Execute(ctx, inbound("spam@cand.example", token))
+	if err != nil || out.Forwarded || out.Reason != jobusecase.RelayDropRateLimited {
+		t.Fatalf("third message: out=%+v err=%v, want rate limited", out, err)
+	}
+	if out, err := uc.Execute(ctx, inbound("other@cand.example", token)); err != nil || !out.Forwarded {
+		t.Fatalf("another sender must not be limited: out=%+v err=%v", out, err)
+	}
+	if len(mail.to) != 3 || mail.to[0] != "jobs@acme.example" {
+		t.Fatalf("forwarded to %v", mail.to)
+	}
+}
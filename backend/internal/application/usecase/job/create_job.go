@@ -6,11 +6,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -18,7 +20,12 @@ type CreateJobUseCase struct {
 	jobRepo      repository.JobRepository
 	startupRepo  repository.StartupRepository
 	memberRepo   repository.StartupMemberRepository
+	followNotificationRepo repository.FollowNotificationRepository
 	authService  *service.AuthorizationService
+	verification *service.EmailVerificationPolicy
+	tx           port.TxManager
+	events       port.EventRecorder
+	cache        port.PublicCache
 	logger       logger.Logger
 }
 
@@ -26,19 +33,32 @@ func NewCreateJobUseCase(
 	jobRepo repository.JobRepository,
 	startupRepo repository.StartupRepository,
 	memberRepo repository.StartupMemberRepository,
+	followNotificationRepo repository.FollowNotificationRepository,
 	authService *service.AuthorizationService,
+	verification *service.EmailVerificationPolicy,
+	tx port.TxManager,
+	events port.EventRecorder,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *CreateJobUseCase {
 	return &CreateJobUseCase{
 		jobRepo:     jobRepo,
 		startupRepo: startupRepo,
 		memberRepo:  memberRepo,
+		followNotificationRepo: followNotificationRepo,
 		authService: authService,
+		verification: verification,
+		tx:          tx,
+		events:      events,
+		cache:       cache,
 		logger:      logger,
 	}
 }
 
 func (uc *CreateJobUseCase) Execute(ctx context.Context, input dto.CreateJobInput, userID string, apiTokenStartupID string) (*dto.JobOutput, error) {
+	ctx, span := tracing.Start(ctx, "job.CreateJob")
+	defer span.End()
+
 	if apiTokenStartupID != "" {
 		input.StartupID = apiTokenStartupID
 	}
@@ -54,6 +74,9 @@ func (uc *CreateJobUseCase) Execute(ctx context.Context, input dto.CreateJobInpu
 		if err != nil || !canManage {
 			return nil, errors.NewForbiddenError("you don't have permission to create jobs for this startup")
 		}
+		if ok, err := uc.verification.CanPublish(ctx, userID); err != nil || !ok {
+			return nil, errors.NewForbiddenError("verify your email address before posting jobs")
+		}
 	}
 
 	if input.ApplicationURL != nil && *input.ApplicationURL != "" {
@@ -72,6 +95,11 @@ func (uc *CreateJobUseCase) Execute(ctx context.Context, input dto.CreateJobInpu
 		expiresAt = &parsed
 	}
 
+	relayToken, err := utils.GenerateRelayToken()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create job
 	job := &entity.Job{
 		ID:              uuid.New().String(),
@@ -88,15 +116,28 @@ func (uc *CreateJobUseCase) Execute(ctx context.Context, input dto.CreateJobInpu
 		Currency:        input.Currency,
 		ApplicationURL:  input.ApplicationURL,
 		ApplicationEmail: input.ApplicationEmail,
+		ApplyRelayToken: relayToken,
 		Status:          entity.JobStatusActive,
 		ExpiresAt:       expiresAt,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
 
-	if err := uc.jobRepo.Create(ctx, job); err != nil {
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.jobRepo.Create(ctx, job); err != nil {
+			return err
+		}
+		return recordJobEvent(ctx, uc.events, entity.DomainEventJobCreated, job)
+	})
+	if err != nil {
 		return nil, err
 	}
+	uc.cache.Invalidate(port.CacheScopeJobs, port.CacheScopeStartups)
+
+	// Followers are notified in the next digest; a failure here must not fail the posting.
+	if _, err := uc.followNotificationRepo.EnqueueForJob(ctx, job.StartupID, job.ID, job.CreatedAt); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to queue follower notifications for job %s: %v", job.ID, err)
+	}
 
 	return uc.toOutput(job, startup.Name), nil
 }
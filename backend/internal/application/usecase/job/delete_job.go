@@ -3,31 +3,46 @@ package job
 import (
 	"context"
 
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type DeleteJobUseCase struct {
 	jobRepo     repository.JobRepository
 	authService *service.AuthorizationService
+	tx          port.TxManager
+	events      port.EventRecorder
+	cache       port.PublicCache
 	logger      logger.Logger
 }
 
 func NewDeleteJobUseCase(
 	jobRepo repository.JobRepository,
 	authService *service.AuthorizationService,
+	tx port.TxManager,
+	events port.EventRecorder,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *DeleteJobUseCase {
 	return &DeleteJobUseCase{
 		jobRepo:     jobRepo,
 		authService: authService,
+		tx:          tx,
+		events:      events,
+		cache:       cache,
 		logger:      logger,
 	}
 }
 
 func (uc *DeleteJobUseCase) Execute(ctx context.Context, jobID string, userID string, apiTokenStartupID string) error {
+	ctx, span := tracing.Start(ctx, "job.DeleteJob")
+	defer span.End()
+
 	job, err := uc.jobRepo.FindByID(ctx, jobID)
 	if err != nil {
 		return errors.NewNotFoundError("job")
@@ -44,7 +59,17 @@ func (uc *DeleteJobUseCase) Execute(ctx context.Context, jobID string, userID st
 		}
 	}
 
-	return uc.jobRepo.Delete(ctx, jobID)
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.jobRepo.Delete(ctx, jobID); err != nil {
+			return err
+		}
+		return recordJobEvent(ctx, uc.events, entity.DomainEventJobDeleted, job)
+	})
+	if err != nil {
+		return err
+	}
+	uc.cache.Invalidate(port.CacheScopeJobs, port.CacheScopeStartups)
+	return nil
 }
 
 
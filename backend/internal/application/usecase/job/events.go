@@ -0,0 +1,51 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+// jobEventData is the payload of job.* domain events, and so the "data"
+// object of job.* webhooks.
+type jobEventData struct {
+	ID           string  `json:"id"`
+	StartupID    string  `json:"startup_id"`
+	Title        string  `json:"title"`
+	Status       string  `json:"status"`
+	JobType      string  `json:"job_type"`
+	LocationType string  `json:"location_type"`
+	ExpiresAt    *string `json:"expires_at"`
+	BoostedUntil *string `json:"boosted_until"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+func recordJobEvent(ctx context.Context, events port.EventRecorder, eventType entity.DomainEventType, job *entity.Job) error {
+	data := jobEventData{
+		ID: job.ID, StartupID: job.StartupID, Title: job.Title, Status: string(job.Status),
+		JobType: string(job.JobType), LocationType: string(job.LocationType),
+		CreatedAt: job.CreatedAt.Format(time.RFC3339), UpdatedAt: job.UpdatedAt.Format(time.RFC3339),
+	}
+	if job.ExpiresAt != nil {
+		s := job.ExpiresAt.Format(time.RFC3339)
+		data.ExpiresAt = &s
+	}
+	if job.BoostedUntil != nil {
+		s := job.BoostedUntil.Format(time.RFC3339)
+		data.BoostedUntil = &s
+	}
+	return events.Record(ctx, &entity.DomainEvent{
+		Type: eventType, AggregateType: "job", AggregateID: job.ID, StartupID: job.StartupID,
+	}, data)
+}
+
+// jobChangeEvent is job.closed when an update takes a job out of active, else job.updated.
+func jobChangeEvent(previous entity.JobStatus, job *entity.Job) entity.DomainEventType {
+	if previous == entity.JobStatusActive && (job.Status == entity.JobStatusClosed || job.Status == entity.JobStatusFilled) {
+		return entity.DomainEventJobClosed
+	}
+	return entity.DomainEventJobUpdated
+}
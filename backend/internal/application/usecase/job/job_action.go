@@ -0,0 +1,119 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+// JobExtendPeriod is how far "extend" pushes a listing's expiry.
+const JobExtendPeriod = 30 * 24 * time.Hour
+
+// ApplyJobActionUseCase executes a signed one-click link from a reminder
+// email. No login is required: the token authorizes exactly one job and one
+// action, expires, and is void once the job changes.
+type ApplyJobActionUseCase struct {
+	jobRepo      repository.JobRepository
+	actionTokens port.ActionTokenService
+	tx           port.TxManager
+	events       port.EventRecorder
+	cache        port.PublicCache
+	logger       logger.Logger
+}
+
+func NewApplyJobActionUseCase(jobRepo repository.JobRepository, actionTokens port.ActionTokenService, tx port.TxManager, events port.EventRecorder, cache port.PublicCache, logger logger.Logger) *ApplyJobActionUseCase {
+	return &ApplyJobActionUseCase{jobRepo: jobRepo, actionTokens: actionTokens, tx: tx, events: events, cache: cache, logger: logger}
+}
+
+// Preview validates the token without changing the job, so the confirmation
+// page can show what will happen (and link scanners can't trigger it).
+func (uc *ApplyJobActionUseCase) Preview(ctx context.Context, token string) (*dto.JobActionOutput, error) {
+	job, action, err := uc.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return toJobActionOutput(job, action, false), nil
+}
+
+func (uc *ApplyJobActionUseCase) Execute(ctx context.Context, token string) (*dto.JobActionOutput, error) {
+	ctx, span := tracing.Start(ctx, "job.ApplyJobAction")
+	defer span.End()
+
+	job, action, err := uc.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	previousStatus := job.Status
+	switch action {
+	case entity.JobActionExtend:
+		base := now
+		if job.ExpiresAt != nil && job.ExpiresAt.After(now) {
+			base = *job.ExpiresAt
+		}
+		expiresAt := base.Add(JobExtendPeriod)
+		job.ExpiresAt = &expiresAt
+	case entity.JobActionFill:
+		job.Status = entity.JobStatusFilled
+	case entity.JobActionClose:
+		job.Status = entity.JobStatusClosed
+	}
+	job.UpdatedAt = now
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.jobRepo.Update(ctx, job); err != nil {
+			return err
+		}
+		return recordJobEvent(ctx, uc.events, jobChangeEvent(previousStatus, job), job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.cache.Invalidate(port.CacheScopeJobs, port.CacheScopeStartups)
+	uc.logger.WithContext(ctx).Info("Job %s: one-click %s applied", job.ID, action)
+	return toJobActionOutput(job, action, true), nil
+}
+
+func (uc *ApplyJobActionUseCase) resolve(ctx context.Context, token string) (*entity.Job, entity.JobAction, error) {
+	invalid := errors.NewBadRequestError("invalid or expired link")
+
+	claims, err := uc.actionTokens.Verify(token)
+	if err != nil {
+		return nil, "", invalid
+	}
+	action := entity.JobAction(claims.Action)
+	if !action.IsValid() {
+		return nil, "", invalid
+	}
+	job, err := uc.jobRepo.FindByID(ctx, claims.Subject)
+	if err != nil || job == nil {
+		return nil, "", invalid
+	}
+	if job.UpdatedAt.Unix() != claims.Version || job.Status != entity.JobStatusActive {
+		return nil, "", errors.NewBadRequestError("this link has already been used or the job has changed")
+	}
+	return job, action, nil
+}
+
+func toJobActionOutput(job *entity.Job, action entity.JobAction, applied bool) *dto.JobActionOutput {
+	output := &dto.JobActionOutput{
+		JobID:   job.ID,
+		Title:   job.Title,
+		Action:  string(action),
+		Status:  string(job.Status),
+		Applied: applied,
+	}
+	if job.ExpiresAt != nil {
+		expiresAtStr := job.ExpiresAt.Format(time.RFC3339)
+		output.ExpiresAt = &expiresAtStr
+	}
+	return output
+}
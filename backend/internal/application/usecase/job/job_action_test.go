@@ -0,0 +1,88 @@
+package job_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/infrastructure/auth"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type noEvents struct{}
+
+func (noEvents) Record(ctx context.Context, event *entity.DomainEvent, data interface{}) error {
+	return nil
+}
+
+type noCache struct{}
+
+func (noCache) Invalidate(scopes ...port.CacheScope) {}
+
+func newJobActions(jobs *memJobs) *jobusecase.ApplyJobActionUseCase {
+	return jobusecase.NewApplyJobActionUseCase(jobs, auth.NewActionTokenService(actionSecret), inlineTx{}, noEvents{}, noCache{}, logger.New(logger.Options{Output: io.Discard}))
+}
+
+func signAction(t *testing.T, job *entity.Job, action entity.JobAction, expiresAt time.Time) string {
+	t.Helper()
+	token, err := auth.NewActionTokenService(actionSecret).Sign(port.ActionClaims{
+		Subject: job.ID, Action: string(action), Version: job.UpdatedAt.Unix(), ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestJobActionRefusesStaleExpiredAndClosed(t *testing.T) {
+	updatedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	job := &entity.Job{ID: "job-1", Title: "Engineer", Status: entity.JobStatusActive, UpdatedAt: updatedAt}
+	jobs := &memJobs{jobs: map[string]*entity.Job{"job-1": job}}
+	uc := newJobActions(jobs)
+	ctx := context.Background()
+
+	expired := signAction(t, job, entity.JobActionExtend, time.Now().Add(-time.Minute))
+	if _, err := uc.Execute(ctx, expired); err == nil {
+		t.Fatal("expired token was accepted")
+	}
+
+	stale := signAction(t, job, entity.JobActionExtend, time.Now().Add(time.Hour))
+	jobs.jobs["job-1"].UpdatedAt = updatedAt.Add(time.Minute)
+	if _, err := uc.Execute(ctx, stale); err == nil {
+		t.Fatal("token for an older job version was accepted")
+	}
+
+	jobs.jobs["job-1"].Status = entity.JobStatusClosed
+	closed := signAction(t, jobs.jobs["job-1"], entity.JobActionFill, time.Now().Add(time.Hour))
+	if _, err := uc.Execute(ctx, closed); err == nil {
+		t.Fatal("action on a closed job was accepted")
+	}
+	if jobs.jobs["job-1"].Status != entity.JobStatusClosed || jobs.jobs["job-1"].ExpiresAt != nil {
+		t.Fatalf("job changed: %+v", jobs.jobs["job-1"])
+	}
+}
+
+func TestJobActionIsSingleUse(t *testing.T) {
+	job := &entity.Job{ID: "job-1", Title: "Engineer", Status: entity.JobStatusActive, UpdatedAt: time.Now().Add(-time.Hour)}
+	jobs := &memJobs{jobs: map[string]*entity.Job{"job-1": job}}
+	uc := newJobActions(jobs)
+	token := signAction(t, job, entity.JobActionExtend, time.Now().Add(time.Hour))
+
+	out, err := uc.Execute(context.Background(), token)
+	if err != nil || !out.Applied || jobs.jobs["job-1"].ExpiresAt == nil {
+		t.Fatalf("extend: out=%+v err=%v", out, err)
+	}
+	if _, err := uc.Execute(context.Background(), token); err == nil {
+		t.Fatal("link was accepted twice")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -34,6 +35,9 @@ func NewListJobsUseCase(
 // Execute lists jobs. When lean is true, descriptions are truncated and
 // application contact fields are omitted (anti-scrape for list dumps).
 func (uc *ListJobsUseCase) Execute(ctx context.Context, filter repository.JobFilter, lean bool) ([]*dto.JobOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "job.ListJobs")
+	defer span.End()
+
 	jobs, total, err := uc.jobRepo.List(ctx, filter)
 	if err != nil {
 		return nil, 0, err
@@ -0,0 +1,151 @@
+package job
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const reminderBatchSize = 200
+
+// JobReminderSettings tunes when owner reminders go out.
+type JobReminderSettings struct {
+	ExpiryLead time.Duration // remind this long before ExpiresAt
+	StaleAfter time.Duration // remind when a listing hasn't changed for this long
+	LinkTTL    time.Duration // lifetime of the one-click action links
+}
+
+// SendJobRemindersUseCase emails team members holding jobs:write about
+// listings that are about to expire or have gone stale.
+type SendJobRemindersUseCase struct {
+	jobRepo      repository.JobRepository
+	startupRepo  repository.StartupRepository
+	userRepo     repository.UserRepository
+	authService  *service.AuthorizationService
+	emailService port.EmailService
+	actionTokens port.ActionTokenService
+	settings     JobReminderSettings
+	appURL       string
+	logger       logger.Logger
+}
+
+func NewSendJobRemindersUseCase(
+	jobRepo repository.JobRepository,
+	startupRepo repository.StartupRepository,
+	userRepo repository.UserRepository,
+	authService *service.AuthorizationService,
+	emailService port.EmailService,
+	actionTokens port.ActionTokenService,
+	settings JobReminderSettings,
+	appURL string,
+	logger logger.Logger,
+) *SendJobRemindersUseCase {
+	return &SendJobRemindersUseCase{
+		jobRepo: jobRepo, startupRepo: startupRepo, userRepo: userRepo,
+		authService: authService, emailService: emailService, actionTokens: actionTokens,
+		settings: settings, appURL: strings.TrimRight(appURL, "/"), logger: logger,
+	}
+}
+
+// Execute sends one batch of reminders. A job that is both expiring and stale
+// only gets the expiry reminder in a given run.
+func (uc *SendJobRemindersUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "job.SendJobReminders")
+	defer span.End()
+
+	now := time.Now()
+
+	expiring, err := uc.jobRepo.FindExpiringForReminder(ctx, now.Add(uc.settings.ExpiryLead), reminderBatchSize)
+	if err != nil {
+		return err
+	}
+	handled := make(map[string]bool, len(expiring))
+	for _, job := range expiring {
+		handled[job.ID] = true
+		uc.remind(ctx, job, entity.JobReminderExpiring, now)
+	}
+
+	stale, err := uc.jobRepo.FindStaleForReminder(ctx, now.Add(-uc.settings.StaleAfter), reminderBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, job := range stale {
+		if handled[job.ID] {
+			continue
+		}
+		uc.remind(ctx, job, entity.JobReminderStale, now)
+	}
+	return nil
+}
+
+func (uc *SendJobRemindersUseCase) remind(ctx context.Context, job *entity.Job, kind entity.JobReminderKind, now time.Time) {
+	startup, err := uc.startupRepo.FindByID(ctx, job.StartupID)
+	if err != nil || startup == nil {
+		uc.logger.WithContext(ctx).Warn("Job reminder: startup %s not found for job %s", job.StartupID, job.ID)
+		return
+	}
+
+	var recipients []port.EmailRecipient
+	if startup.TeamID != nil && *startup.TeamID != "" {
+		members, err := uc.authService.TeamMembersWithScope(ctx, *startup.TeamID, entity.ScopeJobsWrite)
+		if err != nil {
+			uc.logger.WithContext(ctx).Error("Job reminder: failed to list members for team %s: %v", *startup.TeamID, err)
+			return
+		}
+		for _, m := range members {
+			if user, err := uc.userRepo.FindByID(ctx, m.UserID); err == nil && user != nil {
+				recipients = append(recipients, port.EmailRecipient{Email: user.Email, Locale: user.Locale})
+			}
+		}
+	}
+
+	if len(recipients) > 0 {
+		reminder := port.JobReminderEmail{
+			Kind:        kind,
+			JobTitle:    job.Title,
+			StartupName: startup.Name,
+			ExpiresAt:   job.ExpiresAt,
+		}
+		links := map[entity.JobAction]*string{
+			entity.JobActionExtend: &reminder.ExtendURL,
+			entity.JobActionFill:   &reminder.FillURL,
+			entity.JobActionClose:  &reminder.CloseURL,
+		}
+		for action, dst := range links {
+			// Version ties the link to the job's current state: any later update
+			// (including another one-click action) invalidates outstanding links.
+			token, err := uc.actionTokens.Sign(port.ActionClaims{
+				Subject:   job.ID,
+				Action:    string(action),
+				Version:   job.UpdatedAt.Unix(),
+				ExpiresAt: now.Add(uc.settings.LinkTTL),
+			})
+			if err != nil {
+				uc.logger.WithContext(ctx).Error("Job reminder: failed to sign %s link for job %s: %v", action, job.ID, err)
+				return
+			}
+			*dst = uc.appURL + "/jobs/actions?token=" + url.QueryEscape(token)
+		}
+
+		for _, to := range recipients {
+			if err := uc.emailService.Send(ctx, port.EmailJobReminder, reminder, to); err != nil {
+				uc.logger.WithContext(ctx).Warn("Job reminder: failed to email %s for job %s: %v", to.Email, job.ID, err)
+			}
+		}
+	} else {
+		uc.logger.WithContext(ctx).Debug("Job reminder: no jobs:write recipients for job %s", job.ID)
+	}
+
+	// Marked even without recipients so the job isn't rescanned every run.
+	if err := uc.jobRepo.MarkReminderSent(ctx, job.ID, kind, now); err != nil {
+		uc.logger.WithContext(ctx).Error("Job reminder: failed to mark %s reminder for job %s: %v", kind, job.ID, err)
+	}
+}
@@ -0,0 +1,204 @@
+package job_test
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/internal/infrastructure/auth"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// memJobs keeps jobs in a map and mirrors the repository's reminder queries.
+type memJobs struct {
+	repository.JobRepository
+	jobs map[string]*entity.Job
+}
+
+func (r *memJobs) FindByID(ctx context.Context, id string) (*entity.Job, error) {
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *job
+	return &copied, nil
+}
+
+func (r *memJobs) Update(ctx context.Context, job *entity.Job) error {
+	copied := *job
+	r.jobs[job.ID] = &copied
+	return nil
+}
+
+func (r *memJobs) FindExpiringForReminder(ctx context.Context, before time.Time, limit int) ([]*entity.Job, error) {
+	var out []*entity.Job
+	for _, job := range r.jobs {
+		if job.Status == entity.JobStatusActive && job.ExpiresAt != nil && job.ExpiresAt.After(time.Now()) && !job.ExpiresAt.After(before) &&
+			(job.ExpiryReminderSentAt == nil || job.ExpiryReminderSentAt.Before(job.UpdatedAt)) {
+			copied := *job
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memJobs) FindStaleForReminder(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Job, error) {
+	var out []*entity.Job
+	for _, job := range r.jobs {
+		if job.Status == entity.JobStatusActive && job.UpdatedAt.Before(updatedBefore) &&
+			(job.StaleReminderSentAt == nil || job.StaleReminderSentAt.Before(job.UpdatedAt)) {
+			copied := *job
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memJobs) MarkReminderSent(ctx context.Context, id string, kind entity.JobReminderKind, at time.Time) error {
+	if kind == entity.JobReminderStale {
+		r.jobs[id].StaleReminderSentAt = &at
+	} else {
+		r.jobs[id].ExpiryReminderSentAt = &at
+	}
+	return nil
+}
+
+type reminderStartups struct {
+	repository.StartupRepository
+	startup *entity.Startup
+}
+
+func (r *reminderStartups) FindByID(ctx context.Context, id string) (*entity.Startup, error) {
+	return r.startup, nil
+}
+
+type reminderUsers struct {
+	repository.UserRepository
+}
+
+func (r *reminderUsers) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	return &entity.User{ID: id, Email: id + "@acme.example"}, nil
+}
+
+type reminderMembers struct {
+	repository.TeamMemberRepository
+	members []*entity.TeamMember
+}
+
+func (r *reminderMembers) FindByTeamID(ctx context.Context, teamID string) ([]*entity.TeamMember, error) {
+	return r.members, nil
+}
+
+type reminderRoles struct {
+	repository.RoleRepository
+	roles map[string]*entity.Role
+}
+
+func (r *reminderRoles) FindByID(ctx context.Context, id string) (*entity.Role, error) {
+	return r.roles[id], nil
+}
+
+type sentMail struct {
+	port.EmailService
+	to        []string
+	reminders []port.JobReminderEmail
+}
+
+func (m *sentMail) Send(ctx context.Context, template string, data interface{}, to port.EmailRecipient) error {
+	m.to = append(m.to, to.Email)
+	m.reminders = append(m.reminders, data.(port.JobReminderEmail))
+	return nil
+}
+
+const actionSecret = "reminder-test-secret"
+
+func newReminders(jobs *memJobs, mail *sentMail) *jobusecase.SendJobRemindersUseCase {
+	teamID := "team-1"
+	members := &reminderMembers{members: []*entity.TeamMember{
+		{UserID: "editor", RoleID: "editor", Status: entity.MemberStatusActive},
+		{UserID: "viewer", RoleID: "viewer", Status: entity.MemberStatusActive},
+	}}
+	roles := &reminderRoles{roles: map[string]*entity.Role{
+		"editor": {ID: "editor", Scopes: []entity.Scope{entity.ScopeJobsWrite}},
+		"viewer": {ID: "viewer"},
+	}}
+	startups := &reminderStartups{startup: &entity.Startup{ID: "startup-1", Name: "Acme", TeamID: &teamID}}
+	authz := service.NewAuthorizationService(&reminderUsers{}, members, roles, startups, nil)
+	return jobusecase.NewSendJobRemindersUseCase(
+		jobs, startups, &reminderUsers{}, authz, mail, auth.NewActionTokenService(actionSecret),
+		jobusecase.JobReminderSettings{ExpiryLead: 72 * time.Hour, StaleAfter: 30 * 24 * time.Hour, LinkTTL: 7 * 24 * time.Hour},
+		"https://joinus.example/", logger.New(logger.Options{Output: io.Discard}),
+	)
+}
+
+func TestJobRemindersSentOncePerWindow(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(24 * time.Hour)
+	jobs := &memJobs{jobs: map[string]*entity.Job{
+		"expiring": {ID: "expiring", StartupID: "startup-1", Title: "Engineer", Status: entity.JobStatusActive, ExpiresAt: &expiresAt, UpdatedAt: now.Add(-60 * 24 * time.Hour)},
+		"fresh":    {ID: "fresh", StartupID: "startup-1", Title: "Designer", Status: entity.JobStatusActive, UpdatedAt: now},
+	}}
+	mail := &sentMail{}
+	uc := newReminders(jobs, mail)
+
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(mail.to) != 1 || mail.to[0] != "editor@acme.example" {
+		t.Fatalf("sent to %v, want only the jobs:write member", mail.to)
+	}
+	// Expiring and stale at once: only the expiry reminder goes out.
+	if mail.reminders[0].Kind != entity.JobReminderExpiring {
+		t.Fatalf("kind = %s", mail.reminders[0].Kind)
+	}
+	if !strings.HasPrefix(mail.reminders[0].ExtendURL, "https://joinus.example/jobs/actions?token=") {
+		t.Fatalf("extend url = %s", mail.reminders[0].ExtendURL)
+	}
+
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// The second run sends the stale reminder that the first one held back,
+	// and nothing is sent again after that.
+	if len(mail.to) != 2 || mail.reminders[1].Kind != entity.JobReminderStale {
+		t.Fatalf("got %d reminders after three runs, want expiry then stale", len(mail.to))
+	}
+}
+
+func TestJobReminderLinkAppliesAction(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(24 * time.Hour)
+	jobs := &memJobs{jobs: map[string]*entity.Job{
+		"job-1": {ID: "job-1", StartupID: "startup-1", Title: "Engineer", Status: entity.JobStatusActive, ExpiresAt: &expiresAt, UpdatedAt: now},
+	}}
+	mail := &sentMail{}
+	if err := newReminders(jobs, mail).Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(mail.reminders) != 1 {
+		t.Fatalf("sent %d reminders", len(mail.reminders))
+	}
+	link, err := url.Parse(mail.reminders[0].CloseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := newJobActions(jobs).Execute(context.Background(), link.Query().Get("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Applied || jobs.jobs["job-1"].Status != entity.JobStatusClosed {
+		t.Fatalf("close link not applied: %+v", out)
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"time"
 
 	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -17,6 +19,9 @@ type UpdateJobUseCase struct {
 	jobRepo     repository.JobRepository
 	startupRepo repository.StartupRepository
 	authService *service.AuthorizationService
+	tx          port.TxManager
+	events      port.EventRecorder
+	cache       port.PublicCache
 	logger      logger.Logger
 }
 
@@ -24,17 +29,26 @@ func NewUpdateJobUseCase(
 	jobRepo repository.JobRepository,
 	startupRepo repository.StartupRepository,
 	authService *service.AuthorizationService,
+	tx port.TxManager,
+	events port.EventRecorder,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *UpdateJobUseCase {
 	return &UpdateJobUseCase{
 		jobRepo:     jobRepo,
 		startupRepo: startupRepo,
 		authService: authService,
+		tx:          tx,
+		events:      events,
+		cache:       cache,
 		logger:      logger,
 	}
 }
 
 func (uc *UpdateJobUseCase) Execute(ctx context.Context, input dto.UpdateJobInput, userID string, apiTokenStartupID string) (*dto.JobOutput, error) {
+	ctx, span := tracing.Start(ctx, "job.UpdateJob")
+	defer span.End()
+
 	job, err := uc.jobRepo.FindByID(ctx, input.ID)
 	if err != nil {
 		return nil, errors.NewNotFoundError("job")
@@ -51,6 +65,8 @@ func (uc *UpdateJobUseCase) Execute(ctx context.Context, input dto.UpdateJobInpu
 		}
 	}
 
+	previousStatus := job.Status
+
 	// Update fields
 	if input.Title != nil {
 		job.Title = *input.Title
@@ -108,9 +124,16 @@ func (uc *UpdateJobUseCase) Execute(ctx context.Context, input dto.UpdateJobInpu
 
 	job.UpdatedAt = time.Now()
 
-	if err := uc.jobRepo.Update(ctx, job); err != nil {
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.jobRepo.Update(ctx, job); err != nil {
+			return err
+		}
+		return recordJobEvent(ctx, uc.events, jobChangeEvent(previousStatus, job), job)
+	})
+	if err != nil {
 		return nil, err
 	}
+	uc.cache.Invalidate(port.CacheScopeJobs, port.CacheScopeStartups)
 
 	startup, _ := uc.startupRepo.FindByID(ctx, job.StartupID)
 	startupName := ""
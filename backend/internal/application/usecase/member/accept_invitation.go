@@ -10,6 +10,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type AcceptInvitationUseCase struct {
@@ -34,6 +35,9 @@ func NewAcceptInvitationUseCase(
 }
 
 func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, token string, userID string) (*dto.MemberOutput, error) {
+	ctx, span := tracing.Start(ctx, "member.AcceptInvitation")
+	defer span.End()
+
 	// Find invitation
 	invitation, err := uc.invitationRepo.FindByToken(ctx, token)
 	if err != nil {
@@ -94,7 +98,7 @@ func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, token string, us
 	invitation.Status = entity.InvitationStatusAccepted
 	invitation.AcceptedAt = &now
 	if err := uc.invitationRepo.Update(ctx, invitation); err != nil {
-		uc.logger.Warn("Failed to update invitation status: %v", err)
+		uc.logger.WithContext(ctx).Warn("Failed to update invitation status: %v", err)
 	}
 
 	return &dto.MemberOutput{
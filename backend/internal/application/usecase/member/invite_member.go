@@ -11,6 +11,7 @@ import (
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type InviteMemberUseCase struct {
@@ -47,6 +48,9 @@ func NewInviteMemberUseCase(
 }
 
 func (uc *InviteMemberUseCase) Execute(ctx context.Context, input dto.InviteMemberInput, inviterID string) (*dto.InvitationOutput, error) {
+	ctx, span := tracing.Start(ctx, "member.InviteMember")
+	defer span.End()
+
 	// Verify startup exists
 	startup, err := uc.startupRepo.FindByID(ctx, input.StartupID)
 	if err != nil {
@@ -85,6 +89,7 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, input dto.InviteMemb
 		InvitedBy: inviterID,
 		ExpiresAt: time.Now().Add(24 * time.Hour),
 		Status:    entity.InvitationStatusPending,
+		Locale:    input.Locale,
 		CreatedAt: time.Now(),
 	}
 
@@ -94,8 +99,10 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, input dto.InviteMemb
 
 	// Send invitation email
 	inviteURL := uc.appURL + "/invitations/" + token + "/accept"
-	if err := uc.emailService.SendInvitationEmail(ctx, invitation, startup, inviteURL); err != nil {
-		uc.logger.Warn("Failed to send invitation email: %v", err)
+	if err := uc.emailService.Send(ctx, port.EmailStartupInvitation, port.StartupInvitationEmail{
+		StartupName: startup.Name, Role: string(invitation.Role), InviteURL: inviteURL,
+	}, port.EmailRecipient{Email: invitation.Email, Locale: invitation.Locale}); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to send invitation email: %v", err)
 		// Don't fail the request if email fails
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -35,6 +36,9 @@ func NewJoinStartupUseCase(
 }
 
 func (uc *JoinStartupUseCase) Execute(ctx context.Context, startupSlug string, joinCode string, userID string) (*dto.MemberOutput, error) {
+	ctx, span := tracing.Start(ctx, "member.JoinStartup")
+	defer span.End()
+
 	// Find startup by slug
 	startup, err := uc.startupRepo.FindBySlug(ctx, startupSlug)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type RequestJoinUseCase struct {
@@ -38,6 +39,9 @@ func NewRequestJoinUseCase(
 }
 
 func (uc *RequestJoinUseCase) Execute(ctx context.Context, input dto.JoinRequestInput, userID string) (*dto.MemberOutput, error) {
+	ctx, span := tracing.Start(ctx, "member.RequestJoin")
+	defer span.End()
+
 	// Find startup by slug
 	startup, err := uc.startupRepo.FindBySlug(ctx, input.StartupSlug)
 	if err != nil {
@@ -90,7 +94,7 @@ func (uc *RequestJoinUseCase) Execute(ctx context.Context, input dto.JoinRequest
 
 	// Notify startup owners/admins
 	if err := uc.notifyService.NotifyJoinRequest(ctx, member, startup); err != nil {
-		uc.logger.Warn("Failed to send join request notification: %v", err)
+		uc.logger.WithContext(ctx).Warn("Failed to send join request notification: %v", err)
 		// Don't fail the request if notification fails
 	}
 
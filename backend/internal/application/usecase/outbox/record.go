@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+)
+
+// RecordEventUseCase implements port.EventRecorder on the outbox table;
+// RelayEventsUseCase dispatches what it records.
+type RecordEventUseCase struct {
+	outboxRepo repository.OutboxRepository
+}
+
+func NewRecordEventUseCase(outboxRepo repository.OutboxRepository) *RecordEventUseCase {
+	return &RecordEventUseCase{outboxRepo: outboxRepo}
+}
+
+var _ port.EventRecorder = (*RecordEventUseCase)(nil)
+
+func (uc *RecordEventUseCase) Record(ctx context.Context, event *entity.DomainEvent, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encode %s event: %w", event.Type, err)
+	}
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	event.Payload = string(payload)
+	return uc.outboxRepo.Append(ctx, event)
+}
@@ -0,0 +1,119 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const (
+	relayBatch = 100
+	// relayLease keeps a claimed event from being picked up again while its handlers run.
+	relayLease = time.Minute
+	retryBase  = 5 * time.Second
+	retryMax   = time.Hour
+)
+
+// retryDelay is the exponential backoff after the given (1-based) failed attempt:
+// 5s, 10s, 20s, ... capped at 1h.
+func retryDelay(attempt int) time.Duration {
+	d := retryBase
+	for i := 1; i < attempt && d < retryMax; i++ {
+		d *= 2
+	}
+	if d > retryMax {
+		d = retryMax
+	}
+	return d
+}
+
+// RelayEventsUseCase dispatches due outbox events and schedules retries. An
+// event whose handlers fail is dispatched again in full, so every handler
+// sees each event at least once. It is run periodically by the scheduler.
+type RelayEventsUseCase struct {
+	outboxRepo  repository.OutboxRepository
+	dispatcher  port.EventDispatcher
+	maxAttempts int
+	logger      logger.Logger
+}
+
+func NewRelayEventsUseCase(
+	outboxRepo repository.OutboxRepository,
+	dispatcher port.EventDispatcher,
+	maxAttempts int,
+	logger logger.Logger,
+) *RelayEventsUseCase {
+	return &RelayEventsUseCase{outboxRepo: outboxRepo, dispatcher: dispatcher, maxAttempts: maxAttempts, logger: logger}
+}
+
+func (uc *RelayEventsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "outbox.RelayEvents")
+	defer span.End()
+
+	now := time.Now()
+	events, err := uc.outboxRepo.ClaimDue(ctx, now, now.Add(relayLease), relayBatch)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		uc.dispatch(ctx, e)
+	}
+	return nil
+}
+
+func (uc *RelayEventsUseCase) dispatch(ctx context.Context, e *entity.OutboxEvent) {
+	e.Attempts++
+	err := uc.dispatcher.Dispatch(ctx, &e.DomainEvent)
+
+	now := time.Now()
+	e.UpdatedAt = now
+	e.LastError = ""
+	switch {
+	case err == nil:
+		e.Status = entity.OutboxProcessed
+		e.ProcessedAt = &now
+	case e.Attempts >= uc.maxAttempts:
+		e.Status = entity.OutboxFailed
+		e.LastError = err.Error()
+		uc.logger.WithContext(ctx).Error("Outbox: giving up on %s event %s after %d attempts: %v", e.Type, e.ID, e.Attempts, err)
+	default:
+		e.LastError = err.Error()
+		e.NextAttemptAt = now.Add(retryDelay(e.Attempts))
+		uc.logger.WithContext(ctx).Warn("Outbox: %s event %s failed (attempt %d), retrying: %v", e.Type, e.ID, e.Attempts, err)
+	}
+	if err := uc.outboxRepo.Update(ctx, e); err != nil {
+		// The lease expires and the event is dispatched again.
+		uc.logger.WithContext(ctx).Error("Outbox: failed to record event %s: %v", e.ID, err)
+	}
+}
+
+// PruneEventsUseCase deletes processed events once they are older than the
+// retention period; failed ones are kept for inspection.
+type PruneEventsUseCase struct {
+	outboxRepo repository.OutboxRepository
+	retention  time.Duration
+	logger     logger.Logger
+}
+
+func NewPruneEventsUseCase(outboxRepo repository.OutboxRepository, retention time.Duration, logger logger.Logger) *PruneEventsUseCase {
+	return &PruneEventsUseCase{outboxRepo: outboxRepo, retention: retention, logger: logger}
+}
+
+func (uc *PruneEventsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "outbox.PruneEvents")
+	defer span.End()
+
+	n, err := uc.outboxRepo.DeleteProcessedBefore(ctx, time.Now().Add(-uc.retention))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		uc.logger.WithContext(ctx).Info("Outbox: pruned %d processed events", n)
+	}
+	return nil
+}
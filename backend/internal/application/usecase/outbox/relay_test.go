@@ -0,0 +1,119 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/usecase/outbox"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/infrastructure/eventbus"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// memoryOutbox is an OutboxRepository that ignores leases: every pending
+// event whose next attempt is due is claimed.
+type memoryOutbox struct {
+	events []*entity.OutboxEvent
+}
+
+func (m *memoryOutbox) Append(_ context.Context, events ...*entity.DomainEvent) error {
+	for _, e := range events {
+		m.events = append(m.events, &entity.OutboxEvent{DomainEvent: *e, Status: entity.OutboxPending, NextAttemptAt: time.Now()})
+	}
+	return nil
+}
+
+func (m *memoryOutbox) ClaimDue(_ context.Context, now, _ time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var due []*entity.OutboxEvent
+	for _, e := range m.events {
+		if e.Status == entity.OutboxPending && !e.NextAttemptAt.After(now) && len(due) < limit {
+			copied := *e
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (m *memoryOutbox) Update(_ context.Context, event *entity.OutboxEvent) error {
+	for i, e := range m.events {
+		if e.ID == event.ID {
+			m.events[i] = event
+		}
+	}
+	return nil
+}
+
+func (m *memoryOutbox) DeleteProcessedBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+// makeDue pulls every retry forward so the next relay run picks it up.
+func (m *memoryOutbox) makeDue() {
+	for _, e := range m.events {
+		e.NextAttemptAt = time.Time{}
+	}
+}
+
+func TestRelayRetriesUntilHandlersSucceed(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryOutbox{}
+	if err := outbox.NewRecordEventUseCase(repo).Record(ctx, &entity.DomainEvent{
+		Type: entity.DomainEventJobCreated, AggregateType: "job", AggregateID: "job-1",
+	}, map[string]string{"id": "job-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var seen []string
+	failures := 1
+	bus := eventbus.NewBus()
+	bus.Subscribe("flaky", func(_ context.Context, e *entity.DomainEvent) error {
+		seen = append(seen, e.ID)
+		if failures > 0 {
+			failures--
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	}, entity.DomainEventJobCreated)
+	bus.Subscribe("other", func(context.Context, *entity.DomainEvent) error { return nil }, entity.DomainEventJobClosed)
+
+	relay := outbox.NewRelayEventsUseCase(repo, bus, 5, logger.New(logger.Options{Output: io.Discard}))
+	for run := 0; run < 3; run++ {
+		if err := relay.Execute(ctx); err != nil {
+			t.Fatal(err)
+		}
+		repo.makeDue()
+	}
+
+	got := repo.events[0]
+	if got.Status != entity.OutboxProcessed || got.Attempts != 2 || got.ProcessedAt == nil || got.LastError != "" {
+		t.Fatalf("event = %+v", got)
+	}
+	if len(seen) != 2 || seen[0] != seen[1] || got.Payload != `{"id":"job-1"}` {
+		t.Fatalf("handler saw %v, payload %s", seen, got.Payload)
+	}
+}
+
+func TestRelayGivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryOutbox{}
+	_ = repo.Append(ctx, &entity.DomainEvent{ID: "evt-1", Type: entity.DomainEventJobClosed})
+
+	bus := eventbus.NewBus()
+	bus.Subscribe("broken", func(context.Context, *entity.DomainEvent) error { panic("boom") }, entity.DomainEventJobClosed)
+
+	relay := outbox.NewRelayEventsUseCase(repo, bus, 2, logger.New(logger.Options{Output: io.Discard}))
+	for run := 0; run < 4; run++ {
+		if err := relay.Execute(ctx); err != nil {
+			t.Fatal(err)
+		}
+		repo.makeDue()
+	}
+
+	got := repo.events[0]
+	if got.Status != entity.OutboxFailed || got.Attempts != 2 || got.LastError != "broken: panic: boom" {
+		t.Fatalf("event = %+v", got)
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -21,7 +23,11 @@ type CreateStartupUseCase struct {
 	roleRepo       repository.RoleRepository
 	memberRepo     repository.StartupMemberRepository
 	userRepo       repository.UserRepository
+	tokenRepo      repository.StartupAPITokenRepository
 	tokenGen       port.TokenService
+	slugs          *service.SlugService
+	verification   *service.EmailVerificationPolicy
+	cache          port.PublicCache
 	logger         logger.Logger
 }
 
@@ -32,22 +38,32 @@ func NewCreateStartupUseCase(
 	roleRepo repository.RoleRepository,
 	memberRepo repository.StartupMemberRepository,
 	userRepo repository.UserRepository,
+	tokenRepo repository.StartupAPITokenRepository,
 	tokenGen port.TokenService,
+	slugs *service.SlugService,
+	verification *service.EmailVerificationPolicy,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *CreateStartupUseCase {
 	return &CreateStartupUseCase{
 		startupRepo: startupRepo, teamRepo: teamRepo, teamMemberRepo: teamMemberRepo,
 		roleRepo: roleRepo, memberRepo: memberRepo, userRepo: userRepo,
-		tokenGen: tokenGen, logger: logger,
+		tokenRepo: tokenRepo, tokenGen: tokenGen, slugs: slugs, verification: verification, cache: cache, logger: logger,
 	}
 }
 
 func (uc *CreateStartupUseCase) Execute(ctx context.Context, input dto.CreateStartupInput, userID string, userRole entity.UserRole) (*dto.StartupOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.CreateStartup")
+	defer span.End()
+
 	// Platform admins create orphan startups (no team) via the admin API.
 	// Regular users create a team + become owner + link the startup.
 	if userRole.IsPlatformAdmin() {
 		return nil, errors.NewForbiddenError("platform admins must create startups via admin API (without a team)")
 	}
+	if ok, err := uc.verification.CanPublish(ctx, userID); err != nil || !ok {
+		return nil, errors.NewForbiddenError("verify your email address before creating a startup")
+	}
 
 	tokenStr, err := uc.tokenGen.GenerateToken()
 	if err != nil {
@@ -59,11 +75,7 @@ func (uc *CreateStartupUseCase) Execute(ctx context.Context, input dto.CreateSta
 		return nil, errors.ErrInternalError
 	}
 
-	teamBase := utils.GenerateSlug(input.Name + "-team")
-	teamSlug := utils.GenerateUniqueSlug(teamBase, func(s string) bool {
-		_, e := uc.teamRepo.FindBySlug(ctx, s)
-		return e == nil
-	})
+	teamSlug := uc.slugs.TeamSlug(ctx, input.Name+"-team", "")
 	now := time.Now()
 	team := &entity.Team{
 		ID: uuid.New().String(), Name: input.Name + " Team", Slug: teamSlug,
@@ -81,23 +93,27 @@ func (uc *CreateStartupUseCase) Execute(ctx context.Context, input dto.CreateSta
 		return nil, err
 	}
 
-	baseSlug := utils.GenerateSlug(input.Name)
-	slug := utils.GenerateUniqueSlug(baseSlug, func(s string) bool {
-		_, e := uc.startupRepo.FindBySlug(ctx, s)
-		return e == nil
-	})
+	slug := uc.slugs.StartupSlug(ctx, input.Name, "")
 
 	teamID := team.ID
 	startup := &entity.Startup{
 		ID: uuid.New().String(), Name: input.Name, Slug: slug, Description: input.Description,
 		Website: input.Website, FoundedYear: input.FoundedYear, Industry: input.Industry,
-		CompanySize: input.CompanySize, Location: input.Location, APIToken: utils.HashToken(tokenStr),
+		CompanySize: input.CompanySize, Location: input.Location,
 		AllowPublicJoin: input.AllowPublicJoin, Status: entity.StartupStatusActive,
 		TeamID: &teamID, Plan: string(entity.StartupPlanFree), CreatedAt: now, UpdatedAt: now,
 	}
 	if err := uc.startupRepo.Create(ctx, startup); err != nil {
 		return nil, err
 	}
+	if err := uc.tokenRepo.Create(ctx, &entity.StartupAPIToken{
+		ID: uuid.New().String(), StartupID: startup.ID, Name: entity.DefaultAPITokenName,
+		TokenHash: utils.HashToken(tokenStr), Prefix: tokenStr[:entity.APITokenPrefixLen],
+		Scopes: entity.APITokenScopes(), CreatedBy: &userID, CreatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
 
 	// Keep legacy startup_members in sync for gradual migration / crawler paths.
 	_ = uc.memberRepo.Create(ctx, &entity.StartupMember{
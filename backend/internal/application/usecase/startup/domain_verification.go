@@ -0,0 +1,380 @@
+package startup
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+const (
+	verificationChallengeTTL = 7 * 24 * time.Hour
+	verificationEmailCodeTTL = time.Hour
+	// maxEmailCodeAttempts voids an emailed code after this many wrong guesses.
+	maxEmailCodeAttempts = 5
+	// maxRecheckFailures revokes a verification after consecutive failed re-checks.
+	maxRecheckFailures = 3
+	recheckBatchSize   = 100
+)
+
+// StartDomainVerificationUseCase issues a new proof-of-control challenge for
+// the startup's website domain, replacing any previous one.
+type StartDomainVerificationUseCase struct {
+	startupRepo      repository.StartupRepository
+	verificationRepo repository.StartupVerificationRepository
+	userRepo         repository.UserRepository
+	authService      *service.AuthorizationService
+	emailService     port.EmailService
+	logger           logger.Logger
+}
+
+func NewStartDomainVerificationUseCase(
+	startupRepo repository.StartupRepository,
+	verificationRepo repository.StartupVerificationRepository,
+	userRepo repository.UserRepository,
+	authService *service.AuthorizationService,
+	emailService port.EmailService,
+	logger logger.Logger,
+) *StartDomainVerificationUseCase {
+	return &StartDomainVerificationUseCase{
+		startupRepo: startupRepo, verificationRepo: verificationRepo, userRepo: userRepo,
+		authService: authService, emailService: emailService, logger: logger,
+	}
+}
+
+func (uc *StartDomainVerificationUseCase) Execute(ctx context.Context, startupID, userID string, input dto.StartDomainVerificationInput) (*dto.DomainVerificationOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.StartDomainVerification")
+	defer span.End()
+
+	startup, err := loadManagedStartup(ctx, uc.startupRepo, uc.authService, startupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	domain := utils.WebsiteDomain(startup.Website)
+	if domain == "" {
+		return nil, errors.NewBadRequestError("startup website has no valid domain")
+	}
+
+	existing, err := uc.verificationRepo.FindByStartupID(ctx, startupID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.IsVerified() && existing.Domain == domain && startup.VerifiedAt != nil {
+		return nil, errors.NewBadRequestError("domain is already verified")
+	}
+
+	method := entity.VerificationMethod(input.Method)
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if method == entity.VerificationMethodEmail {
+		if email == "" {
+			return nil, errors.NewBadRequestError("email is required for email verification")
+		}
+		if utils.EmailDomain(email) != domain {
+			return nil, errors.NewBadRequestError("email must be an address on " + domain)
+		}
+	}
+
+	token, err := utils.RandomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	v := &entity.StartupVerification{
+		ID: uuid.New().String(), StartupID: startupID, Domain: domain, Method: method,
+		Token: token, ExpiresAt: now.Add(verificationChallengeTTL), CreatedAt: now, UpdatedAt: now,
+	}
+	if existing != nil {
+		v.ID, v.CreatedAt = existing.ID, existing.CreatedAt
+	}
+
+	var code string
+	if method == entity.VerificationMethodEmail {
+		if code, err = utils.RandomHex(4); err != nil {
+			return nil, err
+		}
+		code = strings.ToUpper(code)
+		v.Email = email
+		v.CodeHash = utils.HashToken(code)
+		v.ExpiresAt = now.Add(verificationEmailCodeTTL)
+	}
+
+	if err := uc.verificationRepo.Save(ctx, v); err != nil {
+		return nil, err
+	}
+	if code != "" {
+		// The requester reads the code at the address they gave, so it is sent
+		// in their language.
+		to := port.EmailRecipient{Email: email}
+		if user, err := uc.userRepo.FindByID(ctx, userID); err == nil && user != nil {
+			to.Locale = user.Locale
+		}
+		if err := uc.emailService.Send(ctx, port.EmailDomainVerification, port.DomainVerificationEmail{
+			StartupName: startup.Name, Domain: domain, Code: code,
+		}, to); err != nil {
+			uc.logger.WithContext(ctx).Error("Failed to send domain verification email for startup %s: %v", startupID, err)
+			return nil, errors.ErrInternalError
+		}
+	}
+	return toDomainVerificationOutput(v, now), nil
+}
+
+// GetDomainVerificationUseCase returns the current challenge and instructions.
+type GetDomainVerificationUseCase struct {
+	startupRepo      repository.StartupRepository
+	verificationRepo repository.StartupVerificationRepository
+	authService      *service.AuthorizationService
+}
+
+func NewGetDomainVerificationUseCase(
+	startupRepo repository.StartupRepository,
+	verificationRepo repository.StartupVerificationRepository,
+	authService *service.AuthorizationService,
+) *GetDomainVerificationUseCase {
+	return &GetDomainVerificationUseCase{startupRepo: startupRepo, verificationRepo: verificationRepo, authService: authService}
+}
+
+func (uc *GetDomainVerificationUseCase) Execute(ctx context.Context, startupID, userID string) (*dto.DomainVerificationOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.GetDomainVerification")
+	defer span.End()
+
+	if _, err := loadManagedStartup(ctx, uc.startupRepo, uc.authService, startupID, userID); err != nil {
+		return nil, err
+	}
+	v, err := uc.verificationRepo.FindByStartupID(ctx, startupID)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, errors.NewNotFoundError("verification")
+	}
+	return toDomainVerificationOutput(v, time.Now()), nil
+}
+
+// CheckDomainVerificationUseCase validates the pending challenge and marks the
+// startup verified on success.
+type CheckDomainVerificationUseCase struct {
+	startupRepo      repository.StartupRepository
+	verificationRepo repository.StartupVerificationRepository
+	verifier         port.DomainVerifier
+	authService      *service.AuthorizationService
+	cache            port.PublicCache
+	logger           logger.Logger
+}
+
+func NewCheckDomainVerificationUseCase(
+	startupRepo repository.StartupRepository,
+	verificationRepo repository.StartupVerificationRepository,
+	verifier port.DomainVerifier,
+	authService *service.AuthorizationService,
+	cache port.PublicCache,
+	logger logger.Logger,
+) *CheckDomainVerificationUseCase {
+	return &CheckDomainVerificationUseCase{
+		startupRepo: startupRepo, verificationRepo: verificationRepo,
+		verifier: verifier, authService: authService, cache: cache, logger: logger,
+	}
+}
+
+func (uc *CheckDomainVerificationUseCase) Execute(ctx context.Context, startupID, userID string, input dto.CheckDomainVerificationInput) (*dto.DomainVerificationOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.CheckDomainVerification")
+	defer span.End()
+
+	startup, err := loadManagedStartup(ctx, uc.startupRepo, uc.authService, startupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := uc.verificationRepo.FindByStartupID(ctx, startupID)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, errors.NewBadRequestError("no verification in progress")
+	}
+	now := time.Now()
+	if v.IsVerified() {
+		return toDomainVerificationOutput(v, now), nil
+	}
+	if now.After(v.ExpiresAt) {
+		return nil, errors.NewBadRequestError("verification challenge expired; start a new one")
+	}
+	if v.Domain != utils.WebsiteDomain(startup.Website) {
+		return nil, errors.NewBadRequestError("website changed since verification started; start a new one")
+	}
+
+	switch v.Method {
+	case entity.VerificationMethodDNS:
+		if err := uc.verifier.CheckDNS(ctx, v.Domain, v.Token); err != nil {
+			return nil, errors.NewBadRequestError("TXT record not found: " + err.Error())
+		}
+	case entity.VerificationMethodFile:
+		if err := uc.verifier.CheckWellKnownFile(ctx, v.Domain, v.Token); err != nil {
+			return nil, errors.NewBadRequestError("verification file not found: " + err.Error())
+		}
+	case entity.VerificationMethodEmail:
+		code := strings.ToUpper(strings.TrimSpace(input.Code))
+		if code == "" || subtle.ConstantTimeCompare([]byte(utils.HashToken(code)), []byte(v.CodeHash)) != 1 {
+			v.Failures++
+			if v.Failures >= maxEmailCodeAttempts {
+				v.ExpiresAt = now
+			}
+			v.UpdatedAt = now
+			if err := uc.verificationRepo.Save(ctx, v); err != nil {
+				return nil, err
+			}
+			return nil, errors.NewBadRequestError("invalid verification code")
+		}
+		v.CodeHash = ""
+	}
+
+	v.VerifiedAt, v.LastCheckedAt = &now, &now
+	v.Failures = 0
+	v.UpdatedAt = now
+	if err := uc.verificationRepo.Save(ctx, v); err != nil {
+		return nil, err
+	}
+	startup.VerifiedAt = &now
+	if err := uc.startupRepo.Update(ctx, startup); err != nil {
+		return nil, err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
+	uc.logger.WithContext(ctx).Info("Startup %s verified domain %s via %s", startup.ID, v.Domain, v.Method)
+	return toDomainVerificationOutput(v, now), nil
+}
+
+// ReverifyDomainsUseCase periodically re-checks DNS/file proofs and revokes
+// the verified badge after repeated failures or a website change.
+type ReverifyDomainsUseCase struct {
+	startupRepo      repository.StartupRepository
+	verificationRepo repository.StartupVerificationRepository
+	verifier         port.DomainVerifier
+	recheckAfter     time.Duration
+	cache            port.PublicCache
+	logger           logger.Logger
+}
+
+func NewReverifyDomainsUseCase(
+	startupRepo repository.StartupRepository,
+	verificationRepo repository.StartupVerificationRepository,
+	verifier port.DomainVerifier,
+	recheckAfter time.Duration,
+	cache port.PublicCache,
+	logger logger.Logger,
+) *ReverifyDomainsUseCase {
+	return &ReverifyDomainsUseCase{
+		startupRepo: startupRepo, verificationRepo: verificationRepo,
+		verifier: verifier, recheckAfter: recheckAfter, cache: cache, logger: logger,
+	}
+}
+
+func (uc *ReverifyDomainsUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "startup.ReverifyDomains")
+	defer span.End()
+
+	now := time.Now()
+	due, err := uc.verificationRepo.FindDueForRecheck(ctx, now.Add(-uc.recheckAfter), recheckBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, v := range due {
+		startup, err := uc.startupRepo.FindByID(ctx, v.StartupID)
+		if err != nil || startup == nil {
+			continue
+		}
+
+		revoke := v.Domain != utils.WebsiteDomain(startup.Website)
+		if !revoke {
+			var checkErr error
+			if v.Method == entity.VerificationMethodDNS {
+				checkErr = uc.verifier.CheckDNS(ctx, v.Domain, v.Token)
+			} else {
+				checkErr = uc.verifier.CheckWellKnownFile(ctx, v.Domain, v.Token)
+			}
+			if checkErr == nil {
+				v.Failures = 0
+			} else {
+				v.Failures++
+				uc.logger.WithContext(ctx).Warn("Domain re-check failed for startup %s (%s, %d/%d): %v",
+					startup.ID, v.Domain, v.Failures, maxRecheckFailures, checkErr)
+				revoke = v.Failures >= maxRecheckFailures
+			}
+		}
+
+		v.LastCheckedAt = &now
+		v.UpdatedAt = now
+		if revoke {
+			v.VerifiedAt = nil
+			uc.logger.WithContext(ctx).Info("Revoking domain verification for startup %s (%s)", startup.ID, v.Domain)
+		}
+		if err := uc.verificationRepo.Save(ctx, v); err != nil {
+			uc.logger.WithContext(ctx).Error("Failed to save domain re-check for startup %s: %v", startup.ID, err)
+			continue
+		}
+		if revoke && startup.VerifiedAt != nil {
+			startup.VerifiedAt = nil
+			if err := uc.startupRepo.Update(ctx, startup); err != nil {
+				uc.logger.WithContext(ctx).Error("Failed to clear verified badge for startup %s: %v", startup.ID, err)
+			} else {
+				uc.cache.Invalidate(port.CacheScopeStartups)
+			}
+		}
+	}
+	return nil
+}
+
+// loadManagedStartup denies with NOT_FOUND to avoid leaking private startups.
+func loadManagedStartup(ctx context.Context, startupRepo repository.StartupRepository, authService *service.AuthorizationService, startupID, userID string) (*entity.Startup, error) {
+	startup, err := startupRepo.FindByID(ctx, startupID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("startup")
+	}
+	ok, err := authService.CanAccessStartup(ctx, userID, startupID, entity.ScopeStartupManage)
+	if err != nil || !ok {
+		return nil, errors.NewNotFoundError("startup")
+	}
+	return startup, nil
+}
+
+func toDomainVerificationOutput(v *entity.StartupVerification, now time.Time) *dto.DomainVerificationOutput {
+	status := "pending"
+	if v.IsVerified() {
+		status = "verified"
+	} else if now.After(v.ExpiresAt) {
+		status = "expired"
+	}
+	output := &dto.DomainVerificationOutput{
+		StartupID: v.StartupID,
+		Domain:    v.Domain,
+		Method:    string(v.Method),
+		Status:    status,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt.Format(time.RFC3339),
+	}
+	switch v.Method {
+	case entity.VerificationMethodDNS:
+		output.DNSRecordName = v.Domain
+		output.DNSRecordValue = entity.VerificationTXTPrefix + v.Token
+	case entity.VerificationMethodFile:
+		output.FileURL = "https://" + v.Domain + entity.VerificationWellKnownPath
+		output.FileContent = v.Token
+	}
+	if v.VerifiedAt != nil {
+		verifiedAtStr := v.VerifiedAt.Format(time.RFC3339)
+		output.VerifiedAt = &verifiedAtStr
+	}
+	if v.LastCheckedAt != nil {
+		lastCheckedAtStr := v.LastCheckedAt.Format(time.RFC3339)
+		output.LastCheckedAt = &lastCheckedAtStr
+	}
+	return output
+}
@@ -6,27 +6,35 @@ import (
 	"github.com/startup-job-board/backend/internal/application/dto"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"time"
 )
 
 type GetStartupUseCase struct {
 	startupRepo repository.StartupRepository
+	slugs       *service.SlugService
 	logger      logger.Logger
 }
 
 func NewGetStartupUseCase(
 	startupRepo repository.StartupRepository,
+	slugs *service.SlugService,
 	logger logger.Logger,
 ) *GetStartupUseCase {
 	return &GetStartupUseCase{
 		startupRepo: startupRepo,
+		slugs:       slugs,
 		logger:      logger,
 	}
 }
 
 func (uc *GetStartupUseCase) Execute(ctx context.Context, id string) (*dto.StartupOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.GetStartup")
+	defer span.End()
+
 	startup, err := uc.startupRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, errors.NewNotFoundError("startup")
@@ -35,13 +43,26 @@ func (uc *GetStartupUseCase) Execute(ctx context.Context, id string) (*dto.Start
 	return uc.toOutput(startup), nil
 }
 
-func (uc *GetStartupUseCase) ExecuteBySlug(ctx context.Context, slug string) (*dto.StartupOutput, error) {
+// ExecuteBySlug also answers slugs the startup has since been renamed away
+// from; moved reports that, and the output carries the canonical slug.
+func (uc *GetStartupUseCase) ExecuteBySlug(ctx context.Context, slug string) (output *dto.StartupOutput, moved bool, err error) {
+	ctx, span := tracing.Start(ctx, "startup.GetStartupBySlug")
+	defer span.End()
+
 	startup, err := uc.startupRepo.FindBySlug(ctx, slug)
-	if err != nil {
-		return nil, errors.NewNotFoundError("startup")
+	if err == nil && startup != nil {
+		return uc.toOutput(startup), false, nil
 	}
 
-	return uc.toOutput(startup), nil
+	startupID, ok := uc.slugs.ResolveStartup(ctx, slug)
+	if !ok {
+		return nil, false, errors.NewNotFoundError("startup")
+	}
+	startup, err = uc.startupRepo.FindByID(ctx, startupID)
+	if err != nil || startup == nil {
+		return nil, false, errors.NewNotFoundError("startup")
+	}
+	return uc.toOutput(startup), true, nil
 }
 
 func (uc *GetStartupUseCase) toOutput(startup *entity.Startup) *dto.StartupOutput {
@@ -68,6 +89,12 @@ func (uc *GetStartupUseCase) toOutput(startup *entity.Startup) *dto.StartupOutpu
 		output.PlanExpiresAt = &planExpiresAtStr
 	}
 
+	if startup.VerifiedAt != nil {
+		verifiedAtStr := startup.VerifiedAt.Format(time.RFC3339)
+		output.Verified = true
+		output.VerifiedAt = &verifiedAtStr
+	}
+
 	return output
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/startup-job-board/backend/pkg/utils"
 )
 
@@ -30,6 +31,9 @@ func NewListStartupsUseCase(
 
 // Execute lists startups. When lean is true, descriptions are truncated.
 func (uc *ListStartupsUseCase) Execute(ctx context.Context, filter repository.StartupFilter, lean bool) ([]*dto.StartupOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "startup.ListStartups")
+	defer span.End()
+
 	startups, total, err := uc.startupRepo.List(ctx, filter)
 	if err != nil {
 		return nil, 0, err
@@ -72,5 +76,11 @@ func (uc *ListStartupsUseCase) toOutput(startup *entity.Startup, lean bool) *dto
 		output.PlanExpiresAt = &planExpiresAtStr
 	}
 
+	if startup.VerifiedAt != nil {
+		verifiedAtStr := startup.VerifiedAt.Format(time.RFC3339)
+		output.Verified = true
+		output.VerifiedAt = &verifiedAtStr
+	}
+
 	return output
 }
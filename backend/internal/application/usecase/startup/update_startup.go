@@ -4,33 +4,52 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
 )
 
 type UpdateStartupUseCase struct {
 	startupRepo repository.StartupRepository
+	fileRepo    repository.FileRepository
+	refRepo     repository.FileReferenceRepository
+	slugs       *service.SlugService
 	authService *service.AuthorizationService
+	cache       port.PublicCache
 	logger      logger.Logger
 }
 
 func NewUpdateStartupUseCase(
 	startupRepo repository.StartupRepository,
+	fileRepo repository.FileRepository,
+	refRepo repository.FileReferenceRepository,
+	slugs *service.SlugService,
 	authService *service.AuthorizationService,
+	cache port.PublicCache,
 	logger logger.Logger,
 ) *UpdateStartupUseCase {
 	return &UpdateStartupUseCase{
 		startupRepo: startupRepo,
+		fileRepo:    fileRepo,
+		refRepo:     refRepo,
+		slugs:       slugs,
 		authService: authService,
+		cache:       cache,
 		logger:      logger,
 	}
 }
 
 func (uc *UpdateStartupUseCase) Execute(ctx context.Context, input dto.UpdateStartupInput, userID string) (*dto.StartupOutput, error) {
+	ctx, span := tracing.Start(ctx, "startup.UpdateStartup")
+	defer span.End()
+
 	startup, err := uc.startupRepo.FindByID(ctx, input.ID)
 	if err != nil {
 		return nil, errors.NewNotFoundError("startup")
@@ -42,8 +61,11 @@ func (uc *UpdateStartupUseCase) Execute(ctx context.Context, input dto.UpdateSta
 		return nil, errors.NewNotFoundError("startup")
 	}
 
-	if input.Name != nil {
+	oldSlug := startup.Slug
+	logoChanged := input.LogoURL != nil && (startup.LogoURL == nil || *startup.LogoURL != *input.LogoURL)
+	if input.Name != nil && *input.Name != startup.Name {
 		startup.Name = *input.Name
+		startup.Slug = uc.slugs.StartupSlug(ctx, startup.Name, startup.ID)
 	}
 	if input.Description != nil {
 		startup.Description = *input.Description
@@ -52,6 +74,10 @@ func (uc *UpdateStartupUseCase) Execute(ctx context.Context, input dto.UpdateSta
 		startup.LogoURL = input.LogoURL
 	}
 	if input.Website != nil {
+		// A new domain has to be proven again before the badge comes back.
+		if utils.WebsiteDomain(*input.Website) != utils.WebsiteDomain(startup.Website) {
+			startup.VerifiedAt = nil
+		}
 		startup.Website = *input.Website
 	}
 	if input.AllowPublicJoin != nil {
@@ -75,10 +101,41 @@ func (uc *UpdateStartupUseCase) Execute(ctx context.Context, input dto.UpdateSta
 	if err := uc.startupRepo.Update(ctx, startup); err != nil {
 		return nil, err
 	}
+	// Job responses carry the startup's name and slug.
+	uc.cache.Invalidate(port.CacheScopeStartups, port.CacheScopeJobs)
+	// The old slug keeps resolving (and stays reserved) via the history.
+	if err := uc.slugs.Retire(ctx, entity.SlugOwnerStartup, startup.ID, oldSlug, startup.Slug); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to record slug history for startup %s: %v", startup.ID, err)
+	}
+	if logoChanged {
+		if err := uc.trackLogo(ctx, startup); err != nil {
+			// The file GC reconciles logo references before collecting.
+			uc.logger.WithContext(ctx).Warn("Failed to update logo reference for startup %s: %v", startup.ID, err)
+		}
+	}
 
 	return uc.toOutput(startup), nil
 }
 
+// trackLogo points the startup's logo reference at the uploaded file its
+// LogoURL names, releasing the previous logo to the file GC.
+func (uc *UpdateStartupUseCase) trackLogo(ctx context.Context, startup *entity.Startup) error {
+	if err := uc.refRepo.DeleteByOwner(ctx, entity.FileRefStartupLogo, startup.ID); err != nil {
+		return err
+	}
+	if startup.LogoURL == nil || *startup.LogoURL == "" {
+		return nil
+	}
+	file, err := uc.fileRepo.FindByURL(ctx, *startup.LogoURL)
+	if err != nil || file == nil {
+		return err // an external URL has nothing to track
+	}
+	return uc.refRepo.Add(ctx, &entity.FileReference{
+		ID: uuid.New().String(), FileID: file.ID, OwnerType: entity.FileRefStartupLogo,
+		OwnerID: startup.ID, CreatedAt: time.Now(),
+	})
+}
+
 func (uc *UpdateStartupUseCase) toOutput(startup *entity.Startup) *dto.StartupOutput {
 	output := &dto.StartupOutput{
 		ID:              startup.ID,
@@ -103,5 +160,11 @@ func (uc *UpdateStartupUseCase) toOutput(startup *entity.Startup) *dto.StartupOu
 		output.PlanExpiresAt = &planExpiresAtStr
 	}
 
+	if startup.VerifiedAt != nil {
+		verifiedAtStr := startup.VerifiedAt.Format(time.RFC3339)
+		output.Verified = true
+		output.VerifiedAt = &verifiedAtStr
+	}
+
 	return output
 }
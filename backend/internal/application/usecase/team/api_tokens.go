@@ -0,0 +1,216 @@
+package team
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+// loadTeamStartup checks the actor holds startup:manage on the team and that
+// the startup belongs to it.
+func loadTeamStartup(ctx context.Context, startupRepo repository.StartupRepository, authService *service.AuthorizationService, teamID, startupID, userID string) error {
+	ok, err := authService.HasScope(ctx, userID, teamID, entity.ScopeStartupManage)
+	if err != nil || !ok {
+		return errors.NewNotFoundError("team")
+	}
+	startup, err := startupRepo.FindByID(ctx, startupID)
+	if err != nil || startup == nil || startup.TeamID == nil || *startup.TeamID != teamID {
+		return errors.NewNotFoundError("startup")
+	}
+	return nil
+}
+
+type ListAPITokensUseCase struct {
+	tokenRepo   repository.StartupAPITokenRepository
+	startupRepo repository.StartupRepository
+	authService *service.AuthorizationService
+}
+
+func NewListAPITokensUseCase(tokenRepo repository.StartupAPITokenRepository, startupRepo repository.StartupRepository, authService *service.AuthorizationService) *ListAPITokensUseCase {
+	return &ListAPITokensUseCase{tokenRepo: tokenRepo, startupRepo: startupRepo, authService: authService}
+}
+
+func (uc *ListAPITokensUseCase) Execute(ctx context.Context, teamID, startupID, userID string) ([]*dto.APITokenOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListAPITokens")
+	defer span.End()
+
+	if err := loadTeamStartup(ctx, uc.startupRepo, uc.authService, teamID, startupID, userID); err != nil {
+		return nil, err
+	}
+	tokens, err := uc.tokenRepo.ListByStartupID(ctx, startupID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	out := make([]*dto.APITokenOutput, len(tokens))
+	for i, t := range tokens {
+		out[i] = toAPITokenOutput(t, now)
+	}
+	return out, nil
+}
+
+type CreateAPITokenUseCase struct {
+	tokenRepo   repository.StartupAPITokenRepository
+	startupRepo repository.StartupRepository
+	tokenGen    port.TokenService
+	authService *service.AuthorizationService
+}
+
+func NewCreateAPITokenUseCase(tokenRepo repository.StartupAPITokenRepository, startupRepo repository.StartupRepository, tokenGen port.TokenService, authService *service.AuthorizationService) *CreateAPITokenUseCase {
+	return &CreateAPITokenUseCase{tokenRepo: tokenRepo, startupRepo: startupRepo, tokenGen: tokenGen, authService: authService}
+}
+
+func (uc *CreateAPITokenUseCase) Execute(ctx context.Context, teamID, startupID, userID string, input dto.CreateAPITokenInput) (*dto.APITokenOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.CreateAPIToken")
+	defer span.End()
+
+	if err := loadTeamStartup(ctx, uc.startupRepo, uc.authService, teamID, startupID, userID); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]entity.Scope, 0, len(input.Scopes))
+	for _, s := range input.Scopes {
+		if !entity.IsAPITokenScope(entity.Scope(s)) {
+			return nil, errors.NewBadRequestError("unsupported scope: " + s)
+		}
+		scopes = append(scopes, entity.Scope(s))
+	}
+
+	var expiresAt *time.Time
+	if input.ExpiresAt != nil && *input.ExpiresAt != "" {
+		parsed, err := time.Parse(time.RFC3339, *input.ExpiresAt)
+		if err != nil {
+			return nil, errors.NewBadRequestError("invalid expires_at format")
+		}
+		if !parsed.After(time.Now()) {
+			return nil, errors.NewBadRequestError("expires_at must be in the future")
+		}
+		expiresAt = &parsed
+	}
+
+	return issueAPIToken(ctx, uc.tokenRepo, uc.tokenGen, &entity.StartupAPIToken{
+		StartupID: startupID, Name: input.Name, Scopes: scopes, ExpiresAt: expiresAt, CreatedBy: &userID,
+	})
+}
+
+// RotateAPITokenUseCase replaces a token with a fresh secret carrying the same
+// name, scopes and lifetime. The old token stops working immediately.
+type RotateAPITokenUseCase struct {
+	tokenRepo   repository.StartupAPITokenRepository
+	startupRepo repository.StartupRepository
+	tokenGen    port.TokenService
+	authService *service.AuthorizationService
+}
+
+func NewRotateAPITokenUseCase(tokenRepo repository.StartupAPITokenRepository, startupRepo repository.StartupRepository, tokenGen port.TokenService, authService *service.AuthorizationService) *RotateAPITokenUseCase {
+	return &RotateAPITokenUseCase{tokenRepo: tokenRepo, startupRepo: startupRepo, tokenGen: tokenGen, authService: authService}
+}
+
+func (uc *RotateAPITokenUseCase) Execute(ctx context.Context, teamID, startupID, tokenID, userID string) (*dto.APITokenOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.RotateAPIToken")
+	defer span.End()
+
+	if err := loadTeamStartup(ctx, uc.startupRepo, uc.authService, teamID, startupID, userID); err != nil {
+		return nil, err
+	}
+	old, err := uc.tokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil || old.StartupID != startupID {
+		return nil, errors.NewNotFoundError("token")
+	}
+	if old.RevokedAt != nil {
+		return nil, errors.NewBadRequestError("token has been revoked")
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	if old.ExpiresAt != nil {
+		next := now.Add(old.ExpiresAt.Sub(old.CreatedAt))
+		expiresAt = &next
+	}
+	out, err := issueAPIToken(ctx, uc.tokenRepo, uc.tokenGen, &entity.StartupAPIToken{
+		StartupID: startupID, Name: old.Name, Scopes: old.Scopes, ExpiresAt: expiresAt, CreatedBy: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tokenRepo.Revoke(ctx, old.ID, now); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type RevokeAPITokenUseCase struct {
+	tokenRepo   repository.StartupAPITokenRepository
+	startupRepo repository.StartupRepository
+	authService *service.AuthorizationService
+}
+
+func NewRevokeAPITokenUseCase(tokenRepo repository.StartupAPITokenRepository, startupRepo repository.StartupRepository, authService *service.AuthorizationService) *RevokeAPITokenUseCase {
+	return &RevokeAPITokenUseCase{tokenRepo: tokenRepo, startupRepo: startupRepo, authService: authService}
+}
+
+func (uc *RevokeAPITokenUseCase) Execute(ctx context.Context, teamID, startupID, tokenID, userID string) error {
+	ctx, span := tracing.Start(ctx, "team.RevokeAPIToken")
+	defer span.End()
+
+	if err := loadTeamStartup(ctx, uc.startupRepo, uc.authService, teamID, startupID, userID); err != nil {
+		return err
+	}
+	token, err := uc.tokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if token == nil || token.StartupID != startupID {
+		return errors.NewNotFoundError("token")
+	}
+	return uc.tokenRepo.Revoke(ctx, token.ID, time.Now())
+}
+
+func issueAPIToken(ctx context.Context, tokenRepo repository.StartupAPITokenRepository, tokenGen port.TokenService, t *entity.StartupAPIToken) (*dto.APITokenOutput, error) {
+	plain, err := tokenGen.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	t.ID = uuid.New().String()
+	t.TokenHash = utils.HashToken(plain)
+	t.Prefix = plain[:entity.APITokenPrefixLen]
+	t.CreatedAt = time.Now()
+	if err := tokenRepo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+	out := toAPITokenOutput(t, t.CreatedAt)
+	out.Token = plain // plaintext shown once
+	return out, nil
+}
+
+func toAPITokenOutput(t *entity.StartupAPIToken, now time.Time) *dto.APITokenOutput {
+	out := &dto.APITokenOutput{
+		ID: t.ID, StartupID: t.StartupID, Name: t.Name, Prefix: t.Prefix,
+		Scopes: scopesToStrings(t.Scopes), Active: t.IsActive(now),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+	out.ExpiresAt = formatOptionalTime(t.ExpiresAt)
+	out.LastUsedAt = formatOptionalTime(t.LastUsedAt)
+	out.RevokedAt = formatOptionalTime(t.RevokedAt)
+	return out
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,536 @@
+package team
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+const (
+	claimCodeTTL         = time.Hour
+	maxClaimCodeAttempts = 5
+)
+
+// claimApprover links a claimed startup to the claimant's team. It is shared
+// by self-serve verification and admin approval.
+type claimApprover struct {
+	claimRepo      repository.StartupClaimRepository
+	startupRepo    repository.StartupRepository
+	teamRepo       repository.TeamRepository
+	teamMemberRepo repository.TeamMemberRepository
+	roleRepo       repository.RoleRepository
+	slugs          *service.SlugService
+	authService    *service.AuthorizationService
+	tx             port.TxManager
+	cache          port.PublicCache
+	logger         logger.Logger
+}
+
+// approve runs in one transaction. The startup is linked with a conditional
+// update, so of two concurrent approvals only the first wins; the other gets
+// a conflict and its new team (if any) is rolled back.
+func (a *claimApprover) approve(ctx context.Context, claim *entity.StartupClaim, actorID *string, note string) error {
+	err := a.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return a.approveInTx(ctx, claim, actorID, note)
+	})
+	if err != nil {
+		return err
+	}
+	a.cache.Invalidate(port.CacheScopeStartups)
+	return nil
+}
+
+func (a *claimApprover) approveInTx(ctx context.Context, claim *entity.StartupClaim, actorID *string, note string) error {
+	alreadyClaimed := errors.NewConflictError("startup has already been claimed")
+	startup, err := a.startupRepo.FindByID(ctx, claim.StartupID)
+	if err != nil || startup == nil {
+		return errors.NewNotFoundError("startup")
+	}
+	if startup.TeamID != nil && *startup.TeamID != "" {
+		return alreadyClaimed
+	}
+
+	var teamID string
+	if claim.TeamID != nil {
+		// The claimant may have lost access to the team since submitting.
+		ok, err := a.authService.HasScope(ctx, claim.UserID, *claim.TeamID, entity.ScopeStartupManage)
+		if err != nil || !ok {
+			return errors.NewBadRequestError("claimant no longer manages the selected team")
+		}
+		teamID = *claim.TeamID
+	} else {
+		team, err := createTeamWithOwner(ctx, a.teamRepo, a.teamMemberRepo, a.roleRepo, a.slugs, a.logger, startup.Name, claim.UserID)
+		if err != nil {
+			return err
+		}
+		teamID = team.ID
+	}
+
+	now := time.Now()
+	linked, err := a.startupRepo.LinkTeam(ctx, startup.ID, teamID, now)
+	if err != nil {
+		return err
+	}
+	if !linked {
+		return alreadyClaimed
+	}
+
+	claim.TeamID = &teamID
+	claim.Status = entity.ClaimStatusApproved
+	claim.ReviewedBy, claim.ReviewedAt, claim.UpdatedAt = actorID, &now, now
+	if err := a.claimRepo.Update(ctx, claim); err != nil {
+		return err
+	}
+	addClaimEvent(ctx, a.claimRepo, a.logger, claim.ID, actorID, entity.ClaimEventApproved, note)
+	a.logger.WithContext(ctx).Info("Startup %s claimed by user %s (team %s, %s)", startup.ID, claim.UserID, teamID, claim.Method)
+
+	// Other pending claims for the startup can no longer succeed.
+	others, err := a.claimRepo.ListPendingByStartupID(ctx, startup.ID)
+	if err != nil {
+		return err
+	}
+	for _, other := range others {
+		if other.ID == claim.ID {
+			continue
+		}
+		if err := rejectClaim(ctx, a.claimRepo, a.logger, other, nil, "startup was claimed by another user"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rejectClaim(ctx context.Context, claimRepo repository.StartupClaimRepository, log logger.Logger, claim *entity.StartupClaim, actorID *string, reason string) error {
+	now := time.Now()
+	claim.Status = entity.ClaimStatusRejected
+	claim.RejectionReason = reason
+	claim.ReviewedBy, claim.ReviewedAt, claim.UpdatedAt = actorID, &now, now
+	if err := claimRepo.Update(ctx, claim); err != nil {
+		log.Warn("Failed to reject claim %s: %v", claim.ID, err)
+		return err
+	}
+	addClaimEvent(ctx, claimRepo, log, claim.ID, actorID, entity.ClaimEventRejected, reason)
+	return nil
+}
+
+// addClaimEvent records an audit entry; failures are logged, not returned.
+func addClaimEvent(ctx context.Context, claimRepo repository.StartupClaimRepository, log logger.Logger, claimID string, actorID *string, action entity.ClaimEventAction, note string) {
+	if err := claimRepo.AddEvent(ctx, &entity.StartupClaimEvent{
+		ID: uuid.New().String(), ClaimID: claimID, ActorID: actorID, Action: action, Note: note, CreatedAt: time.Now(),
+	}); err != nil {
+		log.Warn("Failed to record claim event %s for %s: %v", action, claimID, err)
+	}
+}
+
+// SubmitClaimUseCase opens a claim on a startup that has no team.
+type SubmitClaimUseCase struct {
+	claimRepo    repository.StartupClaimRepository
+	startupRepo  repository.StartupRepository
+	userRepo     repository.UserRepository
+	authService  *service.AuthorizationService
+	emailService port.EmailService
+	logger       logger.Logger
+}
+
+func NewSubmitClaimUseCase(
+	claimRepo repository.StartupClaimRepository,
+	startupRepo repository.StartupRepository,
+	userRepo repository.UserRepository,
+	authService *service.AuthorizationService,
+	emailService port.EmailService,
+	logger logger.Logger,
+) *SubmitClaimUseCase {
+	return &SubmitClaimUseCase{
+		claimRepo: claimRepo, startupRepo: startupRepo, userRepo: userRepo,
+		authService: authService, emailService: emailService, logger: logger,
+	}
+}
+
+func (uc *SubmitClaimUseCase) Execute(ctx context.Context, startupID, userID string, input dto.CreateStartupClaimInput) (*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.SubmitClaim")
+	defer span.End()
+
+	startup, err := uc.startupRepo.FindByID(ctx, startupID)
+	if err != nil || startup == nil || startup.Status != entity.StartupStatusActive {
+		return nil, errors.NewNotFoundError("startup")
+	}
+	if startup.TeamID != nil && *startup.TeamID != "" {
+		return nil, errors.NewBadRequestError("startup is already managed by a team")
+	}
+
+	var teamID *string
+	if input.TeamID != nil && *input.TeamID != "" {
+		ok, err := uc.authService.HasScope(ctx, userID, *input.TeamID, entity.ScopeStartupManage)
+		if err != nil || !ok {
+			return nil, errors.NewNotFoundError("team")
+		}
+		teamID = input.TeamID
+	}
+
+	existing, err := uc.claimRepo.FindPendingByStartupAndUser(ctx, startupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.NewBadRequestError("you already have a pending claim for this startup")
+	}
+
+	method := entity.ClaimMethod(input.Method)
+	domain := utils.WebsiteDomain(startup.Website)
+	if method != entity.ClaimMethodManual && domain == "" {
+		return nil, errors.NewBadRequestError("startup has no website domain; use manual review")
+	}
+
+	now := time.Now()
+	claim := &entity.StartupClaim{
+		ID: uuid.New().String(), StartupID: startupID, UserID: userID, TeamID: teamID,
+		Method: method, Status: entity.ClaimStatusPending, Domain: domain,
+		Message: strings.TrimSpace(input.Message), CreatedAt: now, UpdatedAt: now,
+	}
+
+	var code, locale string
+	switch method {
+	case entity.ClaimMethodWebsite:
+		if claim.Token, err = utils.RandomHex(16); err != nil {
+			return nil, err
+		}
+	case entity.ClaimMethodEmailDomain:
+		user, err := uc.userRepo.FindByID(ctx, userID)
+		if err != nil || user == nil {
+			return nil, errors.NewNotFoundError("user")
+		}
+		if utils.EmailDomain(user.Email) != domain {
+			return nil, errors.NewBadRequestError("your account email must be an address on " + domain)
+		}
+		if code, err = utils.RandomHex(4); err != nil {
+			return nil, err
+		}
+		code = strings.ToUpper(code)
+		expiresAt := now.Add(claimCodeTTL)
+		claim.Email = strings.ToLower(user.Email)
+		locale = user.Locale
+		claim.CodeHash = utils.HashToken(code)
+		claim.CodeExpiresAt = &expiresAt
+	}
+
+	if err := uc.claimRepo.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+	addClaimEvent(ctx, uc.claimRepo, uc.logger, claim.ID, &userID, entity.ClaimEventSubmitted, string(method))
+
+	if code != "" {
+		if err := uc.emailService.Send(ctx, port.EmailDomainVerification, port.DomainVerificationEmail{
+			StartupName: startup.Name, Domain: domain, Code: code,
+		}, port.EmailRecipient{Email: claim.Email, Locale: locale}); err != nil {
+			uc.logger.WithContext(ctx).Error("Failed to send claim code for startup %s: %v", startupID, err)
+			return nil, errors.ErrInternalError
+		}
+		addClaimEvent(ctx, uc.claimRepo, uc.logger, claim.ID, nil, entity.ClaimEventCodeSent, claim.Email)
+	}
+	return toStartupClaimOutput(claim, startup.Name, nil), nil
+}
+
+// VerifyClaimUseCase checks the claimant's proof and approves the claim on success.
+type VerifyClaimUseCase struct {
+	approver *claimApprover
+	verifier port.DomainVerifier
+}
+
+func NewVerifyClaimUseCase(
+	claimRepo repository.StartupClaimRepository,
+	startupRepo repository.StartupRepository,
+	teamRepo repository.TeamRepository,
+	teamMemberRepo repository.TeamMemberRepository,
+	roleRepo repository.RoleRepository,
+	slugs *service.SlugService,
+	verifier port.DomainVerifier,
+	authService *service.AuthorizationService,
+	tx port.TxManager,
+	cache port.PublicCache,
+	logger logger.Logger,
+) *VerifyClaimUseCase {
+	return &VerifyClaimUseCase{
+		approver: &claimApprover{
+			claimRepo: claimRepo, startupRepo: startupRepo, teamRepo: teamRepo,
+			teamMemberRepo: teamMemberRepo, roleRepo: roleRepo, slugs: slugs, authService: authService, tx: tx, cache: cache, logger: logger,
+		},
+		verifier: verifier,
+	}
+}
+
+func (uc *VerifyClaimUseCase) Execute(ctx context.Context, claimID, userID string, input dto.VerifyStartupClaimInput) (*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.VerifyClaim")
+	defer span.End()
+
+	a := uc.approver
+	claim, err := a.claimRepo.FindByID(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil || claim.UserID != userID {
+		return nil, errors.NewNotFoundError("claim")
+	}
+	if !claim.IsPending() {
+		return nil, errors.NewBadRequestError("claim is no longer pending")
+	}
+
+	now := time.Now()
+	var verifyErr error
+	switch claim.Method {
+	case entity.ClaimMethodManual:
+		return nil, errors.NewBadRequestError("manual claims are reviewed by an admin")
+	case entity.ClaimMethodWebsite:
+		if dnsErr := uc.verifier.CheckDNS(ctx, claim.Domain, claim.Token); dnsErr != nil {
+			if fileErr := uc.verifier.CheckWellKnownFile(ctx, claim.Domain, claim.Token); fileErr != nil {
+				verifyErr = errors.NewBadRequestError("verification token not found in DNS or " + entity.VerificationWellKnownPath)
+			}
+		}
+	case entity.ClaimMethodEmailDomain:
+		if claim.CodeExpiresAt == nil || now.After(*claim.CodeExpiresAt) || claim.CodeAttempts >= maxClaimCodeAttempts {
+			return nil, errors.NewBadRequestError("verification code expired; submit a new claim")
+		}
+		code := strings.ToUpper(strings.TrimSpace(input.Code))
+		if code == "" || subtle.ConstantTimeCompare([]byte(utils.HashToken(code)), []byte(claim.CodeHash)) != 1 {
+			claim.CodeAttempts++
+			claim.UpdatedAt = now
+			if err := a.claimRepo.Update(ctx, claim); err != nil {
+				return nil, err
+			}
+			verifyErr = errors.NewBadRequestError("invalid verification code")
+		}
+	}
+	if verifyErr != nil {
+		addClaimEvent(ctx, a.claimRepo, a.logger, claim.ID, &userID, entity.ClaimEventVerificationFailed, verifyErr.Error())
+		return nil, verifyErr
+	}
+
+	if err := a.approve(ctx, claim, nil, "verified by "+string(claim.Method)); err != nil {
+		return nil, err
+	}
+	return loadClaimOutput(ctx, a.claimRepo, a.startupRepo, claim, true)
+}
+
+// GetClaimUseCase returns a claim with its audit trail to the claimant or a platform admin.
+type GetClaimUseCase struct {
+	claimRepo   repository.StartupClaimRepository
+	startupRepo repository.StartupRepository
+	authService *service.AuthorizationService
+}
+
+func NewGetClaimUseCase(claimRepo repository.StartupClaimRepository, startupRepo repository.StartupRepository, authService *service.AuthorizationService) *GetClaimUseCase {
+	return &GetClaimUseCase{claimRepo: claimRepo, startupRepo: startupRepo, authService: authService}
+}
+
+func (uc *GetClaimUseCase) Execute(ctx context.Context, claimID, userID string) (*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.GetClaim")
+	defer span.End()
+
+	claim, err := uc.claimRepo.FindByID(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, errors.NewNotFoundError("claim")
+	}
+	if claim.UserID != userID {
+		if ok, err := uc.authService.IsPlatformAdmin(ctx, userID); err != nil || !ok {
+			return nil, errors.NewNotFoundError("claim")
+		}
+	}
+	return loadClaimOutput(ctx, uc.claimRepo, uc.startupRepo, claim, true)
+}
+
+type ListMyClaimsUseCase struct {
+	claimRepo   repository.StartupClaimRepository
+	startupRepo repository.StartupRepository
+}
+
+func NewListMyClaimsUseCase(claimRepo repository.StartupClaimRepository, startupRepo repository.StartupRepository) *ListMyClaimsUseCase {
+	return &ListMyClaimsUseCase{claimRepo: claimRepo, startupRepo: startupRepo}
+}
+
+func (uc *ListMyClaimsUseCase) Execute(ctx context.Context, userID string) ([]*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListMyClaims")
+	defer span.End()
+
+	claims, err := uc.claimRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toStartupClaimOutputs(ctx, uc.startupRepo, claims), nil
+}
+
+// ListClaimsUseCase is the platform-admin review queue.
+type ListClaimsUseCase struct {
+	claimRepo   repository.StartupClaimRepository
+	startupRepo repository.StartupRepository
+	authService *service.AuthorizationService
+}
+
+func NewListClaimsUseCase(claimRepo repository.StartupClaimRepository, startupRepo repository.StartupRepository, authService *service.AuthorizationService) *ListClaimsUseCase {
+	return &ListClaimsUseCase{claimRepo: claimRepo, startupRepo: startupRepo, authService: authService}
+}
+
+func (uc *ListClaimsUseCase) Execute(ctx context.Context, actorID, status string, page, pageSize int) ([]*dto.StartupClaimOutput, int64, error) {
+	ctx, span := tracing.Start(ctx, "team.ListClaims")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, 0, errors.NewForbiddenError("platform admin required")
+	}
+	claims, total, err := uc.claimRepo.List(ctx, entity.ClaimStatus(status), page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return toStartupClaimOutputs(ctx, uc.startupRepo, claims), total, nil
+}
+
+// ApproveClaimUseCase lets a platform admin approve any pending claim,
+// regardless of whether the claimant completed verification.
+type ApproveClaimUseCase struct {
+	approver *claimApprover
+}
+
+func NewApproveClaimUseCase(
+	claimRepo repository.StartupClaimRepository,
+	startupRepo repository.StartupRepository,
+	teamRepo repository.TeamRepository,
+	teamMemberRepo repository.TeamMemberRepository,
+	roleRepo repository.RoleRepository,
+	slugs *service.SlugService,
+	authService *service.AuthorizationService,
+	tx port.TxManager,
+	cache port.PublicCache,
+	logger logger.Logger,
+) *ApproveClaimUseCase {
+	return &ApproveClaimUseCase{approver: &claimApprover{
+		claimRepo: claimRepo, startupRepo: startupRepo, teamRepo: teamRepo,
+		teamMemberRepo: teamMemberRepo, roleRepo: roleRepo, slugs: slugs, authService: authService, tx: tx, cache: cache, logger: logger,
+	}}
+}
+
+func (uc *ApproveClaimUseCase) Execute(ctx context.Context, actorID, claimID string) (*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ApproveClaim")
+	defer span.End()
+
+	a := uc.approver
+	ok, err := a.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	claim, err := a.claimRepo.FindByID(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, errors.NewNotFoundError("claim")
+	}
+	if !claim.IsPending() {
+		return nil, errors.NewBadRequestError("claim is no longer pending")
+	}
+	if err := a.approve(ctx, claim, &actorID, "approved by admin"); err != nil {
+		return nil, err
+	}
+	return loadClaimOutput(ctx, a.claimRepo, a.startupRepo, claim, true)
+}
+
+type RejectClaimUseCase struct {
+	claimRepo   repository.StartupClaimRepository
+	startupRepo repository.StartupRepository
+	authService *service.AuthorizationService
+	logger      logger.Logger
+}
+
+func NewRejectClaimUseCase(claimRepo repository.StartupClaimRepository, startupRepo repository.StartupRepository, authService *service.AuthorizationService, logger logger.Logger) *RejectClaimUseCase {
+	return &RejectClaimUseCase{claimRepo: claimRepo, startupRepo: startupRepo, authService: authService, logger: logger}
+}
+
+func (uc *RejectClaimUseCase) Execute(ctx context.Context, actorID, claimID string, input dto.RejectStartupClaimInput) (*dto.StartupClaimOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.RejectClaim")
+	defer span.End()
+
+	ok, err := uc.authService.IsPlatformAdmin(ctx, actorID)
+	if err != nil || !ok {
+		return nil, errors.NewForbiddenError("platform admin required")
+	}
+	claim, err := uc.claimRepo.FindByID(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, errors.NewNotFoundError("claim")
+	}
+	if !claim.IsPending() {
+		return nil, errors.NewBadRequestError("claim is no longer pending")
+	}
+	if err := rejectClaim(ctx, uc.claimRepo, uc.logger, claim, &actorID, strings.TrimSpace(input.Reason)); err != nil {
+		return nil, err
+	}
+	return loadClaimOutput(ctx, uc.claimRepo, uc.startupRepo, claim, true)
+}
+
+func loadClaimOutput(ctx context.Context, claimRepo repository.StartupClaimRepository, startupRepo repository.StartupRepository, claim *entity.StartupClaim, withEvents bool) (*dto.StartupClaimOutput, error) {
+	startupName := ""
+	if startup, err := startupRepo.FindByID(ctx, claim.StartupID); err == nil && startup != nil {
+		startupName = startup.Name
+	}
+	var events []*entity.StartupClaimEvent
+	if withEvents {
+		var err error
+		if events, err = claimRepo.ListEvents(ctx, claim.ID); err != nil {
+			return nil, err
+		}
+	}
+	return toStartupClaimOutput(claim, startupName, events), nil
+}
+
+func toStartupClaimOutputs(ctx context.Context, startupRepo repository.StartupRepository, claims []*entity.StartupClaim) []*dto.StartupClaimOutput {
+	names := map[string]string{}
+	out := make([]*dto.StartupClaimOutput, len(claims))
+	for i, c := range claims {
+		name, ok := names[c.StartupID]
+		if !ok {
+			if startup, err := startupRepo.FindByID(ctx, c.StartupID); err == nil && startup != nil {
+				name = startup.Name
+			}
+			names[c.StartupID] = name
+		}
+		out[i] = toStartupClaimOutput(c, name, nil)
+	}
+	return out
+}
+
+func toStartupClaimOutput(c *entity.StartupClaim, startupName string, events []*entity.StartupClaimEvent) *dto.StartupClaimOutput {
+	out := &dto.StartupClaimOutput{
+		ID: c.ID, StartupID: c.StartupID, StartupName: startupName, UserID: c.UserID, TeamID: c.TeamID,
+		Method: string(c.Method), Status: string(c.Status), Domain: c.Domain, Message: c.Message,
+		Email: c.Email, RejectionReason: c.RejectionReason,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339), UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+	}
+	if c.Method == entity.ClaimMethodWebsite && c.IsPending() {
+		out.DNSRecordName = c.Domain
+		out.DNSRecordValue = entity.VerificationTXTPrefix + c.Token
+		out.FileURL = "https://" + c.Domain + entity.VerificationWellKnownPath
+		out.FileContent = c.Token
+	}
+	out.ReviewedAt = formatOptionalTime(c.ReviewedAt)
+	for _, e := range events {
+		out.Events = append(out.Events, dto.StartupClaimEventOutput{
+			Action: string(e.Action), ActorID: e.ActorID, Note: e.Note, CreatedAt: e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return out
+}
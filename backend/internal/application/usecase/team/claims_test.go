@@ -0,0 +1,277 @@
+package team_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/application/usecase/team"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	apperrors "github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+type memClaims struct {
+	byID   map[string]*entity.StartupClaim
+	events []*entity.StartupClaimEvent
+}
+
+func (r *memClaims) Create(ctx context.Context, c *entity.StartupClaim) error {
+	r.byID[c.ID] = c
+	return nil
+}
+func (r *memClaims) Update(ctx context.Context, c *entity.StartupClaim) error {
+	r.byID[c.ID] = c
+	return nil
+}
+func (r *memClaims) FindByID(ctx context.Context, id string) (*entity.StartupClaim, error) {
+	c, ok := r.byID[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *c
+	return &copied, nil
+}
+func (r *memClaims) FindPendingByStartupAndUser(ctx context.Context, startupID, userID string) (*entity.StartupClaim, error) {
+	for _, c := range r.byID {
+		if c.StartupID == startupID && c.UserID == userID && c.IsPending() {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+func (r *memClaims) ListPendingByStartupID(ctx context.Context, startupID string) ([]*entity.StartupClaim, error) {
+	var out []*entity.StartupClaim
+	for _, c := range r.byID {
+		if c.StartupID == startupID && c.IsPending() {
+			copied := *c
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+func (r *memClaims) ListByUserID(ctx context.Context, userID string) ([]*entity.StartupClaim, error) {
+	return nil, nil
+}
+func (r *memClaims) List(ctx context.Context, status entity.ClaimStatus, page, pageSize int) ([]*entity.StartupClaim, int64, error) {
+	return nil, 0, nil
+}
+func (r *memClaims) AddEvent(ctx context.Context, e *entity.StartupClaimEvent) error {
+	r.events = append(r.events, e)
+	return nil
+}
+func (r *memClaims) ListEvents(ctx context.Context, claimID string) ([]*entity.StartupClaimEvent, error) {
+	return nil, nil
+}
+
+// staleStartups returns the startup as it was before any link, like a read
+// that raced a concurrent approval.
+type staleStartups struct{ *lfStartup }
+
+func (r staleStartups) FindByID(ctx context.Context, id string) (*entity.Startup, error) {
+	s, err := r.lfStartup.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	copied := *s
+	copied.TeamID = nil
+	return &copied, nil
+}
+
+type dnsVerifier struct{ published map[string]string }
+
+func (v dnsVerifier) CheckDNS(ctx context.Context, domain, token string) error {
+	if v.published[domain] != token {
+		return errors.New("no TXT record")
+	}
+	return nil
+}
+func (v dnsVerifier) CheckWellKnownFile(ctx context.Context, domain, token string) error {
+	return errors.New("no file")
+}
+
+// cacheSpy records which public cache scopes were invalidated.
+type cacheSpy struct{ invalidated []port.CacheScope }
+
+func (c *cacheSpy) Invalidate(scopes ...port.CacheScope) {
+	c.invalidated = append(c.invalidated, scopes...)
+}
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type claimFixture struct {
+	*linkFixture
+	claims   *memClaims
+	verifier dnsVerifier
+	cache    *cacheSpy
+}
+
+// newClaimFixture has an orphan startup s1, two claimants ann and bob who
+// each manage their own team, and a platform admin.
+func newClaimFixture() *claimFixture {
+	f := &claimFixture{
+		linkFixture: newLinkFixture(),
+		claims:      &memClaims{byID: map[string]*entity.StartupClaim{}},
+		verifier:    dnsVerifier{published: map[string]string{}},
+		cache:       &cacheSpy{},
+	}
+	f.users["admin"] = &entity.User{ID: "admin", Role: entity.UserRoleAdmin}
+	f.roles["owner"] = &entity.Role{ID: "owner", Scopes: entity.AllScopes()}
+	for _, user := range []string{"ann", "bob"} {
+		f.users[user] = &entity.User{ID: user, Email: user + "@acme.example", Role: entity.UserRoleCandidate}
+		f.members[user+":team-"+user] = &entity.TeamMember{
+			UserID: user, TeamID: "team-" + user, RoleID: "owner", Status: entity.MemberStatusActive,
+		}
+	}
+	f.startups["s1"] = &entity.Startup{ID: "s1", Name: "Acme", Website: "https://acme.example", Status: entity.StartupStatusActive}
+	return f
+}
+
+func (f *claimFixture) submit(t *testing.T, userID string, method entity.ClaimMethod) *dto.StartupClaimOutput {
+	t.Helper()
+	teamID := "team-" + userID
+	uc := team.NewSubmitClaimUseCase(f.claims, &lfStartup{f.linkFixture}, &lfUser{f.linkFixture}, f.authz, nil, discardLogger())
+	out, err := uc.Execute(context.Background(), "s1", userID, dto.CreateStartupClaimInput{Method: string(method), TeamID: &teamID})
+	if err != nil {
+		t.Fatalf("submit %s claim for %s: %v", method, userID, err)
+	}
+	return out
+}
+
+func (f *claimFixture) approveUseCase() *team.ApproveClaimUseCase {
+	return team.NewApproveClaimUseCase(f.claims, &lfStartup{f.linkFixture}, &lfTeam{}, &lfMember{f.linkFixture}, &lfRole{f.linkFixture}, nil, f.authz, inlineTx{}, f.cache, discardLogger())
+}
+
+func discardLogger() logger.Logger {
+	return logger.New(logger.Options{Output: io.Discard})
+}
+
+func appErrorCode(err error) string {
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	return ""
+}
+
+func TestVerifyClaimByDomainApprovesAndRejectsCompetitors(t *testing.T) {
+	f := newClaimFixture()
+	ann := f.submit(t, "ann", entity.ClaimMethodWebsite)
+	bob := f.submit(t, "bob", entity.ClaimMethodManual)
+	f.verifier.published["acme.example"] = f.claims.byID[ann.ID].Token
+
+	uc := team.NewVerifyClaimUseCase(f.claims, &lfStartup{f.linkFixture}, &lfTeam{}, &lfMember{f.linkFixture}, &lfRole{f.linkFixture}, nil, f.verifier, f.authz, inlineTx{}, f.cache, discardLogger())
+	out, err := uc.Execute(context.Background(), ann.ID, "ann", dto.VerifyStartupClaimInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Status != string(entity.ClaimStatusApproved) {
+		t.Fatalf("status = %s", out.Status)
+	}
+	if got := f.startups["s1"].TeamID; got == nil || *got != "team-ann" {
+		t.Fatalf("startup team = %v, want team-ann", got)
+	}
+	if f.claims.byID[bob.ID].Status != entity.ClaimStatusRejected {
+		t.Fatalf("competing claim is %s, want rejected", f.claims.byID[bob.ID].Status)
+	}
+}
+
+func TestVerifyClaimWithoutPublishedTokenFails(t *testing.T) {
+	f := newClaimFixture()
+	ann := f.submit(t, "ann", entity.ClaimMethodWebsite)
+
+	uc := team.NewVerifyClaimUseCase(f.claims, &lfStartup{f.linkFixture}, &lfTeam{}, &lfMember{f.linkFixture}, &lfRole{f.linkFixture}, nil, f.verifier, f.authz, inlineTx{}, f.cache, discardLogger())
+	if _, err := uc.Execute(context.Background(), ann.ID, "ann", dto.VerifyStartupClaimInput{}); err == nil {
+		t.Fatal("claim verified without a published token")
+	}
+	if f.startups["s1"].TeamID != nil || !f.claims.byID[ann.ID].IsPending() {
+		t.Fatal("failed verification must leave the claim pending and the startup unlinked")
+	}
+}
+
+func TestAdminApproveAndRejectManualClaims(t *testing.T) {
+	f := newClaimFixture()
+	ann := f.submit(t, "ann", entity.ClaimMethodManual)
+	bob := f.submit(t, "bob", entity.ClaimMethodManual)
+	ctx := context.Background()
+
+	reject := team.NewRejectClaimUseCase(f.claims, &lfStartup{f.linkFixture}, f.authz, discardLogger())
+	if _, err := reject.Execute(ctx, "ann", bob.ID, dto.RejectStartupClaimInput{Reason: "no"}); appErrorCode(err) != "FORBIDDEN" {
+		t.Fatalf("non-admin reject: %v", err)
+	}
+	out, err := reject.Execute(ctx, "admin", bob.ID, dto.RejectStartupClaimInput{Reason: "not affiliated"})
+	if err != nil || out.Status != string(entity.ClaimStatusRejected) || out.RejectionReason != "not affiliated" {
+		t.Fatalf("reject: out=%+v err=%v", out, err)
+	}
+
+	approve := f.approveUseCase()
+	if _, err := approve.Execute(ctx, "ann", ann.ID); appErrorCode(err) != "FORBIDDEN" {
+		t.Fatalf("non-admin approve: %v", err)
+	}
+	if out, err := approve.Execute(ctx, "admin", ann.ID); err != nil || out.Status != string(entity.ClaimStatusApproved) {
+		t.Fatalf("approve: out=%+v err=%v", out, err)
+	}
+	if got := f.startups["s1"].TeamID; got == nil || *got != "team-ann" {
+		t.Fatalf("startup team = %v, want team-ann", got)
+	}
+	if len(f.cache.invalidated) != 1 || f.cache.invalidated[0] != port.CacheScopeStartups {
+		t.Fatalf("invalidated %v after approval, want startups", f.cache.invalidated)
+	}
+	if _, err := approve.Execute(ctx, "admin", bob.ID); err == nil {
+		t.Fatal("approved a rejected claim")
+	}
+}
+
+func TestSubmitClaimRefusesDuplicateAndLinkedStartup(t *testing.T) {
+	f := newClaimFixture()
+	f.submit(t, "ann", entity.ClaimMethodManual)
+	teamID := "team-ann"
+	uc := team.NewSubmitClaimUseCase(f.claims, &lfStartup{f.linkFixture}, &lfUser{f.linkFixture}, f.authz, nil, discardLogger())
+
+	_, err := uc.Execute(context.Background(), "s1", "ann", dto.CreateStartupClaimInput{Method: "manual", TeamID: &teamID})
+	if appErrorCode(err) != "BAD_REQUEST" {
+		t.Fatalf("duplicate pending claim: %v", err)
+	}
+
+	linked := "team-other"
+	f.startups["s1"].TeamID = &linked
+	teamID = "team-bob"
+	if _, err := uc.Execute(context.Background(), "s1", "bob", dto.CreateStartupClaimInput{Method: "manual", TeamID: &teamID}); appErrorCode(err) != "BAD_REQUEST" {
+		t.Fatalf("claim on linked startup: %v", err)
+	}
+}
+
+func TestConcurrentApprovalGetsConflict(t *testing.T) {
+	f := newClaimFixture()
+	ann := f.submit(t, "ann", entity.ClaimMethodManual)
+	bob := f.submit(t, "bob", entity.ClaimMethodManual)
+	ctx := context.Background()
+
+	if _, err := f.approveUseCase().Execute(ctx, "admin", ann.ID); err != nil {
+		t.Fatal(err)
+	}
+	// bob's claim was rejected by ann's approval; reopen it and approve it
+	// from a stale read of the startup, as a request racing ann's would.
+	f.claims.byID[bob.ID].Status = entity.ClaimStatusPending
+	racing := team.NewApproveClaimUseCase(f.claims, staleStartups{&lfStartup{f.linkFixture}}, &lfTeam{}, &lfMember{f.linkFixture}, &lfRole{f.linkFixture}, nil, f.authz, inlineTx{}, f.cache, discardLogger())
+	invalidated := len(f.cache.invalidated)
+	if _, err := racing.Execute(ctx, "admin", bob.ID); appErrorCode(err) != "CONFLICT" {
+		t.Fatalf("racing approval: %v, want CONFLICT", err)
+	}
+	if len(f.cache.invalidated) != invalidated {
+		t.Fatal("a failed approval must not invalidate the cache")
+	}
+	if got := f.startups["s1"].TeamID; got == nil || *got != "team-ann" {
+		t.Fatalf("startup team = %v, want team-ann", got)
+	}
+	if _, err := f.approveUseCase().Execute(ctx, "admin", bob.ID); appErrorCode(err) != "CONFLICT" {
+		t.Fatalf("approval of linked startup: %v, want CONFLICT", err)
+	}
+}
@@ -11,13 +11,14 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
 	"github.com/startup-job-board/backend/pkg/logger"
-	"github.com/startup-job-board/backend/pkg/utils"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type CreateTeamUseCase struct {
 	teamRepo       repository.TeamRepository
 	teamMemberRepo repository.TeamMemberRepository
 	roleRepo       repository.RoleRepository
+	slugs          *service.SlugService
 	logger         logger.Logger
 }
 
@@ -25,29 +26,46 @@ func NewCreateTeamUseCase(
 	teamRepo repository.TeamRepository,
 	teamMemberRepo repository.TeamMemberRepository,
 	roleRepo repository.RoleRepository,
+	slugs *service.SlugService,
 	logger logger.Logger,
 ) *CreateTeamUseCase {
-	return &CreateTeamUseCase{teamRepo: teamRepo, teamMemberRepo: teamMemberRepo, roleRepo: roleRepo, logger: logger}
+	return &CreateTeamUseCase{teamRepo: teamRepo, teamMemberRepo: teamMemberRepo, roleRepo: roleRepo, slugs: slugs, logger: logger}
 }
 
 func (uc *CreateTeamUseCase) Execute(ctx context.Context, input dto.CreateTeamInput, userID string) (*dto.TeamOutput, error) {
-	ownerRole, err := uc.roleRepo.FindSystemBySlug(ctx, string(entity.SystemRoleOwner))
+	ctx, span := tracing.Start(ctx, "team.CreateTeam")
+	defer span.End()
+
+	team, err := createTeamWithOwner(ctx, uc.teamRepo, uc.teamMemberRepo, uc.roleRepo, uc.slugs, uc.logger, input.Name, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toTeamOutput(team), nil
+}
+
+// createTeamWithOwner creates a team and makes userID its owner.
+func createTeamWithOwner(
+	ctx context.Context,
+	teamRepo repository.TeamRepository,
+	teamMemberRepo repository.TeamMemberRepository,
+	roleRepo repository.RoleRepository,
+	slugs *service.SlugService,
+	logger logger.Logger,
+	name, userID string,
+) (*entity.Team, error) {
+	ownerRole, err := roleRepo.FindSystemBySlug(ctx, string(entity.SystemRoleOwner))
 	if err != nil {
 		return nil, errors.ErrInternalError
 	}
 
-	baseSlug := utils.GenerateSlug(input.Name)
-	slug := utils.GenerateUniqueSlug(baseSlug, func(s string) bool {
-		_, e := uc.teamRepo.FindBySlug(ctx, s)
-		return e == nil
-	})
+	slug := slugs.TeamSlug(ctx, name, "")
 
 	now := time.Now()
 	team := &entity.Team{
-		ID: uuid.New().String(), Name: input.Name, Slug: slug,
+		ID: uuid.New().String(), Name: name, Slug: slug,
 		CreatedBy: userID, CreatedAt: now, UpdatedAt: now,
 	}
-	if err := uc.teamRepo.Create(ctx, team); err != nil {
+	if err := teamRepo.Create(ctx, team); err != nil {
 		return nil, err
 	}
 
@@ -57,12 +75,11 @@ func (uc *CreateTeamUseCase) Execute(ctx context.Context, input dto.CreateTeamIn
 		RoleID: ownerRole.ID, Status: entity.MemberStatusActive,
 		InvitedAt: now, JoinedAt: &joined, CreatedAt: now, UpdatedAt: now,
 	}
-	if err := uc.teamMemberRepo.Create(ctx, member); err != nil {
-		uc.logger.Error("failed to create team owner membership: %v", err)
+	if err := teamMemberRepo.Create(ctx, member); err != nil {
+		logger.Error("failed to create team owner membership: %v", err)
 		return nil, err
 	}
-
-	return toTeamOutput(team), nil
+	return team, nil
 }
 
 type ListMyTeamsUseCase struct {
@@ -74,6 +91,9 @@ func NewListMyTeamsUseCase(teamRepo repository.TeamRepository) *ListMyTeamsUseCa
 }
 
 func (uc *ListMyTeamsUseCase) Execute(ctx context.Context, userID string) ([]*dto.TeamOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListMyTeams")
+	defer span.End()
+
 	teams, err := uc.teamRepo.ListByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -95,6 +115,9 @@ func NewGetTeamUseCase(teamRepo repository.TeamRepository, authService *service.
 }
 
 func (uc *GetTeamUseCase) Execute(ctx context.Context, teamID, userID string) (*dto.TeamOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.GetTeam")
+	defer span.End()
+
 	// Security: use NOT_FOUND when the caller cannot see the team (no existence leak).
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeTeamRead)
 	if err != nil || !ok {
@@ -109,14 +132,19 @@ func (uc *GetTeamUseCase) Execute(ctx context.Context, teamID, userID string) (*
 
 type UpdateTeamUseCase struct {
 	teamRepo    repository.TeamRepository
+	slugs       *service.SlugService
 	authService *service.AuthorizationService
+	logger      logger.Logger
 }
 
-func NewUpdateTeamUseCase(teamRepo repository.TeamRepository, authService *service.AuthorizationService) *UpdateTeamUseCase {
-	return &UpdateTeamUseCase{teamRepo: teamRepo, authService: authService}
+func NewUpdateTeamUseCase(teamRepo repository.TeamRepository, slugs *service.SlugService, authService *service.AuthorizationService, logger logger.Logger) *UpdateTeamUseCase {
+	return &UpdateTeamUseCase{teamRepo: teamRepo, slugs: slugs, authService: authService, logger: logger}
 }
 
 func (uc *UpdateTeamUseCase) Execute(ctx context.Context, teamID, userID string, input dto.UpdateTeamInput) (*dto.TeamOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.UpdateTeam")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeTeamManage)
 	if err != nil || !ok {
 		return nil, errors.NewNotFoundError("team")
@@ -125,11 +153,18 @@ func (uc *UpdateTeamUseCase) Execute(ctx context.Context, teamID, userID string,
 	if err != nil {
 		return nil, errors.NewNotFoundError("team")
 	}
+	oldSlug := team.Slug
+	if input.Name != team.Name {
+		team.Slug = uc.slugs.TeamSlug(ctx, input.Name, team.ID)
+	}
 	team.Name = input.Name
 	team.UpdatedAt = time.Now()
 	if err := uc.teamRepo.Update(ctx, team); err != nil {
 		return nil, err
 	}
+	if err := uc.slugs.Retire(ctx, entity.SlugOwnerTeam, team.ID, oldSlug, team.Slug); err != nil {
+		uc.logger.WithContext(ctx).Warn("Failed to record slug history for team %s: %v", team.ID, err)
+	}
 	return toTeamOutput(team), nil
 }
 
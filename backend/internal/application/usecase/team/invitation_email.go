@@ -0,0 +1,59 @@
+package team
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+// InvitationEmailJob sends the email for a team invitation. It carries
+// only the ID; the token is read from the invitation when the job runs.
+type InvitationEmailJob struct {
+	InvitationID string `json:"invitation_id"`
+}
+
+func (InvitationEmailJob) Kind() string { return "team.invitation_email" }
+
+var _ port.BackgroundJobArgs = InvitationEmailJob{}
+
+// SendInvitationEmailUseCase handles InvitationEmailJob. An invitation that
+// was accepted, revoked or has expired by the time the job runs gets no email.
+type SendInvitationEmailUseCase struct {
+	invitationRepo repository.TeamInvitationRepository
+	teamRepo       repository.TeamRepository
+	emailService   port.EmailService
+	appURL         string
+}
+
+func NewSendInvitationEmailUseCase(
+	invitationRepo repository.TeamInvitationRepository,
+	teamRepo repository.TeamRepository,
+	emailService port.EmailService,
+	appURL string,
+) *SendInvitationEmailUseCase {
+	return &SendInvitationEmailUseCase{
+		invitationRepo: invitationRepo, teamRepo: teamRepo, emailService: emailService, appURL: appURL,
+	}
+}
+
+func (uc *SendInvitationEmailUseCase) Execute(ctx context.Context, job InvitationEmailJob) error {
+	ctx, span := tracing.Start(ctx, "team.SendInvitationEmail")
+	defer span.End()
+
+	inv, err := uc.invitationRepo.FindByID(ctx, job.InvitationID)
+	if err != nil {
+		return err
+	}
+	if !inv.IsValid() {
+		return nil
+	}
+	teamName := "a JoinUs team"
+	if team, err := uc.teamRepo.FindByID(ctx, inv.TeamID); err == nil && team != nil {
+		teamName = team.Name
+	}
+	link := uc.appURL + "/invitations/accept?token=" + inv.Token
+	return uc.emailService.Send(ctx, port.EmailTeamInvitation, port.TeamInvitationEmail{TeamName: teamName, InviteURL: link},
+		port.EmailRecipient{Email: inv.Email, Locale: inv.Locale})
+}
@@ -0,0 +1,107 @@
+package team_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/application/usecase/team"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/email"
+)
+
+type memInvitations struct {
+	repository.TeamInvitationRepository
+	byID map[string]*entity.TeamInvitation
+}
+
+func (r *memInvitations) Create(ctx context.Context, inv *entity.TeamInvitation) error {
+	r.byID[inv.ID] = inv
+	return nil
+}
+func (r *memInvitations) FindByID(ctx context.Context, id string) (*entity.TeamInvitation, error) {
+	inv, ok := r.byID[id]
+	if !ok {
+		return nil, errNF
+	}
+	return inv, nil
+}
+
+type fixedTokens struct{}
+
+func (fixedTokens) GenerateToken() (string, error)           { return "sb_token", nil }
+func (fixedTokens) GenerateInvitationToken() (string, error) { return "invite-token", nil }
+
+type noEvents struct{}
+
+func (noEvents) Record(ctx context.Context, event *entity.DomainEvent, data interface{}) error {
+	return nil
+}
+
+type invitationJobs struct{ jobs []team.InvitationEmailJob }
+
+func (q *invitationJobs) Enqueue(ctx context.Context, args port.BackgroundJobArgs, opts port.EnqueueOptions) (bool, error) {
+	q.jobs = append(q.jobs, args.(team.InvitationEmailJob))
+	return true, nil
+}
+
+type sentMessages struct{ messages []*email.Message }
+
+func (t *sentMessages) Deliver(ctx context.Context, msg *email.Message) error {
+	t.messages = append(t.messages, msg)
+	return nil
+}
+
+// TestInvitationEmailUsesInvitationLocale follows an invitation from the
+// invite request to the rendered email.
+func TestInvitationEmailUsesInvitationLocale(t *testing.T) {
+	f := newLinkFixture()
+	teamA := "team-a"
+	f.users["u1"] = &entity.User{ID: "u1", Role: entity.UserRoleCandidate}
+	f.users["u2"] = &entity.User{ID: "u2", Email: "bob@example.com", Role: entity.UserRoleCandidate, Locale: "de"}
+	f.roles["owner"] = &entity.Role{ID: "owner", Scopes: entity.AllScopes()}
+	f.roles["recruiter"] = &entity.Role{ID: "recruiter", TeamID: &teamA}
+	f.members["u1:team-a"] = &entity.TeamMember{
+		UserID: "u1", TeamID: teamA, RoleID: "owner", Status: entity.MemberStatusActive,
+	}
+
+	renderer, err := email.NewRenderer(email.BuiltinTemplates(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := &sentMessages{}
+	invitations := &memInvitations{byID: map[string]*entity.TeamInvitation{}}
+	jobs := &invitationJobs{}
+	invite := team.NewInviteMemberUseCase(invitations, &lfMember{f}, &lfUser{f}, &lfRole{f}, fixedTokens{}, f.authz, inlineTx{}, noEvents{}, jobs)
+	send := team.NewSendInvitationEmailUseCase(invitations, &lfTeam{byID: map[string]*entity.Team{teamA: {ID: teamA, Name: "Acme"}}},
+		email.NewService(renderer, sent, "JoinUs <hello@joinus.example>"), "https://joinus.example")
+
+	for _, tc := range []struct {
+		name, email, acceptLanguage string
+	}{
+		{"new invitee, inviter's language", "ann@example.com", "de-DE"},
+		{"existing user's own locale", "bob@example.com", "en"},
+	} {
+		jobs.jobs, sent.messages = nil, nil
+		input := dto.InviteTeamMemberInput{Email: tc.email, RoleID: "recruiter", Locale: tc.acceptLanguage}
+		if err := invite.Execute(context.Background(), teamA, "u1", input); err != nil {
+			t.Fatalf("%s: invite: %v", tc.name, err)
+		}
+		if len(jobs.jobs) != 1 {
+			t.Fatalf("%s: queued %d jobs", tc.name, len(jobs.jobs))
+		}
+		if err := send.Execute(context.Background(), jobs.jobs[0]); err != nil {
+			t.Fatalf("%s: send: %v", tc.name, err)
+		}
+		if len(sent.messages) != 1 {
+			t.Fatalf("%s: sent %d messages", tc.name, len(sent.messages))
+		}
+		msg := sent.messages[0]
+		if msg.To != tc.email || msg.Subject != "Einladung zum Team Acme" || !strings.Contains(msg.Text, "Einladung annehmen") {
+			t.Fatalf("%s: got %q to %s:\n%s", tc.name, msg.Subject, msg.To, msg.Text)
+		}
+	}
+}
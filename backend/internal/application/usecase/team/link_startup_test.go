@@ -46,7 +46,7 @@ func TestLinkStartupCannotStealOtherTeamStartup(t *testing.T) {
 	other := "team-b"
 	f.startups["s1"] = &entity.Startup{ID: "s1", TeamID: &other}
 
-	uc := team.NewLinkStartupUseCase(&lfStartup{f}, f.authz)
+	uc := team.NewLinkStartupUseCase(&lfStartup{f}, f.authz, &cacheSpy{})
 	err := uc.Execute(context.Background(), "team-a", "s1", "u1")
 	if err == nil {
 		t.Fatal("expected error when linking another team's startup")
@@ -70,10 +70,14 @@ func TestLinkStartupAllowsOrphan(t *testing.T) {
 	}
 	f.startups["s1"] = &entity.Startup{ID: "s1", TeamID: nil}
 
-	uc := team.NewLinkStartupUseCase(&lfStartup{f}, f.authz)
+	cache := &cacheSpy{}
+	uc := team.NewLinkStartupUseCase(&lfStartup{f}, f.authz, cache)
 	if err := uc.Execute(context.Background(), "team-a", "s1", "u1"); err != nil {
 		t.Fatalf("link orphan should succeed: %v", err)
 	}
+	if len(cache.invalidated) == 0 {
+		t.Fatal("linking must invalidate cached startup responses")
+	}
 	if f.startups["s1"].TeamID == nil || *f.startups["s1"].TeamID != "team-a" {
 		t.Fatal("orphan should be linked to team-a")
 	}
@@ -107,6 +111,11 @@ func (r *lfUser) FindByID(ctx context.Context, id string) (*entity.User, error)
 	return u, nil
 }
 func (r *lfUser) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	for _, u := range r.f.users {
+		if u.Email != "" && u.Email == email {
+			return u, nil
+		}
+	}
 	return nil, errNF
 }
 func (r *lfUser) List(ctx context.Context, page, pageSize int, search string) ([]*entity.User, int64, error) {
@@ -172,10 +181,16 @@ func (r *lfStartup) FindByID(ctx context.Context, id string) (*entity.Startup, e
 	}
 	return s, nil
 }
-func (r *lfStartup) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
-	return nil, errNF
+func (r *lfStartup) FindByIDs(ctx context.Context, ids []string) ([]*entity.Startup, error) {
+	var out []*entity.Startup
+	for _, id := range ids {
+		if s, ok := r.f.startups[id]; ok {
+			out = append(out, s)
+		}
+	}
+	return out, nil
 }
-func (r *lfStartup) FindByAPIToken(ctx context.Context, token string) (*entity.Startup, error) {
+func (r *lfStartup) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
 	return nil, errNF
 }
 func (r *lfStartup) FindByStripeSubscriptionID(ctx context.Context, id string) (*entity.Startup, error) {
@@ -187,6 +202,14 @@ func (r *lfStartup) FindByTeamID(ctx context.Context, teamID string) ([]*entity.
 func (r *lfStartup) List(ctx context.Context, filter repository.StartupFilter) ([]*entity.Startup, int64, error) {
 	return nil, 0, nil
 }
+func (r *lfStartup) LinkTeam(ctx context.Context, id, teamID string, at time.Time) (bool, error) {
+	s, ok := r.f.startups[id]
+	if !ok || s.TeamID != nil {
+		return false, nil
+	}
+	s.TeamID, s.UpdatedAt = &teamID, at
+	return true, nil
+}
 
 type lfLegacy struct{}
 
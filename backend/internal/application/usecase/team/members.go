@@ -12,7 +12,7 @@ import (
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/pkg/errors"
-	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type ListMembersUseCase struct {
@@ -34,6 +34,9 @@ func NewListMembersUseCase(
 }
 
 func (uc *ListMembersUseCase) Execute(ctx context.Context, teamID, userID string) ([]*dto.TeamMemberOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListMembers")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeMembersRead)
 	if err != nil || !ok {
 		return nil, errors.NewNotFoundError("team")
@@ -63,37 +66,37 @@ func (uc *ListMembersUseCase) Execute(ctx context.Context, teamID, userID string
 
 type InviteMemberUseCase struct {
 	invitationRepo repository.TeamInvitationRepository
-	teamRepo       repository.TeamRepository
 	teamMemberRepo repository.TeamMemberRepository
 	userRepo       repository.UserRepository
 	roleRepo       repository.RoleRepository
-	emailService   port.EmailService
 	tokenGen       port.TokenService
 	authService    *service.AuthorizationService
-	appURL         string
-	logger         logger.Logger
+	tx             port.TxManager
+	events         port.EventRecorder
+	jobs           port.BackgroundJobQueue
 }
 
 func NewInviteMemberUseCase(
 	invitationRepo repository.TeamInvitationRepository,
-	teamRepo repository.TeamRepository,
 	teamMemberRepo repository.TeamMemberRepository,
 	userRepo repository.UserRepository,
 	roleRepo repository.RoleRepository,
-	emailService port.EmailService,
 	tokenGen port.TokenService,
 	authService *service.AuthorizationService,
-	appURL string,
-	logger logger.Logger,
+	tx port.TxManager,
+	events port.EventRecorder,
+	jobs port.BackgroundJobQueue,
 ) *InviteMemberUseCase {
 	return &InviteMemberUseCase{
-		invitationRepo: invitationRepo, teamRepo: teamRepo, teamMemberRepo: teamMemberRepo,
-		userRepo: userRepo, roleRepo: roleRepo, emailService: emailService,
-		tokenGen: tokenGen, authService: authService, appURL: appURL, logger: logger,
+		invitationRepo: invitationRepo, teamMemberRepo: teamMemberRepo, userRepo: userRepo, roleRepo: roleRepo,
+		tokenGen: tokenGen, authService: authService, tx: tx, events: events, jobs: jobs,
 	}
 }
 
 func (uc *InviteMemberUseCase) Execute(ctx context.Context, teamID, inviterID string, input dto.InviteTeamMemberInput) error {
+	ctx, span := tracing.Start(ctx, "team.InviteMember")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, inviterID, teamID, entity.ScopeMembersInvite)
 	if err != nil || !ok {
 		return errors.NewNotFoundError("team")
@@ -112,10 +115,14 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, teamID, inviterID st
 	}
 
 	email := strings.ToLower(strings.TrimSpace(input.Email))
+	locale := input.Locale
 	if existing, _ := uc.userRepo.FindByEmail(ctx, email); existing != nil {
 		if member, _ := uc.teamMemberRepo.FindByUserAndTeam(ctx, existing.ID, teamID); member != nil && member.IsActive() {
 			return errors.NewBadRequestError("user is already a team member")
 		}
+		if existing.Locale != "" {
+			locale = existing.Locale
+		}
 	}
 
 	token, err := uc.tokenGen.GenerateInvitationToken()
@@ -126,39 +133,49 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, teamID, inviterID st
 	inv := &entity.TeamInvitation{
 		ID: uuid.New().String(), TeamID: teamID, Email: email, RoleID: input.RoleID,
 		Token: token, InvitedBy: inviterID, Status: entity.InvitationStatusPending,
-		ExpiresAt: now.Add(24 * time.Hour), CreatedAt: now, UpdatedAt: now,
+		ExpiresAt: now.Add(24 * time.Hour), Locale: locale, CreatedAt: now, UpdatedAt: now,
 	}
-	if err := uc.invitationRepo.Create(ctx, inv); err != nil {
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.invitationRepo.Create(ctx, inv); err != nil {
+			return err
+		}
+		// The token stays out of the payload: events are kept and fanned out.
+		err := uc.events.Record(ctx, &entity.DomainEvent{
+			Type: entity.DomainEventMemberInvited, AggregateType: "team", AggregateID: teamID, TeamID: teamID,
+		}, map[string]interface{}{
+			"invitation_id": inv.ID, "team_id": teamID, "email": email, "role_id": inv.RoleID,
+			"invited_by": inviterID, "expires_at": inv.ExpiresAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = uc.jobs.Enqueue(ctx, InvitationEmailJob{InvitationID: inv.ID}, port.EnqueueOptions{Queue: "email"})
 		return err
-	}
-
-	team, err := uc.teamRepo.FindByID(ctx, teamID)
-	teamName := "a JoinUs team"
-	if err == nil && team != nil {
-		teamName = team.Name
-	}
-	link := uc.appURL + "/invitations/accept?token=" + token
-	if err := uc.emailService.SendTeamInvitationEmail(ctx, email, teamName, link); err != nil {
-		uc.logger.Warn("failed to send team invitation email: %v", err)
-	}
-	return nil
+	})
 }
 
 type AcceptInvitationUseCase struct {
 	invitationRepo repository.TeamInvitationRepository
 	teamMemberRepo repository.TeamMemberRepository
 	userRepo       repository.UserRepository
+	tx             port.TxManager
+	events         port.EventRecorder
 }
 
 func NewAcceptInvitationUseCase(
 	invitationRepo repository.TeamInvitationRepository,
 	teamMemberRepo repository.TeamMemberRepository,
 	userRepo repository.UserRepository,
+	tx port.TxManager,
+	events port.EventRecorder,
 ) *AcceptInvitationUseCase {
-	return &AcceptInvitationUseCase{invitationRepo: invitationRepo, teamMemberRepo: teamMemberRepo, userRepo: userRepo}
+	return &AcceptInvitationUseCase{invitationRepo: invitationRepo, teamMemberRepo: teamMemberRepo, userRepo: userRepo, tx: tx, events: events}
 }
 
 func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, userID string, input dto.AcceptTeamInvitationInput) error {
+	ctx, span := tracing.Start(ctx, "team.AcceptInvitation")
+	defer span.End()
+
 	inv, err := uc.invitationRepo.FindByToken(ctx, input.Token)
 	if err != nil || inv == nil || !inv.IsValid() {
 		return errors.NewBadRequestError("invalid or expired invitation")
@@ -173,36 +190,46 @@ func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, userID string, i
 		return errors.NewForbiddenError("invitation does not match this account")
 	}
 
-	if existing, _ := uc.teamMemberRepo.FindByUserAndTeam(ctx, userID, inv.TeamID); existing != nil {
-		if existing.IsActive() {
-			inv.Status = entity.InvitationStatusAccepted
-			_ = uc.invitationRepo.Update(ctx, inv)
-			return nil
-		}
-		existing.RoleID = inv.RoleID
-		existing.Status = entity.MemberStatusActive
-		now := time.Now()
-		existing.JoinedAt = &now
-		existing.UpdatedAt = now
-		if err := uc.teamMemberRepo.Update(ctx, existing); err != nil {
-			return err
-		}
-	} else {
+	existing, _ := uc.teamMemberRepo.FindByUserAndTeam(ctx, userID, inv.TeamID)
+	if existing != nil && existing.IsActive() {
+		inv.Status = entity.InvitationStatusAccepted
+		_ = uc.invitationRepo.Update(ctx, inv)
+		return nil
+	}
+
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
 		now := time.Now()
-		member := &entity.TeamMember{
-			ID: uuid.New().String(), TeamID: inv.TeamID, UserID: userID,
-			RoleID: inv.RoleID, Status: entity.MemberStatusActive,
-			InvitedBy: &inv.InvitedBy, InvitedAt: inv.CreatedAt, JoinedAt: &now,
-			CreatedAt: now, UpdatedAt: now,
+		if existing != nil {
+			existing.RoleID = inv.RoleID
+			existing.Status = entity.MemberStatusActive
+			existing.JoinedAt = &now
+			existing.UpdatedAt = now
+			if err := uc.teamMemberRepo.Update(ctx, existing); err != nil {
+				return err
+			}
+		} else {
+			member := &entity.TeamMember{
+				ID: uuid.New().String(), TeamID: inv.TeamID, UserID: userID,
+				RoleID: inv.RoleID, Status: entity.MemberStatusActive,
+				InvitedBy: &inv.InvitedBy, InvitedAt: inv.CreatedAt, JoinedAt: &now,
+				CreatedAt: now, UpdatedAt: now,
+			}
+			if err := uc.teamMemberRepo.Create(ctx, member); err != nil {
+				return err
+			}
 		}
-		if err := uc.teamMemberRepo.Create(ctx, member); err != nil {
+
+		inv.Status = entity.InvitationStatusAccepted
+		inv.UpdatedAt = now
+		if err := uc.invitationRepo.Update(ctx, inv); err != nil {
 			return err
 		}
-	}
-
-	inv.Status = entity.InvitationStatusAccepted
-	inv.UpdatedAt = time.Now()
-	return uc.invitationRepo.Update(ctx, inv)
+		return uc.events.Record(ctx, &entity.DomainEvent{
+			Type: entity.DomainEventMemberJoined, AggregateType: "team", AggregateID: inv.TeamID, TeamID: inv.TeamID,
+		}, map[string]interface{}{
+			"team_id": inv.TeamID, "user_id": userID, "role_id": inv.RoleID, "joined_at": now.Format(time.RFC3339),
+		})
+	})
 }
 
 type UpdateMemberUseCase struct {
@@ -220,6 +247,9 @@ func NewUpdateMemberUseCase(
 }
 
 func (uc *UpdateMemberUseCase) Execute(ctx context.Context, teamID, targetUserID, actorID string, input dto.UpdateTeamMemberInput) error {
+	ctx, span := tracing.Start(ctx, "team.UpdateMember")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, actorID, teamID, entity.ScopeMembersManage)
 	if err != nil || !ok {
 		return errors.NewNotFoundError("team")
@@ -253,6 +283,9 @@ func NewRemoveMemberUseCase(teamMemberRepo repository.TeamMemberRepository, auth
 }
 
 func (uc *RemoveMemberUseCase) Execute(ctx context.Context, teamID, targetUserID, actorID string) error {
+	ctx, span := tracing.Start(ctx, "team.RemoveMember")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, actorID, teamID, entity.ScopeMembersManage)
 	if err != nil || !ok {
 		return errors.NewNotFoundError("team")
@@ -279,6 +312,9 @@ func NewListRolesUseCase(roleRepo repository.RoleRepository, authService *servic
 }
 
 func (uc *ListRolesUseCase) Execute(ctx context.Context, teamID, userID string) ([]*dto.RoleOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListRoles")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeRolesRead)
 	if err != nil || !ok {
 		return nil, errors.NewNotFoundError("team")
@@ -300,13 +336,17 @@ func (uc *ListRolesUseCase) Execute(ctx context.Context, teamID, userID string)
 type LinkStartupUseCase struct {
 	startupRepo repository.StartupRepository
 	authService *service.AuthorizationService
+	cache       port.PublicCache
 }
 
-func NewLinkStartupUseCase(startupRepo repository.StartupRepository, authService *service.AuthorizationService) *LinkStartupUseCase {
-	return &LinkStartupUseCase{startupRepo: startupRepo, authService: authService}
+func NewLinkStartupUseCase(startupRepo repository.StartupRepository, authService *service.AuthorizationService, cache port.PublicCache) *LinkStartupUseCase {
+	return &LinkStartupUseCase{startupRepo: startupRepo, authService: authService, cache: cache}
 }
 
 func (uc *LinkStartupUseCase) Execute(ctx context.Context, teamID, startupID, userID string) error {
+	ctx, span := tracing.Start(ctx, "team.LinkStartup")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeStartupManage)
 	if err != nil || !ok {
 		return errors.NewNotFoundError("team")
@@ -321,19 +361,27 @@ func (uc *LinkStartupUseCase) Execute(ctx context.Context, teamID, startupID, us
 	}
 	startup.TeamID = &teamID
 	startup.UpdatedAt = time.Now()
-	return uc.startupRepo.Update(ctx, startup)
+	if err := uc.startupRepo.Update(ctx, startup); err != nil {
+		return err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
+	return nil
 }
 
 type UnlinkStartupUseCase struct {
 	startupRepo repository.StartupRepository
 	authService *service.AuthorizationService
+	cache       port.PublicCache
 }
 
-func NewUnlinkStartupUseCase(startupRepo repository.StartupRepository, authService *service.AuthorizationService) *UnlinkStartupUseCase {
-	return &UnlinkStartupUseCase{startupRepo: startupRepo, authService: authService}
+func NewUnlinkStartupUseCase(startupRepo repository.StartupRepository, authService *service.AuthorizationService, cache port.PublicCache) *UnlinkStartupUseCase {
+	return &UnlinkStartupUseCase{startupRepo: startupRepo, authService: authService, cache: cache}
 }
 
 func (uc *UnlinkStartupUseCase) Execute(ctx context.Context, teamID, startupID, userID string) error {
+	ctx, span := tracing.Start(ctx, "team.UnlinkStartup")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeStartupManage)
 	if err != nil || !ok {
 		return errors.NewNotFoundError("team")
@@ -347,7 +395,11 @@ func (uc *UnlinkStartupUseCase) Execute(ctx context.Context, teamID, startupID,
 	}
 	startup.TeamID = nil
 	startup.UpdatedAt = time.Now()
-	return uc.startupRepo.Update(ctx, startup)
+	if err := uc.startupRepo.Update(ctx, startup); err != nil {
+		return err
+	}
+	uc.cache.Invalidate(port.CacheScopeStartups)
+	return nil
 }
 
 type ListTeamStartupsUseCase struct {
@@ -360,6 +412,9 @@ func NewListTeamStartupsUseCase(startupRepo repository.StartupRepository, authSe
 }
 
 func (uc *ListTeamStartupsUseCase) Execute(ctx context.Context, teamID, userID string) ([]*dto.StartupOutput, error) {
+	ctx, span := tracing.Start(ctx, "team.ListTeamStartups")
+	defer span.End()
+
 	ok, err := uc.authService.HasScope(ctx, userID, teamID, entity.ScopeStartupRead)
 	if err != nil || !ok {
 		return nil, errors.NewNotFoundError("team")
@@ -377,6 +432,10 @@ func (uc *ListTeamStartupsUseCase) Execute(ctx context.Context, teamID, userID s
 			AllowPublicJoin: s.AllowPublicJoin, Status: string(s.Status), Plan: s.Plan,
 			CreatedAt: s.CreatedAt.Format(time.RFC3339), UpdatedAt: s.UpdatedAt.Format(time.RFC3339),
 		}
+		if s.VerifiedAt != nil {
+			verifiedAt := s.VerifiedAt.Format(time.RFC3339)
+			out[i].Verified, out[i].VerifiedAt = true, &verifiedAt
+		}
 	}
 	return out, nil
 }
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+const (
+	deliveryBatch = 50
+	// deliveryLease keeps a claimed delivery from being picked up again while it is in flight.
+	deliveryLease = 2 * time.Minute
+	retryBase     = 30 * time.Second
+	retryMax      = 6 * time.Hour
+)
+
+// retryDelay is the exponential backoff after the given (1-based) failed attempt:
+// 30s, 1m, 2m, 4m, ... capped at 6h.
+func retryDelay(attempt int) time.Duration {
+	d := retryBase
+	for i := 1; i < attempt && d < retryMax; i++ {
+		d *= 2
+	}
+	if d > retryMax {
+		d = retryMax
+	}
+	return d
+}
+
+// DeliverWebhooksUseCase sends due deliveries and schedules retries. It is run
+// periodically by the scheduler.
+type DeliverWebhooksUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	sender       port.WebhookSender
+	maxAttempts  int
+	logger       logger.Logger
+}
+
+func NewDeliverWebhooksUseCase(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	sender port.WebhookSender,
+	maxAttempts int,
+	logger logger.Logger,
+) *DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCase{
+		endpointRepo: endpointRepo, deliveryRepo: deliveryRepo, sender: sender,
+		maxAttempts: maxAttempts, logger: logger,
+	}
+}
+
+func (uc *DeliverWebhooksUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "webhook.DeliverWebhooks")
+	defer span.End()
+
+	now := time.Now()
+	deliveries, err := uc.deliveryRepo.ClaimDue(ctx, now, now.Add(deliveryLease), deliveryBatch)
+	if err != nil {
+		return err
+	}
+	for _, d := range deliveries {
+		uc.attempt(ctx, d)
+	}
+	return nil
+}
+
+func (uc *DeliverWebhooksUseCase) attempt(ctx context.Context, d *entity.WebhookDelivery) {
+	endpoint, err := uc.endpointRepo.FindByID(ctx, d.EndpointID)
+	if err != nil {
+		uc.logger.WithContext(ctx).Error("Webhooks: failed to load endpoint %s: %v", d.EndpointID, err)
+		return // lease expires and the delivery is retried
+	}
+
+	now := time.Now()
+	d.UpdatedAt = now
+	if endpoint == nil || !endpoint.Active {
+		d.Status = entity.WebhookDeliveryFailed
+		d.LastError = "endpoint disabled"
+		uc.save(ctx, d)
+		return
+	}
+
+	d.Attempts++
+	resp, err := uc.sender.Send(ctx, endpoint.URL, endpoint.Secret, d)
+	d.ResponseStatus, d.ResponseBody, d.LastError = nil, "", ""
+	if err != nil {
+		d.LastError = err.Error()
+	} else {
+		status := resp.StatusCode
+		d.ResponseStatus, d.ResponseBody = &status, resp.Body
+	}
+
+	switch {
+	case err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300:
+		d.Status = entity.WebhookDeliverySucceeded
+		d.DeliveredAt = &now
+	case d.Attempts >= uc.maxAttempts:
+		d.Status = entity.WebhookDeliveryFailed
+		uc.logger.WithContext(ctx).Warn("Webhooks: delivery %s to endpoint %s failed after %d attempts", d.ID, endpoint.ID, d.Attempts)
+	default:
+		d.NextAttemptAt = now.Add(retryDelay(d.Attempts))
+	}
+	uc.save(ctx, d)
+}
+
+func (uc *DeliverWebhooksUseCase) save(ctx context.Context, d *entity.WebhookDelivery) {
+	if err := uc.deliveryRepo.Update(ctx, d); err != nil {
+		uc.logger.WithContext(ctx).Error("Webhooks: failed to record delivery %s: %v", d.ID, err)
+	}
+}
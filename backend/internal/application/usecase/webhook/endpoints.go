@@ -0,0 +1,308 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/domain/service"
+	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/ssrf"
+	"github.com/startup-job-board/backend/pkg/tracing"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+const (
+	maxEndpointsPerTeam = 10
+	deliveryLogLimit    = 100
+	secretPrefix        = "whsec_"
+)
+
+// Endpoints carry the team's signing secret and delivery payloads, so every
+// operation requires team:manage.
+func requireTeamManage(ctx context.Context, authService *service.AuthorizationService, teamID, userID string) error {
+	ok, err := authService.HasScope(ctx, userID, teamID, entity.ScopeTeamManage)
+	if err != nil || !ok {
+		return errors.NewNotFoundError("team")
+	}
+	return nil
+}
+
+func loadTeamEndpoint(ctx context.Context, endpointRepo repository.WebhookEndpointRepository, teamID, endpointID string) (*entity.WebhookEndpoint, error) {
+	endpoint, err := endpointRepo.FindByID(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil || endpoint.TeamID != teamID {
+		return nil, errors.NewNotFoundError("webhook")
+	}
+	return endpoint, nil
+}
+
+// validateEndpointURL requires https and a host that resolves to public addresses only.
+func validateEndpointURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" {
+		return errors.NewBadRequestError("webhook url must be an https URL")
+	}
+	if err := ssrf.ValidatePublicHTTPURL(ctx, raw); err != nil {
+		return errors.NewBadRequestError("webhook url must resolve to a public address")
+	}
+	return nil
+}
+
+func parseEvents(raw []string) []entity.WebhookEvent {
+	events := make([]entity.WebhookEvent, 0, len(raw))
+	seen := map[entity.WebhookEvent]bool{}
+	for _, e := range raw {
+		ev := entity.WebhookEvent(e)
+		if ev.IsValid() && !seen[ev] {
+			seen[ev] = true
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+type ListEndpointsUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	authService  *service.AuthorizationService
+}
+
+func NewListEndpointsUseCase(endpointRepo repository.WebhookEndpointRepository, authService *service.AuthorizationService) *ListEndpointsUseCase {
+	return &ListEndpointsUseCase{endpointRepo: endpointRepo, authService: authService}
+}
+
+func (uc *ListEndpointsUseCase) Execute(ctx context.Context, teamID, userID string) ([]*dto.WebhookEndpointOutput, error) {
+	ctx, span := tracing.Start(ctx, "webhook.ListEndpoints")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return nil, err
+	}
+	endpoints, err := uc.endpointRepo.ListByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.WebhookEndpointOutput, len(endpoints))
+	for i, ep := range endpoints {
+		out[i] = toEndpointOutput(ep)
+	}
+	return out, nil
+}
+
+type CreateEndpointUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	authService  *service.AuthorizationService
+}
+
+func NewCreateEndpointUseCase(endpointRepo repository.WebhookEndpointRepository, authService *service.AuthorizationService) *CreateEndpointUseCase {
+	return &CreateEndpointUseCase{endpointRepo: endpointRepo, authService: authService}
+}
+
+func (uc *CreateEndpointUseCase) Execute(ctx context.Context, teamID, userID string, input dto.CreateWebhookEndpointInput) (*dto.WebhookEndpointOutput, error) {
+	ctx, span := tracing.Start(ctx, "webhook.CreateEndpoint")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return nil, err
+	}
+	existing, err := uc.endpointRepo.ListByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxEndpointsPerTeam {
+		return nil, errors.NewBadRequestError("webhook endpoint limit reached")
+	}
+	if err := validateEndpointURL(ctx, input.URL); err != nil {
+		return nil, err
+	}
+
+	secret, err := utils.RandomHex(24)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	endpoint := &entity.WebhookEndpoint{
+		ID: uuid.New().String(), TeamID: teamID, URL: input.URL, Secret: secretPrefix + secret,
+		Events: parseEvents(input.Events), Active: true, CreatedBy: userID, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := uc.endpointRepo.Create(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	out := toEndpointOutput(endpoint)
+	out.Secret = endpoint.Secret // shown once
+	return out, nil
+}
+
+type UpdateEndpointUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	authService  *service.AuthorizationService
+}
+
+func NewUpdateEndpointUseCase(endpointRepo repository.WebhookEndpointRepository, authService *service.AuthorizationService) *UpdateEndpointUseCase {
+	return &UpdateEndpointUseCase{endpointRepo: endpointRepo, authService: authService}
+}
+
+func (uc *UpdateEndpointUseCase) Execute(ctx context.Context, teamID, endpointID, userID string, input dto.UpdateWebhookEndpointInput) (*dto.WebhookEndpointOutput, error) {
+	ctx, span := tracing.Start(ctx, "webhook.UpdateEndpoint")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return nil, err
+	}
+	endpoint, err := loadTeamEndpoint(ctx, uc.endpointRepo, teamID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if input.URL != nil {
+		if err := validateEndpointURL(ctx, *input.URL); err != nil {
+			return nil, err
+		}
+		endpoint.URL = *input.URL
+	}
+	if len(input.Events) > 0 {
+		endpoint.Events = parseEvents(input.Events)
+	}
+	if input.Active != nil {
+		endpoint.Active = *input.Active
+	}
+	endpoint.UpdatedAt = time.Now()
+	if err := uc.endpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return toEndpointOutput(endpoint), nil
+}
+
+type DeleteEndpointUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	authService  *service.AuthorizationService
+}
+
+func NewDeleteEndpointUseCase(endpointRepo repository.WebhookEndpointRepository, authService *service.AuthorizationService) *DeleteEndpointUseCase {
+	return &DeleteEndpointUseCase{endpointRepo: endpointRepo, authService: authService}
+}
+
+func (uc *DeleteEndpointUseCase) Execute(ctx context.Context, teamID, endpointID, userID string) error {
+	ctx, span := tracing.Start(ctx, "webhook.DeleteEndpoint")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return err
+	}
+	endpoint, err := loadTeamEndpoint(ctx, uc.endpointRepo, teamID, endpointID)
+	if err != nil {
+		return err
+	}
+	return uc.endpointRepo.Delete(ctx, endpoint.ID)
+}
+
+type ListDeliveriesUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	authService  *service.AuthorizationService
+}
+
+func NewListDeliveriesUseCase(endpointRepo repository.WebhookEndpointRepository, deliveryRepo repository.WebhookDeliveryRepository, authService *service.AuthorizationService) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{endpointRepo: endpointRepo, deliveryRepo: deliveryRepo, authService: authService}
+}
+
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, teamID, endpointID, userID string) ([]*dto.WebhookDeliveryOutput, error) {
+	ctx, span := tracing.Start(ctx, "webhook.ListDeliveries")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return nil, err
+	}
+	if _, err := loadTeamEndpoint(ctx, uc.endpointRepo, teamID, endpointID); err != nil {
+		return nil, err
+	}
+	deliveries, err := uc.deliveryRepo.ListByEndpointID(ctx, endpointID, deliveryLogLimit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.WebhookDeliveryOutput, len(deliveries))
+	for i, d := range deliveries {
+		out[i] = toDeliveryOutput(d)
+	}
+	return out, nil
+}
+
+// RedeliverUseCase queues a fresh copy of a past delivery (same payload and
+// event id) for immediate sending; the original log entry is kept as-is.
+type RedeliverUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	authService  *service.AuthorizationService
+}
+
+func NewRedeliverUseCase(endpointRepo repository.WebhookEndpointRepository, deliveryRepo repository.WebhookDeliveryRepository, authService *service.AuthorizationService) *RedeliverUseCase {
+	return &RedeliverUseCase{endpointRepo: endpointRepo, deliveryRepo: deliveryRepo, authService: authService}
+}
+
+func (uc *RedeliverUseCase) Execute(ctx context.Context, teamID, endpointID, deliveryID, userID string) (*dto.WebhookDeliveryOutput, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Redeliver")
+	defer span.End()
+
+	if err := requireTeamManage(ctx, uc.authService, teamID, userID); err != nil {
+		return nil, err
+	}
+	endpoint, err := loadTeamEndpoint(ctx, uc.endpointRepo, teamID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if !endpoint.Active {
+		return nil, errors.NewBadRequestError("webhook endpoint is disabled")
+	}
+	original, err := uc.deliveryRepo.FindByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil || original.EndpointID != endpoint.ID {
+		return nil, errors.NewNotFoundError("delivery")
+	}
+
+	now := time.Now()
+	delivery := &entity.WebhookDelivery{
+		ID: uuid.New().String(), EndpointID: endpoint.ID, Event: original.Event, Payload: original.Payload,
+		Status: entity.WebhookDeliveryPending, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := uc.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return toDeliveryOutput(delivery), nil
+}
+
+func toEndpointOutput(ep *entity.WebhookEndpoint) *dto.WebhookEndpointOutput {
+	events := make([]string, len(ep.Events))
+	for i, e := range ep.Events {
+		events[i] = string(e)
+	}
+	return &dto.WebhookEndpointOutput{
+		ID: ep.ID, TeamID: ep.TeamID, URL: ep.URL, Events: events, Active: ep.Active,
+		CreatedAt: ep.CreatedAt.Format(time.RFC3339), UpdatedAt: ep.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toDeliveryOutput(d *entity.WebhookDelivery) *dto.WebhookDeliveryOutput {
+	out := &dto.WebhookDeliveryOutput{
+		ID: d.ID, EndpointID: d.EndpointID, Event: string(d.Event), Status: string(d.Status),
+		Attempts: d.Attempts, ResponseStatus: d.ResponseStatus, ResponseBody: d.ResponseBody,
+		LastError: d.LastError, CreatedAt: d.CreatedAt.Format(time.RFC3339),
+		Payload: json.RawMessage(d.Payload),
+	}
+	if d.Status == entity.WebhookDeliveryPending {
+		next := d.NextAttemptAt.Format(time.RFC3339)
+		out.NextAttemptAt = &next
+	}
+	if d.DeliveredAt != nil {
+		delivered := d.DeliveredAt.Format(time.RFC3339)
+		out.DeliveredAt = &delivered
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+)
+
+// eventEnvelope is the JSON body every endpoint receives. ID is shared by all
+// deliveries of the same event so receivers can de-duplicate.
+type eventEnvelope struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	TeamID    string      `json:"team_id"`
+	CreatedAt string      `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// webhookEvents maps the domain events teams can receive as webhooks.
+var webhookEvents = map[entity.DomainEventType]entity.WebhookEvent{
+	entity.DomainEventJobCreated:         entity.WebhookEventJobCreated,
+	entity.DomainEventJobUpdated:         entity.WebhookEventJobUpdated,
+	entity.DomainEventJobClosed:          entity.WebhookEventJobClosed,
+	entity.DomainEventJobBoosted:         entity.WebhookEventJobBoosted,
+	entity.DomainEventMemberJoined:       entity.WebhookEventMemberJoined,
+	entity.DomainEventStartupPlanChanged: entity.WebhookEventPlanChanged,
+}
+
+// PublishEventUseCase subscribes to domain events and queues one delivery
+// per subscribed endpoint; DeliverWebhooksUseCase sends them.
+type PublishEventUseCase struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	startupRepo  repository.StartupRepository
+	tx           port.TxManager
+}
+
+func NewPublishEventUseCase(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	startupRepo repository.StartupRepository,
+	tx port.TxManager,
+) *PublishEventUseCase {
+	return &PublishEventUseCase{endpointRepo: endpointRepo, deliveryRepo: deliveryRepo, startupRepo: startupRepo, tx: tx}
+}
+
+// EventTypes lists the domain events HandleEvent turns into webhooks.
+func (uc *PublishEventUseCase) EventTypes() []entity.DomainEventType {
+	types := make([]entity.DomainEventType, 0, len(webhookEvents))
+	for t := range webhookEvents {
+		types = append(types, t)
+	}
+	return types
+}
+
+// HandleEvent is a port.EventHandler. Events are routed to the team they
+// name, else to the team of their startup; unlinked startups are skipped.
+// The envelope ID is the event ID, so a redelivered event reaches
+// receivers with the ID they have already seen.
+func (uc *PublishEventUseCase) HandleEvent(ctx context.Context, event *entity.DomainEvent) error {
+	webhookEvent, ok := webhookEvents[event.Type]
+	if !ok {
+		return nil
+	}
+	teamID := event.TeamID
+	if teamID == "" && event.StartupID != "" {
+		startup, err := uc.startupRepo.FindByID(ctx, event.StartupID)
+		if err != nil {
+			return err
+		}
+		if startup == nil || startup.TeamID == nil {
+			return nil
+		}
+		teamID = *startup.TeamID
+	}
+	if teamID == "" {
+		return nil
+	}
+	// All of an event's deliveries are queued together, so a retry after a
+	// failure does not send duplicates to the endpoints that succeeded.
+	return uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return uc.publish(ctx, teamID, webhookEvent, event)
+	})
+}
+
+func (uc *PublishEventUseCase) publish(ctx context.Context, teamID string, webhookEvent entity.WebhookEvent, event *entity.DomainEvent) error {
+	endpoints, err := uc.endpointRepo.ListByTeamID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	now := time.Now()
+	for _, ep := range endpoints {
+		if !ep.Active || !ep.Subscribes(webhookEvent) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(eventEnvelope{
+				ID: event.ID, Type: string(webhookEvent), TeamID: teamID,
+				CreatedAt: event.OccurredAt.Format(time.RFC3339), Data: json.RawMessage(event.Payload),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if err := uc.deliveryRepo.Create(ctx, &entity.WebhookDelivery{
+			ID: uuid.New().String(), EndpointID: ep.ID, Event: webhookEvent, Payload: string(payload),
+			Status: entity.WebhookDeliveryPending, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
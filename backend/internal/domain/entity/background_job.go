@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+type BackgroundJobStatus string
+
+const (
+	BackgroundJobQueued    BackgroundJobStatus = "queued"
+	BackgroundJobRunning   BackgroundJobStatus = "running"
+	BackgroundJobSucceeded BackgroundJobStatus = "succeeded"
+	BackgroundJobDead      BackgroundJobStatus = "dead" // gave up after the maximum attempts
+	BackgroundJobCancelled BackgroundJobStatus = "cancelled"
+)
+
+// BackgroundJob is one unit of deferred work in the Postgres-backed queue.
+// Kind selects the handler and Payload is its JSON arguments.
+type BackgroundJob struct {
+	ID          string
+	Queue       string
+	Kind        string
+	Payload     string
+	Status      BackgroundJobStatus
+	Attempts    int
+	MaxAttempts int
+	// RunAt is when the job is next due: the scheduled time, or the retry
+	// time after a failed attempt.
+	RunAt time.Time
+	// UniqueKey, when set, rejects a second queued or running job with the
+	// same key.
+	UniqueKey *string
+	LastError string
+	// LockedUntil is the lease of the worker running the job; a running job
+	// past it is assumed lost and claimed again.
+	LockedUntil *time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsActive reports whether the job may still run.
+func (j *BackgroundJob) IsActive() bool {
+	return j.Status == BackgroundJobQueued || j.Status == BackgroundJobRunning
+}
+
+// CanRetry reports whether an admin may queue the job again.
+func (j *BackgroundJob) CanRetry() bool {
+	return j.Status == BackgroundJobDead || j.Status == BackgroundJobCancelled
+}
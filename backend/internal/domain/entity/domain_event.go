@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+type DomainEventType string
+
+const (
+	DomainEventJobCreated         DomainEventType = "job.created"
+	DomainEventJobUpdated         DomainEventType = "job.updated"
+	DomainEventJobClosed          DomainEventType = "job.closed"
+	DomainEventJobDeleted         DomainEventType = "job.deleted"
+	DomainEventJobBoosted         DomainEventType = "job.boosted"
+	DomainEventStartupPlanChanged DomainEventType = "startup.plan_changed"
+	DomainEventMemberInvited      DomainEventType = "member.invited"
+	DomainEventMemberJoined       DomainEventType = "member.joined"
+)
+
+// DomainEvent records a change to an aggregate. Use cases append it to the
+// outbox in the same transaction as the change; the relay then hands it to
+// every subscriber at least once.
+type DomainEvent struct {
+	ID            string
+	Type          DomainEventType
+	AggregateType string // "job", "startup", "team"
+	AggregateID   string
+	// StartupID and TeamID let subscribers such as webhooks route the event
+	// without decoding it; either may be empty.
+	StartupID  string
+	TeamID     string
+	Payload    string // JSON
+	OccurredAt time.Time
+}
+
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "pending"
+	OutboxProcessed OutboxStatus = "processed"
+	OutboxFailed    OutboxStatus = "failed" // gave up after the maximum attempts
+)
+
+// OutboxEvent is a DomainEvent with its dispatch state.
+type OutboxEvent struct {
+	DomainEvent
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	ProcessedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
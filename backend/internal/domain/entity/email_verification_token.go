@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// EmailVerificationToken is a single-use link token proving a user owns their
+// email address. Only the hash is stored; the plain token is in the email.
+type EmailVerificationToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (t *EmailVerificationToken) IsValid(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -10,8 +10,33 @@ type File struct {
 	StorageKey string
 	URL        string
 	UploadedBy string
+	Variants   []FileVariant
 	CreatedAt  time.Time
 }
 
+// FileVariant is a resized rendition of an image stored next to the original.
+type FileVariant struct {
+	ID         string
+	FileID     string
+	Size       int
+	Format     string
+	MimeType   string
+	StorageKey string
+	URL        string
+}
+
+// FileReferenceType names what points at a file. Files nothing references
+// are garbage collected once they are older than the grace period.
+type FileReferenceType string
 
+const (
+	FileRefStartupLogo FileReferenceType = "startup_logo"
+)
 
+type FileReference struct {
+	ID        string
+	FileID    string
+	OwnerType FileReferenceType
+	OwnerID   string
+	CreatedAt time.Time
+}
@@ -12,6 +12,7 @@ type Invitation struct {
 	ExpiresAt  time.Time
 	AcceptedAt *time.Time
 	Status     InvitationStatus
+	Locale     string // language of the invitation email
 	CreatedAt  time.Time
 }
 
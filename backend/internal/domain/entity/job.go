@@ -17,9 +17,12 @@ type Job struct {
 	Currency        string
 	ApplicationURL  *string
 	ApplicationEmail *string
+	ApplyRelayToken string // public apply+<token>@relay address; masks ApplicationEmail
 	Status          JobStatus
 	ExpiresAt       *time.Time
 	BoostedUntil    *time.Time
+	ExpiryReminderSentAt *time.Time
+	StaleReminderSentAt  *time.Time
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -46,7 +49,29 @@ type JobStatus string
 const (
 	JobStatusActive JobStatus = "active"
 	JobStatusClosed JobStatus = "closed"
+	JobStatusFilled JobStatus = "filled"
 )
 
+// JobReminderKind identifies the owner reminder emails sent for a listing.
+type JobReminderKind string
+
+const (
+	JobReminderExpiring JobReminderKind = "expiring"
+	JobReminderStale    JobReminderKind = "stale"
+)
+
+// JobAction is a one-click owner action carried by a signed email link.
+type JobAction string
+
+const (
+	JobActionExtend JobAction = "extend"
+	JobActionFill   JobAction = "fill"
+	JobActionClose  JobAction = "close"
+)
+
+func (a JobAction) IsValid() bool {
+	return a == JobActionExtend || a == JobActionFill || a == JobActionClose
+}
+
 
 
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// PasswordResetToken is a single-use token from a forgot-password email.
+// Only the hash is stored; the plain token is in the email.
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (t *PasswordResetToken) IsValid(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// SlugOwnerType names the kind of entity a slug belongs to. Slugs are unique
+// per owner type, so a startup and a team may share one.
+type SlugOwnerType string
+
+const (
+	SlugOwnerStartup SlugOwnerType = "startup"
+	SlugOwnerTeam    SlugOwnerType = "team"
+)
+
+// SlugHistory records a slug an entity used to have. Old slugs keep resolving
+// to their owner and are never handed to another entity of the same type.
+type SlugHistory struct {
+	ID        string
+	OwnerType SlugOwnerType
+	OwnerID   string
+	Slug      string
+	CreatedAt time.Time
+}
@@ -14,7 +14,6 @@ type Startup struct {
 	CompanySize     string
 	Location        string
 	SocialLinks     SocialLinks
-	APIToken        string
 	AllowPublicJoin bool
 	JoinCode        *string
 	Status          StartupStatus
@@ -24,6 +23,8 @@ type Startup struct {
 	PlanExpiresAt        *time.Time
 	StripeCustomerID     *string
 	StripeSubscriptionID *string
+	// VerifiedAt is set while the website domain is proven (see StartupVerification).
+	VerifiedAt *time.Time
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	DeletedAt            *time.Time
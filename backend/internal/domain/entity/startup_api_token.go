@@ -0,0 +1,60 @@
+package entity
+
+import "time"
+
+// APITokenPrefixLen is how much of the plaintext token is kept for display.
+const APITokenPrefixLen = 8
+
+// DefaultAPITokenName names the token issued with a new startup.
+const DefaultAPITokenName = "default"
+
+// APITokenScopes is the subset of scopes a startup API token may carry.
+func APITokenScopes() []Scope {
+	return []Scope{ScopeJobsRead, ScopeJobsWrite, ScopeJobsDelete}
+}
+
+// IsAPITokenScope reports whether s can be granted to an API token.
+func IsAPITokenScope(s Scope) bool {
+	for _, allowed := range APITokenScopes() {
+		if s == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// StartupAPIToken is one named machine credential for a startup. Only the
+// SHA-256 of the token is stored; Prefix is kept so users can tell tokens apart.
+type StartupAPIToken struct {
+	ID         string
+	StartupID  string
+	Name       string
+	TokenHash  string
+	Prefix     string
+	Scopes     []Scope
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedBy  *string
+	CreatedAt  time.Time
+	// StartupPlan is the owning startup's plan, filled in by FindByHash so
+	// request authentication also yields the token's quota.
+	StartupPlan string
+}
+
+// IsActive reports whether the token is neither revoked nor expired at now.
+func (t *StartupAPIToken) IsActive(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
+
+func (t *StartupAPIToken) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
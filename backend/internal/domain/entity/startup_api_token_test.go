@@ -0,0 +1,39 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+func TestStartupAPITokenIsActive(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	if !(&entity.StartupAPIToken{}).IsActive(now) {
+		t.Fatal("token without expiry should be active")
+	}
+	if !(&entity.StartupAPIToken{ExpiresAt: &future}).IsActive(now) {
+		t.Fatal("unexpired token should be active")
+	}
+	if (&entity.StartupAPIToken{ExpiresAt: &past}).IsActive(now) {
+		t.Fatal("expired token must not be active")
+	}
+	if (&entity.StartupAPIToken{RevokedAt: &past}).IsActive(now) {
+		t.Fatal("revoked token must not be active")
+	}
+}
+
+func TestAPITokenScopesAreJobsOnly(t *testing.T) {
+	if !entity.IsAPITokenScope(entity.ScopeJobsDelete) {
+		t.Fatal("jobs:delete should be grantable to tokens")
+	}
+	if entity.IsAPITokenScope(entity.ScopeBillingManage) {
+		t.Fatal("billing:manage must not be grantable to tokens")
+	}
+	token := &entity.StartupAPIToken{Scopes: []entity.Scope{entity.ScopeJobsRead}}
+	if token.HasScope(entity.ScopeJobsWrite) {
+		t.Fatal("read-only token must not have jobs:write")
+	}
+}
@@ -0,0 +1,79 @@
+package entity
+
+import "time"
+
+// ClaimMethod is how a claimant proves affiliation with an orphan startup.
+type ClaimMethod string
+
+const (
+	// ClaimMethodEmailDomain: a code is mailed to the claimant's account
+	// address, which must be on the startup's website domain.
+	ClaimMethodEmailDomain ClaimMethod = "email_domain"
+	// ClaimMethodWebsite: a token is published in DNS or the well-known file.
+	ClaimMethodWebsite ClaimMethod = "website"
+	// ClaimMethodManual: a platform admin reviews the request.
+	ClaimMethodManual ClaimMethod = "manual"
+)
+
+func (m ClaimMethod) IsValid() bool {
+	return m == ClaimMethodEmailDomain || m == ClaimMethodWebsite || m == ClaimMethodManual
+}
+
+type ClaimStatus string
+
+const (
+	ClaimStatusPending  ClaimStatus = "pending"
+	ClaimStatusApproved ClaimStatus = "approved"
+	ClaimStatusRejected ClaimStatus = "rejected"
+)
+
+// StartupClaim is a request by a user to take over an admin-curated startup
+// that has no team.
+type StartupClaim struct {
+	ID        string
+	StartupID string
+	UserID    string
+	// TeamID is the claimant's team to link; nil creates a new team on approval.
+	TeamID  *string
+	Method  ClaimMethod
+	Status  ClaimStatus
+	Domain  string
+	Message string
+	// Token is published for the website method.
+	Token string
+	// Email / CodeHash / CodeAttempts are used by the email_domain method.
+	Email           string
+	CodeHash        string
+	CodeAttempts    int
+	CodeExpiresAt   *time.Time
+	RejectionReason string
+	ReviewedBy      *string
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (c *StartupClaim) IsPending() bool {
+	return c.Status == ClaimStatusPending
+}
+
+type ClaimEventAction string
+
+const (
+	ClaimEventSubmitted          ClaimEventAction = "submitted"
+	ClaimEventCodeSent           ClaimEventAction = "code_sent"
+	ClaimEventVerificationFailed ClaimEventAction = "verification_failed"
+	ClaimEventApproved           ClaimEventAction = "approved"
+	ClaimEventRejected           ClaimEventAction = "rejected"
+)
+
+// StartupClaimEvent is one audit-trail entry for a claim. ActorID is nil for
+// system actions (automatic verification, superseded claims).
+type StartupClaimEvent struct {
+	ID        string
+	ClaimID   string
+	ActorID   *string
+	Action    ClaimEventAction
+	Note      string
+	CreatedAt time.Time
+}
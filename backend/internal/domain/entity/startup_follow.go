@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// StartupFollow records a user tracking a startup for new-job notifications.
+type StartupFollow struct {
+	ID        string
+	UserID    string
+	StartupID string
+	CreatedAt time.Time
+}
+
+// FollowNotification is a queued "new job" item for one follower, delivered
+// in the next email digest.
+type FollowNotification struct {
+	ID        string
+	UserID    string
+	StartupID string
+	JobID     string
+	CreatedAt time.Time
+	SentAt    *time.Time
+}
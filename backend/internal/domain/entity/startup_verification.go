@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// Where proof of control is published for the dns and file methods.
+const (
+	VerificationTXTPrefix     = "joinus-verification=" // TXT record value on the apex domain
+	VerificationWellKnownPath = "/.well-known/joinus-verification.txt"
+)
+
+type VerificationMethod string
+
+const (
+	VerificationMethodDNS   VerificationMethod = "dns"
+	VerificationMethodFile  VerificationMethod = "file"
+	VerificationMethodEmail VerificationMethod = "email"
+)
+
+func (m VerificationMethod) IsValid() bool {
+	return m == VerificationMethodDNS || m == VerificationMethodFile || m == VerificationMethodEmail
+}
+
+// Rechecked reports whether proof of control can be re-checked without the
+// user (DNS records and well-known files can; an emailed code can't).
+func (m VerificationMethod) Rechecked() bool {
+	return m == VerificationMethodDNS || m == VerificationMethodFile
+}
+
+// StartupVerification is the current proof-of-control challenge for a
+// startup's website domain (one per startup).
+type StartupVerification struct {
+	ID        string
+	StartupID string
+	Domain    string
+	Method    VerificationMethod
+	// Token is the public value published in DNS or the well-known file.
+	Token string
+	// Email / CodeHash are only used by the email method; the code itself is never stored.
+	Email         string
+	CodeHash      string
+	ExpiresAt     time.Time // challenge deadline while pending
+	VerifiedAt    *time.Time
+	LastCheckedAt *time.Time
+	Failures      int // consecutive failed re-checks
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (v *StartupVerification) IsVerified() bool {
+	return v.VerifiedAt != nil
+}
@@ -57,6 +57,8 @@ type TeamInvitation struct {
 	InvitedBy string
 	Status    InvitationStatus
 	ExpiresAt time.Time
+	// Locale is the language the invitation email is sent in.
+	Locale    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
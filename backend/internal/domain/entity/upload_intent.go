@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// UploadIntent tracks a presigned direct-to-storage upload from the moment
+// the policy is issued until the client reports completion.
+type UploadIntent struct {
+	ID          string
+	UserID      string
+	StorageKey  string
+	FileName    string
+	ContentType string
+	MaxSize     int64
+	ExpiresAt   time.Time // the storage policy stops accepting the upload
+	FileID      *string   // set once completed
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+func (u *UploadIntent) IsCompleted() bool {
+	return u.CompletedAt != nil
+}
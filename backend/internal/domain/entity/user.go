@@ -11,10 +11,20 @@ type User struct {
 	StartupID *string
 	Status       UserStatus
 	TokenVersion int
+	// EmailVerifiedAt is set once the user proves they own Email, by a
+	// verification link or an OAuth provider that vouches for it.
+	EmailVerifiedAt *time.Time
+	// Locale picks the language of the user's emails, e.g. "de" or "pt-BR";
+	// empty means the default.
+	Locale    string
 	CreatedAt    time.Time
 	UpdatedAt time.Time
 }
 
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
 type UserRole string
 
 const (
@@ -0,0 +1,81 @@
+package entity
+
+import "time"
+
+type WebhookEvent string
+
+const (
+	WebhookEventJobCreated   WebhookEvent = "job.created"
+	WebhookEventJobUpdated   WebhookEvent = "job.updated"
+	WebhookEventJobClosed    WebhookEvent = "job.closed"
+	WebhookEventJobBoosted   WebhookEvent = "job.boosted"
+	WebhookEventMemberJoined WebhookEvent = "member.joined"
+	WebhookEventPlanChanged  WebhookEvent = "plan.changed"
+)
+
+// WebhookEvents returns every event type endpoints can subscribe to.
+func WebhookEvents() []WebhookEvent {
+	return []WebhookEvent{
+		WebhookEventJobCreated, WebhookEventJobUpdated, WebhookEventJobClosed,
+		WebhookEventJobBoosted, WebhookEventMemberJoined, WebhookEventPlanChanged,
+	}
+}
+
+func (e WebhookEvent) IsValid() bool {
+	for _, known := range WebhookEvents() {
+		if e == known {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEndpoint is a team-owned URL that receives signed event deliveries.
+type WebhookEndpoint struct {
+	ID     string
+	TeamID string
+	URL    string
+	// Secret is the HMAC-SHA256 signing key; it is shown to the team once.
+	Secret    string
+	Events    []WebhookEvent
+	Active    bool
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (e *WebhookEndpoint) Subscribes(event WebhookEvent) bool {
+	for _, s := range e.Events {
+		if s == event {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent (or to be sent) to one endpoint, kept as
+// the delivery log. Retries update the same row; manual redelivery creates a new one.
+type WebhookDelivery struct {
+	ID            string
+	EndpointID    string
+	Event         WebhookEvent
+	Payload       string
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// Result of the most recent attempt.
+	ResponseStatus *int
+	ResponseBody   string
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
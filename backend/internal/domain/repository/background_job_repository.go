@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type BackgroundJobFilter struct {
+	Queue  string
+	Kind   string
+	Status entity.BackgroundJobStatus
+}
+
+type BackgroundJobRepository interface {
+	// Create stores a queued job. It returns false, storing nothing, when an
+	// active job already holds the job's unique key.
+	Create(ctx context.Context, job *entity.BackgroundJob) (bool, error)
+	FindByID(ctx context.Context, id string) (*entity.BackgroundJob, error)
+	List(ctx context.Context, filter BackgroundJobFilter, page, pageSize int) ([]*entity.BackgroundJob, int64, error)
+	// ClaimDue marks up to limit jobs of the queue as running, leased until
+	// lockUntil, and counts the attempt. It takes queued jobs due at now and
+	// running jobs whose lease has expired, oldest first; concurrent workers
+	// never claim the same job.
+	ClaimDue(ctx context.Context, queue string, now, lockUntil time.Time, limit int) ([]*entity.BackgroundJob, error)
+	// Finish records the outcome of the attempt the job was claimed for. It
+	// returns false when the job was cancelled or claimed again meanwhile.
+	Finish(ctx context.Context, job *entity.BackgroundJob) (bool, error)
+	// Requeue makes a dead or cancelled job due at runAt with fresh attempts.
+	// It returns false when the job is in another state or an active job
+	// holds its unique key.
+	Requeue(ctx context.Context, id string, runAt time.Time) (bool, error)
+	// Cancel stops a queued or running job from running again.
+	Cancel(ctx context.Context, id string) (bool, error)
+	// DeleteFinishedBefore removes succeeded and cancelled jobs that finished
+	// before the cutoff; dead jobs are kept for inspection.
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type EmailVerificationTokenRepository interface {
+	Create(ctx context.Context, token *entity.EmailVerificationToken) error
+	// FindByTokenHash returns nil, nil when no token matches.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error)
+	// FindLatestByUser returns the user's most recently issued token, or nil, nil.
+	FindLatestByUser(ctx context.Context, userID string) (*entity.EmailVerificationToken, error)
+	// MarkUsed fails when the token was already used.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	DeleteExpired(ctx context.Context) error
+}
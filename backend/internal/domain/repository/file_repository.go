@@ -2,14 +2,32 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/domain/entity"
 )
 
 type FileRepository interface {
 	Create(ctx context.Context, file *entity.File) error
 	FindByID(ctx context.Context, id string) (*entity.File, error)
+	// FindByURL matches the original or any variant URL; nil, nil if none does.
+	FindByURL(ctx context.Context, url string) (*entity.File, error)
+	ListByUploaders(ctx context.Context, userIDs []string, page, pageSize int) ([]*entity.File, int64, error)
+	// ListUnreferenced returns files created before the cutoff that no
+	// FileReference points at, oldest first.
+	ListUnreferenced(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.File, error)
+	// UnknownKeys returns the subset of keys no file, variant or upload
+	// intent refers to.
+	UnknownKeys(ctx context.Context, keys []string) ([]string, error)
 	Delete(ctx context.Context, id string) error
 }
 
-
-
+type FileReferenceRepository interface {
+	// Add records a reference; adding the same one twice is a no-op.
+	Add(ctx context.Context, ref *entity.FileReference) error
+	DeleteByOwner(ctx context.Context, ownerType entity.FileReferenceType, ownerID string) error
+	ExistsForFile(ctx context.Context, fileID string) (bool, error)
+	// ReconcileStartupLogos adds references for startup logos that point at a
+	// stored file but have none, e.g. logos set before tracking existed.
+	ReconcileStartupLogos(ctx context.Context) (int64, error)
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/domain/entity"
 )
 
@@ -10,8 +12,13 @@ type JobRepository interface {
 	Update(ctx context.Context, job *entity.Job) error
 	Delete(ctx context.Context, id string) error
 	FindByID(ctx context.Context, id string) (*entity.Job, error)
+	FindByApplyRelayToken(ctx context.Context, token string) (*entity.Job, error)
 	List(ctx context.Context, filter JobFilter) ([]*entity.Job, int64, error)
 	FindByStartupID(ctx context.Context, startupID string, limit int) ([]*entity.Job, error)
+	SetApplyRelayToken(ctx context.Context, id, token string) error
+	FindExpiringForReminder(ctx context.Context, before time.Time, limit int) ([]*entity.Job, error)
+	FindStaleForReminder(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Job, error)
+	MarkReminderSent(ctx context.Context, id string, kind entity.JobReminderKind, at time.Time) error
 }
 
 type JobFilter struct {
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	// Append stores events as pending, due immediately.
+	Append(ctx context.Context, events ...*entity.DomainEvent) error
+	// ClaimDue returns pending events due at now, oldest first, and pushes
+	// their next attempt to leaseUntil so concurrent relays skip them.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxEvent, error)
+	Update(ctx context.Context, event *entity.OutboxEvent) error
+	// DeleteProcessedBefore removes processed events that occurred before the cutoff.
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error)
+}
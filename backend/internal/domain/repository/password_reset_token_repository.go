@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *entity.PasswordResetToken) error
+	// FindByTokenHash returns nil, nil when no token matches.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+	// FindLatestByUser returns the user's most recently issued token, or nil, nil.
+	FindLatestByUser(ctx context.Context, userID string) (*entity.PasswordResetToken, error)
+	// MarkUsed fails when the token was already used.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	// MarkAllUsed spends every outstanding token of the user.
+	MarkAllUsed(ctx context.Context, userID string, usedAt time.Time) error
+	DeleteExpired(ctx context.Context) error
+}
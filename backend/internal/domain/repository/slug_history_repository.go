@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type SlugHistoryRepository interface {
+	// Record stores a retired slug; recording the same slug twice is a no-op.
+	Record(ctx context.Context, h *entity.SlugHistory) error
+	FindBySlug(ctx context.Context, ownerType entity.SlugOwnerType, slug string) (*entity.SlugHistory, error)
+	// Delete removes a slug from ownerID's history, used when it becomes current again.
+	Delete(ctx context.Context, ownerType entity.SlugOwnerType, ownerID, slug string) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type StartupAPITokenRepository interface {
+	Create(ctx context.Context, token *entity.StartupAPIToken) error
+	FindByID(ctx context.Context, id string) (*entity.StartupAPIToken, error)
+	FindByHash(ctx context.Context, tokenHash string) (*entity.StartupAPIToken, error)
+	// ListByStartupID returns every token for the startup, revoked ones included.
+	ListByStartupID(ctx context.Context, startupID string) ([]*entity.StartupAPIToken, error)
+	Revoke(ctx context.Context, id string, at time.Time) error
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
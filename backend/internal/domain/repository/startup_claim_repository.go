@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type StartupClaimRepository interface {
+	Create(ctx context.Context, claim *entity.StartupClaim) error
+	Update(ctx context.Context, claim *entity.StartupClaim) error
+	FindByID(ctx context.Context, id string) (*entity.StartupClaim, error)
+	FindPendingByStartupAndUser(ctx context.Context, startupID, userID string) (*entity.StartupClaim, error)
+	ListPendingByStartupID(ctx context.Context, startupID string) ([]*entity.StartupClaim, error)
+	ListByUserID(ctx context.Context, userID string) ([]*entity.StartupClaim, error)
+	// List is the admin review queue; an empty status lists all claims.
+	List(ctx context.Context, status entity.ClaimStatus, page, pageSize int) ([]*entity.StartupClaim, int64, error)
+
+	AddEvent(ctx context.Context, event *entity.StartupClaimEvent) error
+	ListEvents(ctx context.Context, claimID string) ([]*entity.StartupClaimEvent, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type StartupFollowRepository interface {
+	// Create is idempotent: following twice keeps the original row.
+	Create(ctx context.Context, follow *entity.StartupFollow) error
+	Delete(ctx context.Context, userID, startupID string) error
+	// DeleteByUserID removes every follow of the user and returns how many there were.
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+	ListByUserID(ctx context.Context, userID string, page, pageSize int) ([]*entity.StartupFollow, int64, error)
+	CountByStartupID(ctx context.Context, startupID string) (int64, error)
+}
+
+type FollowNotificationRepository interface {
+	// EnqueueForJob queues one notification per current follower of the startup.
+	EnqueueForJob(ctx context.Context, startupID, jobID string, at time.Time) (int64, error)
+	ListPendingUserIDs(ctx context.Context, limit int) ([]string, error)
+	ListPendingByUserID(ctx context.Context, userID string) ([]*entity.FollowNotification, error)
+	MarkSent(ctx context.Context, ids []string, at time.Time) error
+}
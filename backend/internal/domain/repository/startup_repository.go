@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/domain/entity"
 )
 
@@ -17,10 +19,14 @@ type StartupRepository interface {
 	Update(ctx context.Context, startup *entity.Startup) error
 	Delete(ctx context.Context, id string) error
 	FindByID(ctx context.Context, id string) (*entity.Startup, error)
+	// FindByIDs skips IDs without a startup; the order is unspecified.
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.Startup, error)
 	FindBySlug(ctx context.Context, slug string) (*entity.Startup, error)
-	FindByAPIToken(ctx context.Context, token string) (*entity.Startup, error)
 	FindByStripeSubscriptionID(ctx context.Context, subscriptionID string) (*entity.Startup, error)
 	FindByTeamID(ctx context.Context, teamID string) ([]*entity.Startup, error)
+	// LinkTeam sets team_id only while the startup has none and reports
+	// whether it did; false means another team got there first.
+	LinkTeam(ctx context.Context, id, teamID string, at time.Time) (bool, error)
 	List(ctx context.Context, filter StartupFilter) ([]*entity.Startup, int64, error)
 }
 
@@ -30,6 +36,7 @@ type StartupFilter struct {
 	Search     string
 	Location   string
 	CompanySize string
+	Verified   *bool // nil = any
 	Pagination
 }
 
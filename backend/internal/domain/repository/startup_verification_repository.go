@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type StartupVerificationRepository interface {
+	// Save inserts or replaces the startup's single verification row.
+	Save(ctx context.Context, v *entity.StartupVerification) error
+	FindByStartupID(ctx context.Context, startupID string) (*entity.StartupVerification, error)
+	// FindDueForRecheck returns verified DNS/file rows last checked before the given time.
+	FindDueForRecheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*entity.StartupVerification, error)
+}
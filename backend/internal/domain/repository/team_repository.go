@@ -38,6 +38,7 @@ type RoleRepository interface {
 type TeamInvitationRepository interface {
 	Create(ctx context.Context, invitation *entity.TeamInvitation) error
 	Update(ctx context.Context, invitation *entity.TeamInvitation) error
+	FindByID(ctx context.Context, id string) (*entity.TeamInvitation, error)
 	FindByToken(ctx context.Context, token string) (*entity.TeamInvitation, error)
 	FindByTeamID(ctx context.Context, teamID string) ([]*entity.TeamInvitation, error)
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type UploadIntentRepository interface {
+	Create(ctx context.Context, intent *entity.UploadIntent) error
+	FindByID(ctx context.Context, id string) (*entity.UploadIntent, error)
+	Update(ctx context.Context, intent *entity.UploadIntent) error
+	// MarkCompleted records the file only while the intent is not yet
+	// completed and reports whether it did.
+	MarkCompleted(ctx context.Context, id, fileID string, at time.Time) (bool, error)
+	// ListStale returns uncompleted intents that expired before the cutoff.
+	ListStale(ctx context.Context, expiredBefore time.Time, limit int) ([]*entity.UploadIntent, error)
+	Delete(ctx context.Context, id string) error
+}
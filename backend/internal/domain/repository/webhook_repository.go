@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type WebhookEndpointRepository interface {
+	Create(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	FindByID(ctx context.Context, id string) (*entity.WebhookEndpoint, error)
+	ListByTeamID(ctx context.Context, teamID string) ([]*entity.WebhookEndpoint, error)
+	Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	Delete(ctx context.Context, id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	FindByID(ctx context.Context, id string) (*entity.WebhookDelivery, error)
+	ListByEndpointID(ctx context.Context, endpointID string, limit int) ([]*entity.WebhookDelivery, error)
+	// ClaimDue returns pending deliveries due at now and pushes their next
+	// attempt to leaseUntil, so concurrent workers don't send them twice.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
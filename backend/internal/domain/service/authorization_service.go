@@ -118,6 +118,34 @@ func (s *AuthorizationService) CanManageStartup(ctx context.Context, userID, sta
 	return s.legacyCanManageStartup(ctx, userID, startupID)
 }
 
+// TeamMembersWithScope lists active team members whose role grants scope.
+// Used to pick notification recipients; platform admins are not included.
+func (s *AuthorizationService) TeamMembersWithScope(ctx context.Context, teamID string, scope entity.Scope) ([]*entity.TeamMember, error) {
+	members, err := s.teamMemberRepo.FindByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	roles := map[string]*entity.Role{}
+	var out []*entity.TeamMember
+	for _, m := range members {
+		if !m.IsActive() {
+			continue
+		}
+		role, ok := roles[m.RoleID]
+		if !ok {
+			role, err = s.roleRepo.FindByID(ctx, m.RoleID)
+			if err != nil {
+				return nil, err
+			}
+			roles[m.RoleID] = role
+		}
+		if role.HasScope(scope) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
 func (s *AuthorizationService) GetUserStartups(ctx context.Context, userID string) ([]*entity.StartupMember, error) {
 	return s.memberRepo.FindByUserID(ctx, userID)
 }
@@ -249,10 +249,21 @@ func (r *startupRepo) FindByID(ctx context.Context, id string) (*entity.Startup,
 	}
 	return s, nil
 }
-func (r *startupRepo) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
-	return nil, errNotFound
+func (r *startupRepo) FindByIDs(ctx context.Context, ids []string) ([]*entity.Startup, error) {
+	var out []*entity.Startup
+	for _, id := range ids {
+		if s, ok := r.f.starts[id]; ok {
+			out = append(out, s)
+		}
+	}
+	return out, nil
 }
-func (r *startupRepo) FindByAPIToken(ctx context.Context, token string) (*entity.Startup, error) {
+func (r *startupRepo) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
+	for _, s := range r.f.starts {
+		if s.Slug == slug {
+			return s, nil
+		}
+	}
 	return nil, errNotFound
 }
 func (r *startupRepo) FindByStripeSubscriptionID(ctx context.Context, id string) (*entity.Startup, error) {
@@ -264,6 +275,9 @@ func (r *startupRepo) FindByTeamID(ctx context.Context, teamID string) ([]*entit
 func (r *startupRepo) List(ctx context.Context, filter repository.StartupFilter) ([]*entity.Startup, int64, error) {
 	return nil, 0, nil
 }
+func (r *startupRepo) LinkTeam(ctx context.Context, id, teamID string, at time.Time) (bool, error) {
+	return false, nil
+}
 
 type legacyMemberRepo struct{}
 
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/repository"
+)
+
+// EmailVerificationPolicy decides whether a user may publish on the board
+// (create startups, post jobs) before verifying their email address.
+type EmailVerificationPolicy struct {
+	userRepo repository.UserRepository
+	required bool
+}
+
+// NewEmailVerificationPolicy returns a policy that, when required is false,
+// lets every user through.
+func NewEmailVerificationPolicy(userRepo repository.UserRepository, required bool) *EmailVerificationPolicy {
+	return &EmailVerificationPolicy{userRepo: userRepo, required: required}
+}
+
+// CanPublish reports whether the user may create startups and post jobs.
+// Platform admins always pass.
+func (p *EmailVerificationPolicy) CanPublish(ctx context.Context, userID string) (bool, error) {
+	if !p.required {
+		return true, nil
+	}
+	user, err := p.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return user.IsEmailVerified() || user.Role.IsPlatformAdmin(), nil
+}
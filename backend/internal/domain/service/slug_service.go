@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+// SlugService hands out startup and team slugs. A slug is unavailable while
+// another entity of the same type holds it, currently or historically, so old
+// links never start pointing at someone else.
+type SlugService struct {
+	startupRepo repository.StartupRepository
+	teamRepo    repository.TeamRepository
+	historyRepo repository.SlugHistoryRepository
+}
+
+func NewSlugService(startupRepo repository.StartupRepository, teamRepo repository.TeamRepository, historyRepo repository.SlugHistoryRepository) *SlugService {
+	return &SlugService{startupRepo: startupRepo, teamRepo: teamRepo, historyRepo: historyRepo}
+}
+
+// StartupSlug returns a free slug for name. ownerID is the startup being
+// renamed (empty on create); its own current and past slugs count as free.
+func (s *SlugService) StartupSlug(ctx context.Context, name, ownerID string) string {
+	return utils.GenerateUniqueSlug(utils.GenerateSlug(name), func(slug string) bool {
+		if existing, err := s.startupRepo.FindBySlug(ctx, slug); err == nil && existing != nil && existing.ID != ownerID {
+			return true
+		}
+		return s.heldByOther(ctx, entity.SlugOwnerStartup, slug, ownerID)
+	})
+}
+
+// TeamSlug is StartupSlug for teams.
+func (s *SlugService) TeamSlug(ctx context.Context, name, ownerID string) string {
+	return utils.GenerateUniqueSlug(utils.GenerateSlug(name), func(slug string) bool {
+		if existing, err := s.teamRepo.FindBySlug(ctx, slug); err == nil && existing != nil && existing.ID != ownerID {
+			return true
+		}
+		return s.heldByOther(ctx, entity.SlugOwnerTeam, slug, ownerID)
+	})
+}
+
+// Retire moves oldSlug into ownerID's history after a rename. If newSlug was
+// one of the owner's earlier slugs it is current again and leaves the history.
+func (s *SlugService) Retire(ctx context.Context, ownerType entity.SlugOwnerType, ownerID, oldSlug, newSlug string) error {
+	if oldSlug == newSlug {
+		return nil
+	}
+	if oldSlug != "" {
+		if err := s.historyRepo.Record(ctx, &entity.SlugHistory{
+			ID: uuid.New().String(), OwnerType: ownerType, OwnerID: ownerID, Slug: oldSlug, CreatedAt: time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+	return s.historyRepo.Delete(ctx, ownerType, ownerID, newSlug)
+}
+
+// ResolveStartup returns the ID of the startup that used to be known by slug.
+func (s *SlugService) ResolveStartup(ctx context.Context, slug string) (string, bool) {
+	h, err := s.historyRepo.FindBySlug(ctx, entity.SlugOwnerStartup, slug)
+	if err != nil || h == nil {
+		return "", false
+	}
+	return h.OwnerID, true
+}
+
+func (s *SlugService) heldByOther(ctx context.Context, ownerType entity.SlugOwnerType, slug, ownerID string) bool {
+	h, err := s.historyRepo.FindBySlug(ctx, ownerType, slug)
+	if err != nil {
+		// Fail closed: a suffixed slug is better than stealing an old link.
+		return true
+	}
+	return h != nil && h.OwnerID != ownerID
+}
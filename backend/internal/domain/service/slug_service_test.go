@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/service"
+)
+
+func TestRetiredSlugIsReservedForItsOwner(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture()
+	history := &slugHistoryRepo{rows: map[string]*entity.SlugHistory{}}
+	slugs := service.NewSlugService(&startupRepo{f: f}, nil, history)
+
+	f.starts["a"] = &entity.Startup{ID: "a", Name: "Acme", Slug: "acme"}
+	renamed := slugs.StartupSlug(ctx, "Acme Labs", "a")
+	if renamed != "acme-labs" {
+		t.Fatalf("rename slug = %q", renamed)
+	}
+	if err := slugs.Retire(ctx, entity.SlugOwnerStartup, "a", "acme", renamed); err != nil {
+		t.Fatal(err)
+	}
+	f.starts["a"].Slug = renamed
+
+	if id, ok := slugs.ResolveStartup(ctx, "acme"); !ok || id != "a" {
+		t.Fatalf("old slug resolved to %q, %v", id, ok)
+	}
+
+	// Another startup must not inherit the old slug.
+	if got := slugs.StartupSlug(ctx, "Acme", "b"); got == "acme" || !strings.HasPrefix(got, "acme-") {
+		t.Fatalf("new startup got %q", got)
+	}
+	if got := slugs.StartupSlug(ctx, "Acme", ""); got == "acme" {
+		t.Fatal("create handed out a historical slug")
+	}
+
+	// The owner can rename back and the slug leaves its history.
+	if got := slugs.StartupSlug(ctx, "Acme", "a"); got != "acme" {
+		t.Fatalf("owner rename back got %q", got)
+	}
+	if err := slugs.Retire(ctx, entity.SlugOwnerStartup, "a", renamed, "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := history.rows["acme"]; ok {
+		t.Fatal("reclaimed slug still in history")
+	}
+	if id, ok := slugs.ResolveStartup(ctx, renamed); !ok || id != "a" {
+		t.Fatalf("intermediate slug resolved to %q, %v", id, ok)
+	}
+}
+
+type slugHistoryRepo struct {
+	rows map[string]*entity.SlugHistory
+}
+
+func (r *slugHistoryRepo) Record(ctx context.Context, h *entity.SlugHistory) error {
+	if _, ok := r.rows[h.Slug]; !ok {
+		r.rows[h.Slug] = h
+	}
+	return nil
+}
+func (r *slugHistoryRepo) FindBySlug(ctx context.Context, ownerType entity.SlugOwnerType, slug string) (*entity.SlugHistory, error) {
+	return r.rows[slug], nil
+}
+func (r *slugHistoryRepo) Delete(ctx context.Context, ownerType entity.SlugOwnerType, ownerID, slug string) error {
+	if h, ok := r.rows[slug]; ok && h.OwnerID == ownerID {
+		delete(r.rows, slug)
+	}
+	return nil
+}
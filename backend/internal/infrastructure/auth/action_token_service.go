@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+// actionTokenAudience keeps action links from being accepted as session JWTs
+// (and vice versa) even if the secrets were ever shared.
+const actionTokenAudience = "action-link"
+
+type ActionTokenService struct {
+	secret string
+}
+
+func NewActionTokenService(secret string) port.ActionTokenService {
+	return &ActionTokenService{secret: secret}
+}
+
+type actionClaims struct {
+	Action  string `json:"act"`
+	Version int64  `json:"ver"`
+	jwt.RegisteredClaims
+}
+
+func (s *ActionTokenService) Sign(claims port.ActionClaims) (string, error) {
+	if s.secret == "" {
+		return "", errors.New("action token secret is not configured")
+	}
+	c := &actionClaims{
+		Action:  claims.Action,
+		Version: claims.Version,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   claims.Subject,
+			Audience:  jwt.ClaimStrings{actionTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(claims.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(s.secret))
+}
+
+func (s *ActionTokenService) Verify(tokenString string) (*port.ActionClaims, error) {
+	if s.secret == "" {
+		return nil, errors.New("action token secret is not configured")
+	}
+	token, err := jwt.ParseWithClaims(tokenString, &actionClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(s.secret), nil
+	}, jwt.WithAudience(actionTokenAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*actionClaims)
+	if !ok || !token.Valid || claims.Subject == "" || claims.Action == "" {
+		return nil, errors.New("invalid action token")
+	}
+	return &port.ActionClaims{
+		Subject:   claims.Subject,
+		Action:    claims.Action,
+		Version:   claims.Version,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
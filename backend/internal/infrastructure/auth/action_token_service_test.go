@@ -0,0 +1,53 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/auth"
+	"github.com/startup-job-board/backend/internal/infrastructure/config"
+)
+
+func TestActionTokenRoundTrip(t *testing.T) {
+	svc := auth.NewActionTokenService("action-secret-for-tests")
+	in := port.ActionClaims{Subject: "job-1", Action: "extend", Version: 42, ExpiresAt: time.Now().Add(time.Hour)}
+	token, err := svc.Sign(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := svc.Verify(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Subject != "job-1" || got.Action != "extend" || got.Version != 42 {
+		t.Fatalf("claims: %+v", got)
+	}
+}
+
+func TestActionTokenRejects(t *testing.T) {
+	svc := auth.NewActionTokenService("action-secret-for-tests")
+
+	expired, _ := svc.Sign(port.ActionClaims{Subject: "job-1", Action: "close", ExpiresAt: time.Now().Add(-time.Minute)})
+	if _, err := svc.Verify(expired); err == nil {
+		t.Fatal("expected expired token to fail")
+	}
+
+	valid, _ := svc.Sign(port.ActionClaims{Subject: "job-1", Action: "close", ExpiresAt: time.Now().Add(time.Hour)})
+	if _, err := auth.NewActionTokenService("other-secret").Verify(valid); err == nil {
+		t.Fatal("expected wrong secret to fail")
+	}
+	parts := strings.Split(valid, ".")
+	tampered := parts[0] + "." + parts[1] + "x." + parts[2]
+	if _, err := svc.Verify(tampered); err == nil {
+		t.Fatal("expected tampered token to fail")
+	}
+
+	// A session JWT signed with the same secret is not an action link.
+	jwtSvc := auth.NewJWTService(config.JWTConfig{Secret: "action-secret-for-tests", Expiration: time.Hour})
+	access, _ := jwtSvc.GenerateAccessToken("user-1", "user")
+	if _, err := svc.Verify(access); err == nil {
+		t.Fatal("expected session token to be rejected")
+	}
+}
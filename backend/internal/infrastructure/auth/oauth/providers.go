@@ -12,6 +12,7 @@ import (
 
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
 type Registry struct {
@@ -49,7 +50,7 @@ func NewGoogleProvider(clientID, clientSecret, redirectURL string) *GoogleProvid
 	}
 	return &GoogleProvider{
 		clientID: clientID, clientSecret: clientSecret, redirectURL: redirectURL,
-		httpClient: http.DefaultClient,
+		httpClient: tracing.Client(http.DefaultClient),
 	}
 }
 
@@ -111,9 +112,10 @@ func (p *GoogleProvider) Exchange(ctx context.Context, code string) (*port.Exter
 		return nil, fmt.Errorf("google userinfo failed: %s", string(ubody))
 	}
 	var info struct {
-		Sub   string `json:"sub"`
-		Email string `json:"email"`
-		Name  string `json:"name"`
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
 	}
 	if err := json.Unmarshal(ubody, &info); err != nil {
 		return nil, err
@@ -121,6 +123,7 @@ func (p *GoogleProvider) Exchange(ctx context.Context, code string) (*port.Exter
 	return &port.ExternalProfile{
 		ProviderUserID: info.Sub,
 		Email:          info.Email,
+		EmailVerified:  info.EmailVerified,
 		Name:           info.Name,
 	}, nil
 }
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+type entry struct {
+	resp      *port.CachedResponse
+	scope     port.CacheScope
+	expiresAt time.Time
+}
+
+// Stats are the cache's lookup counters since start.
+type Stats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// MemoryResponseCache keeps public responses in process memory for a short
+// TTL. Invalidation only reaches the local replica; the TTL bounds how stale
+// other replicas can be.
+type MemoryResponseCache struct {
+	mu         sync.Mutex
+	entries    map[string]entry
+	ttl        time.Duration
+	maxEntries int
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+}
+
+var _ port.ResponseCache = (*MemoryResponseCache)(nil)
+
+func NewMemoryResponseCache(ttl time.Duration, maxEntries int) *MemoryResponseCache {
+	if maxEntries <= 0 {
+		maxEntries = 1000
+	}
+	return &MemoryResponseCache{entries: make(map[string]entry), ttl: ttl, maxEntries: maxEntries}
+}
+
+func (c *MemoryResponseCache) Get(key string) (*port.CachedResponse, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok && time.Now().After(e.expiresAt) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	return e.resp, true
+}
+
+func (c *MemoryResponseCache) Set(key string, scope port.CacheScope, resp *port.CachedResponse) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = entry{resp: resp, scope: scope, expiresAt: now.Add(c.ttl)}
+}
+
+// evict drops expired entries, and everything when that frees nothing: under
+// a flood of distinct queries a full reset is cheaper than tracking recency.
+func (c *MemoryResponseCache) evict(now time.Time) {
+	for key, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		clear(c.entries)
+	}
+}
+
+func (c *MemoryResponseCache) Invalidate(scopes ...port.CacheScope) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.entries {
+		for _, scope := range scopes {
+			if e.scope == scope {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+func (c *MemoryResponseCache) Stats() Stats {
+	c.mu.Lock()
+	n := len(c.entries)
+	c.mu.Unlock()
+	return Stats{Hits: c.hits.Load(), Misses: c.misses.Load(), Entries: n}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -28,8 +29,54 @@ type Config struct {
 	AppURL         string
 	Stripe         StripeConfig
 	OAuth          OAuthConfig
+	Auth           AuthConfig
 	InternalKey    string
 	TrustedProxies []string
+	ApplyRelay     ApplyRelayConfig
+	JobReminders   JobReminderConfig
+	DomainRecheck  DomainRecheckConfig
+	FollowDigest   FollowDigestConfig
+	Webhooks       WebhookConfig
+	Outbox         OutboxConfig
+	JobQueue       JobQueueConfig
+	FileGC         FileGCConfig
+	OpenAPI        OpenAPIConfig
+	ResponseCache  ResponseCacheConfig
+	Log            LogConfig
+	Metrics        MetricsConfig
+	Tracing        TracingConfig
+	Health         HealthConfig
+}
+
+// HealthConfig tunes /readyz. ShutdownDelay keeps serving, while readiness
+// fails, for that long after SIGTERM so load balancers stop sending traffic
+// before the listener closes.
+type HealthConfig struct {
+	CheckTimeout  time.Duration
+	ShutdownDelay time.Duration
+}
+
+// TracingConfig controls OpenTelemetry tracing, which runs alongside New
+// Relic. The OTLP endpoint comes from OTEL_EXPORTER_OTLP_ENDPOINT.
+type TracingConfig struct {
+	Exporter    string // otlp, stdout or none
+	ServiceName string
+	SampleRatio float64 // share of new traces kept, 0 to 1
+}
+
+// LogConfig selects the minimum level and output format of the logger.
+type LogConfig struct {
+	Level  string // debug, info, warn, error
+	Format string // json or text
+}
+
+// ApplyRelayConfig controls masked apply+<token>@Domain job addresses.
+// Empty Domain disables masking and job detail returns the real address.
+type ApplyRelayConfig struct {
+	Domain        string
+	InboundSecret string // shared secret the inbound mail webhook must present
+	SenderLimit   int    // forwarded messages per sender per window
+	SenderWindow  time.Duration
 }
 
 type OAuthConfig struct {
@@ -38,6 +85,16 @@ type OAuthConfig struct {
 	RedirectBaseURL    string
 }
 
+// AuthConfig sets account policies. With RequireVerifiedEmail, password
+// sign-ups cannot create startups or post jobs until they verify their email.
+type AuthConfig struct {
+	RequireVerifiedEmail  bool
+	VerificationTTL       time.Duration
+	VerificationCooldown  time.Duration // minimum gap between verification emails
+	PasswordResetTTL      time.Duration
+	PasswordResetCooldown time.Duration // minimum gap between reset emails
+}
+
 type DatabaseConfig struct {
 	URL      string // Full database URL (takes precedence if provided)
 	Host     string
@@ -46,6 +103,9 @@ type DatabaseConfig struct {
 	Password string
 	Name     string
 	SSLMode  string
+	// MigrateOnStart applies pending migrations at boot; turn it off to run
+	// "api migrate up" as a separate deploy step instead.
+	MigrateOnStart bool
 }
 
 type JWTConfig struct {
@@ -55,9 +115,19 @@ type JWTConfig struct {
 }
 
 type StorageConfig struct {
-	Type  string
-	MinIO MinIOConfig
-	S3    S3Config
+	Type         string // minio | s3 | local
+	MinIO        MinIOConfig
+	S3           S3Config
+	Local        LocalStorageConfig
+	SignedURLTTL time.Duration // lifetime of links to private objects
+	// SigningSecret signs local private-object links; defaults to JWT_SECRET.
+	SigningSecret string
+}
+
+// LocalStorageConfig keeps uploads on disk and serves them from the API.
+type LocalStorageConfig struct {
+	Dir     string
+	BaseURL string // public base URL of this API, e.g. http://localhost:8080
 }
 
 type MinIOConfig struct {
@@ -76,7 +146,101 @@ type S3Config struct {
 }
 
 type EmailConfig struct {
+	// Transport is resend, smtp, file (.eml files in FileDir) or memory.
+	Transport     string
+	From          string
+	DefaultLocale string
+	// TemplatesDir replaces the built-in templates when set.
+	TemplatesDir string
 	ResendAPIKey string
+	SMTP         SMTPConfig
+	FileDir      string
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// JobReminderConfig controls expiry / stale-listing owner emails and the
+// signed one-click links they carry.
+type JobReminderConfig struct {
+	Enabled      bool
+	Interval     time.Duration
+	ExpiryLead   time.Duration
+	StaleAfter   time.Duration
+	LinkTTL      time.Duration
+	ActionSecret string // defaults to JWT_SECRET when unset
+}
+
+// DomainRecheckConfig controls periodic re-verification of startup domains.
+type DomainRecheckConfig struct {
+	Enabled  bool
+	Interval time.Duration // how often the re-check task runs
+	After    time.Duration // re-check proofs older than this
+}
+
+// FollowDigestConfig controls the new-job digest emailed to startup followers.
+type FollowDigestConfig struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+// WebhookConfig controls delivery of outbound team webhooks.
+type WebhookConfig struct {
+	Enabled     bool
+	Interval    time.Duration // how often due deliveries are sent
+	MaxAttempts int           // attempts before a delivery is marked failed
+	Timeout     time.Duration // per-request timeout
+}
+
+// OutboxConfig controls the relay that dispatches recorded domain events to
+// their subscribers (webhooks and the like).
+type OutboxConfig struct {
+	Interval    time.Duration // how often due events are dispatched
+	MaxAttempts int           // attempts before an event is marked failed
+	Retention   time.Duration // how long processed events are kept
+}
+
+// JobQueueConfig controls the Postgres-backed background job worker.
+type JobQueueConfig struct {
+	PollInterval time.Duration  // how often each queue is checked for due jobs
+	Concurrency  map[string]int // jobs run at once per queue, per process
+	MaxAttempts  int            // attempts before a job is marked dead, unless enqueued with its own
+	Timeout      time.Duration  // how long one attempt may run
+	Retention    time.Duration  // how long succeeded and cancelled jobs are kept
+}
+
+// FileGCConfig controls deletion of uploaded files nothing references.
+type FileGCConfig struct {
+	Enabled     bool
+	Interval    time.Duration
+	GracePeriod time.Duration // how long an unreferenced file is kept
+}
+
+// ResponseCacheConfig controls the in-process cache of anonymous public
+// jobs/startups reads. Writes through the API invalidate it on the replica
+// that handled them; TTL bounds staleness elsewhere.
+type ResponseCacheConfig struct {
+	Enabled    bool
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// MetricsConfig controls the Prometheus endpoint at /metrics. With Token
+// set, scrapers must send it as a bearer token.
+type MetricsConfig struct {
+	Enabled bool
+	Token   string
+}
+
+// OpenAPIConfig controls checking traffic against the generated OpenAPI
+// document. Validation buffers every response, so it is off in production.
+type OpenAPIConfig struct {
+	Validate bool // reject non-conforming requests, log non-conforming responses
+	Strict   bool // answer 500 instead of a non-conforming response
 }
 
 type CORSConfig struct {
@@ -84,12 +248,15 @@ type CORSConfig struct {
 }
 
 type RateLimitConfig struct {
-	Enabled      bool
-	Requests     int // authenticated / default
-	Window       time.Duration
-	ListLimit    int // public list endpoints
-	DetailLimit  int // public detail endpoints
-	TrustedLimit int // SSR with internal key
+	Enabled       bool
+	Requests      int // authenticated / default
+	Window        time.Duration
+	ListLimit     int    // public list endpoints
+	DetailLimit   int    // public detail endpoints
+	TrustedLimit  int    // SSR with internal key
+	TokenLimit    int    // per API token, free plan
+	TokenProLimit int    // per API token, Pro plan
+	Store         string // "memory" (per process) or "postgres" (shared by replicas)
 }
 
 // StripeConfig holds Stripe billing settings.
@@ -121,6 +288,8 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", "postgres"),
 			Name:     getEnv("DB_NAME", "startup_board"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+
+			MigrateOnStart: getEnvBool("DB_MIGRATE_ON_START", true),
 		},
 
 		JWT: JWTConfig{
@@ -144,10 +313,27 @@ func Load() (*Config, error) {
 				AccessKey: getEnv("AWS_ACCESS_KEY_ID", ""),
 				SecretKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
 			},
+			Local: LocalStorageConfig{
+				Dir:     getEnv("STORAGE_LOCAL_DIR", "./data/uploads"),
+				BaseURL: getEnv("STORAGE_PUBLIC_BASE_URL", "http://localhost:8080"),
+			},
+			SignedURLTTL:  parseDuration(getEnv("STORAGE_SIGNED_URL_TTL", "15m")),
+			SigningSecret: getEnv("STORAGE_SIGNING_SECRET", ""),
 		},
 
 		Email: EmailConfig{
-			ResendAPIKey: getEnv("RESEND_API_KEY", ""),
+			Transport:     getEnv("EMAIL_TRANSPORT", "resend"),
+			From:          getEnv("EMAIL_FROM", "noreply@startupboard.com"),
+			DefaultLocale: getEnv("EMAIL_DEFAULT_LOCALE", "en"),
+			TemplatesDir:  getEnv("EMAIL_TEMPLATES_DIR", ""),
+			ResendAPIKey:  getEnv("RESEND_API_KEY", ""),
+			SMTP: SMTPConfig{
+				Host:     getEnv("SMTP_HOST", ""),
+				Port:     getEnvInt("SMTP_PORT", 587),
+				Username: getEnv("SMTP_USERNAME", ""),
+				Password: getEnv("SMTP_PASSWORD", ""),
+			},
+			FileDir: getEnv("EMAIL_FILE_DIR", "./tmp/mail"),
 		},
 
 		CORS: CORSConfig{
@@ -155,12 +341,15 @@ func Load() (*Config, error) {
 		},
 
 		RateLimit: RateLimitConfig{
-			Enabled:      getEnvBool("RATE_LIMIT_ENABLED", true),
-			Requests:     getEnvInt("RATE_LIMIT_REQUESTS", 100),
-			Window:       parseDuration(getEnv("RATE_LIMIT_WINDOW", "1m")),
-			ListLimit:    getEnvInt("RATE_LIMIT_LIST_REQUESTS", 30),
-			DetailLimit:  getEnvInt("RATE_LIMIT_DETAIL_REQUESTS", 60),
-			TrustedLimit: getEnvInt("RATE_LIMIT_TRUSTED_REQUESTS", 300),
+			Enabled:       getEnvBool("RATE_LIMIT_ENABLED", true),
+			Requests:      getEnvInt("RATE_LIMIT_REQUESTS", 100),
+			Window:        parseDuration(getEnv("RATE_LIMIT_WINDOW", "1m")),
+			ListLimit:     getEnvInt("RATE_LIMIT_LIST_REQUESTS", 30),
+			DetailLimit:   getEnvInt("RATE_LIMIT_DETAIL_REQUESTS", 60),
+			TrustedLimit:  getEnvInt("RATE_LIMIT_TRUSTED_REQUESTS", 300),
+			TokenLimit:    getEnvInt("RATE_LIMIT_TOKEN_REQUESTS", 120),
+			TokenProLimit: getEnvInt("RATE_LIMIT_TOKEN_PRO_REQUESTS", 600),
+			Store:         getEnv("RATE_LIMIT_STORE", "memory"),
 		},
 
 		AppURL: getEnv("APP_URL", "http://localhost:3000"),
@@ -180,12 +369,101 @@ func Load() (*Config, error) {
 			RedirectBaseURL:    getEnv("OAUTH_REDIRECT_BASE_URL", "http://localhost:8080/api/v1/auth/oauth"),
 		},
 
+		Auth: AuthConfig{
+			RequireVerifiedEmail:  getEnvBool("AUTH_REQUIRE_VERIFIED_EMAIL", true),
+			VerificationTTL:       parseDuration(getEnv("EMAIL_VERIFICATION_TTL", "48h")),
+			VerificationCooldown:  parseDuration(getEnv("EMAIL_VERIFICATION_RESEND_COOLDOWN", "1m")),
+			PasswordResetTTL:      parseDuration(getEnv("PASSWORD_RESET_TTL", "1h")),
+			PasswordResetCooldown: parseDuration(getEnv("PASSWORD_RESET_COOLDOWN", "1m")),
+		},
+
 		TrustedProxies: parseStringSlice(getEnv("TRUSTED_PROXIES", "")),
+
+		ApplyRelay: ApplyRelayConfig{
+			Domain:        getEnv("APPLY_RELAY_DOMAIN", ""),
+			InboundSecret: getEnv("APPLY_RELAY_INBOUND_SECRET", ""),
+			SenderLimit:   getEnvInt("APPLY_RELAY_SENDER_LIMIT", 5),
+			SenderWindow:  parseDuration(getEnv("APPLY_RELAY_SENDER_WINDOW", "1h")),
+		},
+
+		JobReminders: JobReminderConfig{
+			Enabled:      getEnvBool("JOB_REMINDERS_ENABLED", true),
+			Interval:     parseDuration(getEnv("JOB_REMINDER_INTERVAL", "1h")),
+			ExpiryLead:   parseDuration(getEnv("JOB_REMINDER_EXPIRY_LEAD", "72h")),
+			StaleAfter:   parseDuration(getEnv("JOB_REMINDER_STALE_AFTER", "1440h")),
+			LinkTTL:      parseDuration(getEnv("JOB_ACTION_LINK_TTL", "168h")),
+			ActionSecret: getEnv("JOB_ACTION_SECRET", ""),
+		},
+
+		DomainRecheck: DomainRecheckConfig{
+			Enabled:  getEnvBool("DOMAIN_RECHECK_ENABLED", true),
+			Interval: parseDuration(getEnv("DOMAIN_RECHECK_INTERVAL", "1h")),
+			After:    parseDuration(getEnv("DOMAIN_RECHECK_AFTER", "168h")),
+		},
+		FollowDigest: FollowDigestConfig{
+			Enabled:  getEnvBool("FOLLOW_DIGEST_ENABLED", true),
+			Interval: parseDuration(getEnv("FOLLOW_DIGEST_INTERVAL", "24h")),
+		},
+		Webhooks: WebhookConfig{
+			Enabled:     getEnvBool("WEBHOOK_DELIVERY_ENABLED", true),
+			Interval:    parseDuration(getEnv("WEBHOOK_DELIVERY_INTERVAL", "10s")),
+			MaxAttempts: getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			Timeout:     parseDuration(getEnv("WEBHOOK_TIMEOUT", "10s")),
+		},
+		Outbox: OutboxConfig{
+			Interval:    parseDuration(getEnv("OUTBOX_RELAY_INTERVAL", "2s")),
+			MaxAttempts: getEnvInt("OUTBOX_MAX_ATTEMPTS", 12),
+			Retention:   parseDuration(getEnv("OUTBOX_RETENTION", "168h")),
+		},
+		JobQueue: JobQueueConfig{
+			PollInterval: parseDuration(getEnv("JOB_QUEUE_POLL_INTERVAL", "1s")),
+			Concurrency:  parseQueueLimits(getEnv("JOB_QUEUE_CONCURRENCY", "default=4,email=2")),
+			MaxAttempts:  getEnvInt("JOB_QUEUE_MAX_ATTEMPTS", 10),
+			Timeout:      parseDuration(getEnv("JOB_QUEUE_TIMEOUT", "5m")),
+			Retention:    parseDuration(getEnv("JOB_QUEUE_RETENTION", "168h")),
+		},
+		FileGC: FileGCConfig{
+			Enabled:     getEnvBool("FILE_GC_ENABLED", true),
+			Interval:    parseDuration(getEnv("FILE_GC_INTERVAL", "6h")),
+			GracePeriod: parseDuration(getEnv("FILE_GC_GRACE_PERIOD", "24h")),
+		},
+		Log: LogConfig{
+			Level:  getEnv("LOG_LEVEL", "info"),
+			Format: getEnv("LOG_FORMAT", "json"),
+		},
+		ResponseCache: ResponseCacheConfig{
+			Enabled:    getEnvBool("RESPONSE_CACHE_ENABLED", false),
+			TTL:        parseDuration(getEnv("RESPONSE_CACHE_TTL", "30s")),
+			MaxEntries: getEnvInt("RESPONSE_CACHE_MAX_ENTRIES", 1000),
+		},
+		Metrics: MetricsConfig{
+			Enabled: getEnvBool("METRICS_ENABLED", true),
+			Token:   getEnv("METRICS_TOKEN", ""),
+		},
+		Tracing: TracingConfig{
+			Exporter:    getEnv("OTEL_TRACES_EXPORTER", "none"),
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "startup-job-board-api"),
+			SampleRatio: getEnvFloat("OTEL_TRACES_SAMPLER_ARG", 1),
+		},
+		Health: HealthConfig{
+			CheckTimeout:  parseDuration(getEnv("HEALTH_CHECK_TIMEOUT", "2s")),
+			ShutdownDelay: parseDuration(getEnv("HEALTH_SHUTDOWN_DELAY", "0s")),
+		},
 	}
 
 	if err := validateJWTSecret(config); err != nil {
 		return nil, err
 	}
+	config.OpenAPI = OpenAPIConfig{
+		Validate: getEnvBool("OPENAPI_VALIDATE", config.Environment == "development" || config.Environment == "test"),
+		Strict:   getEnvBool("OPENAPI_STRICT", config.Environment == "test"),
+	}
+	if config.JobReminders.ActionSecret == "" {
+		config.JobReminders.ActionSecret = config.JWT.Secret
+	}
+	if config.Storage.SigningSecret == "" {
+		config.Storage.SigningSecret = config.JWT.Secret
+	}
 
 	return config, nil
 }
@@ -233,6 +511,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
 func parseDuration(s string) time.Duration {
 	d, err := time.ParseDuration(s)
 	if err != nil {
@@ -254,6 +541,24 @@ func parseStringSlice(s string) []string {
 	return result
 }
 
+// parseQueueLimits reads "queue=n,queue=n"; entries that don't parse to a
+// positive limit are skipped.
+func parseQueueLimits(s string) map[string]int {
+	limits := map[string]int{}
+	for _, item := range parseStringSlice(s) {
+		eq := strings.IndexByte(item, '=')
+		if eq <= 0 {
+			continue
+		}
+		n, err := strconv.Atoi(trimString(item[eq+1:]))
+		if err != nil || n <= 0 {
+			continue
+		}
+		limits[trimString(item[:eq])] = n
+	}
+	return limits
+}
+
 func splitString(s, sep string) []string {
 	result := []string{}
 	current := ""
@@ -0,0 +1,58 @@
+package email
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileTransport writes each message to an .eml file instead of sending it,
+// for development; any mail client opens them.
+type FileTransport struct {
+	dir string
+}
+
+func NewFileTransport(dir string) (*FileTransport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileTransport{dir: dir}, nil
+}
+
+func (t *FileTransport) Deliver(_ context.Context, msg *Message) error {
+	now := time.Now()
+	raw, err := buildMIME(msg, now)
+	if err != nil {
+		return err
+	}
+	name := now.UTC().Format("20060102T150405") + "-" + uuid.New().String()[:8] + ".eml"
+	return os.WriteFile(filepath.Join(t.dir, name), raw, 0o644)
+}
+
+// MemoryTransport keeps messages in memory, for tests.
+type MemoryTransport struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{}
+}
+
+func (t *MemoryTransport) Deliver(_ context.Context, msg *Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.messages = append(t.messages, *msg)
+	return nil
+}
+
+// Messages returns everything delivered so far, oldest first.
+func (t *MemoryTransport) Messages() []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Message(nil), t.messages...)
+}
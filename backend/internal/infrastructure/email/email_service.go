@@ -0,0 +1,42 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+// Service renders templates and hands the result to a transport.
+type Service struct {
+	renderer  *Renderer
+	transport Transport
+	from      string
+}
+
+func NewService(renderer *Renderer, transport Transport, from string) port.EmailService {
+	return &Service{renderer: renderer, transport: transport, from: from}
+}
+
+func (s *Service) Send(ctx context.Context, template string, data interface{}, to port.EmailRecipient) error {
+	rendered, err := s.renderer.Render(template, to.Locale, data)
+	if err != nil {
+		return err
+	}
+	return s.transport.Deliver(ctx, &Message{
+		From: s.from, To: to.Email, Subject: rendered.Subject, HTML: rendered.HTML, Text: rendered.Text,
+	})
+}
+
+func (s *Service) ForwardApplicationEmail(ctx context.Context, toEmail string, msg port.ApplicationMessage) error {
+	subject := msg.Subject
+	if subject == "" {
+		subject = fmt.Sprintf("New application: %s", msg.JobTitle)
+	}
+	// Body is forwarded as received; Reply-To lets the startup answer the candidate directly.
+	out := &Message{From: s.from, To: toEmail, ReplyTo: msg.From, Subject: subject, Text: msg.Text, HTML: msg.HTML}
+	if out.Text == "" && out.HTML == "" {
+		out.Text = "(empty message)"
+	}
+	return s.transport.Deliver(ctx, out)
+}
@@ -0,0 +1,30 @@
+package email
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+// InstrumentedEmailService counts send results per template.
+type InstrumentedEmailService struct {
+	next    port.EmailService
+	metrics port.Metrics
+}
+
+func NewInstrumentedEmailService(next port.EmailService, metrics port.Metrics) port.EmailService {
+	return &InstrumentedEmailService{next: next, metrics: metrics}
+}
+
+func (s *InstrumentedEmailService) record(kind string, err error) error {
+	s.metrics.EmailSent(kind, err)
+	return err
+}
+
+func (s *InstrumentedEmailService) Send(ctx context.Context, template string, data interface{}, to port.EmailRecipient) error {
+	return s.record(template, s.next.Send(ctx, template, data, to))
+}
+
+func (s *InstrumentedEmailService) ForwardApplicationEmail(ctx context.Context, toEmail string, msg port.ApplicationMessage) error {
+	return s.record("application_forward", s.next.ForwardApplicationEmail(ctx, toEmail, msg))
+}
@@ -0,0 +1,85 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message is one outgoing email, ready for a transport.
+type Message struct {
+	From    string
+	To      string
+	ReplyTo string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Transport delivers rendered messages: Resend, SMTP, or one of the
+// development transports.
+type Transport interface {
+	Deliver(ctx context.Context, msg *Message) error
+}
+
+// buildMIME encodes msg as an RFC 5322 message with a text and an HTML
+// alternative, as sent over SMTP or saved to an .eml file.
+func buildMIME(msg *Message, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) { fmt.Fprintf(&buf, "%s: %s\r\n", key, value) }
+
+	domain := "localhost"
+	if from, err := mail.ParseAddress(msg.From); err == nil {
+		if at := strings.LastIndexByte(from.Address, '@'); at >= 0 {
+			domain = from.Address[at+1:]
+		}
+	}
+	header("From", msg.From)
+	header("To", msg.To)
+	if msg.ReplyTo != "" {
+		header("Reply-To", msg.ReplyTo)
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.New().String()+"@"+domain+">")
+	header("MIME-Version", "1.0")
+
+	parts := multipart.NewWriter(&buf)
+	header("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -18,7 +18,9 @@ func NewEmailNotificationService(emailService port.EmailService) port.Notificati
 }
 
 func (s *EmailNotificationService) NotifyJoinRequest(ctx context.Context, member *entity.StartupMember, startup *entity.Startup) error {
-	return s.emailService.SendJoinRequestNotification(ctx, member, startup)
+	// Startup owners' addresses aren't looked up yet; this goes to the platform inbox.
+	return s.emailService.Send(ctx, port.EmailJoinRequest, port.JoinRequestEmail{StartupName: startup.Name},
+		port.EmailRecipient{Email: "admin@startupboard.com"})
 }
 
 
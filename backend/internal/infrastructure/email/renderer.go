@@ -0,0 +1,169 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+//go:embed templates
+var builtinTemplates embed.FS
+
+// BuiltinTemplates returns the email templates compiled into the binary. Each
+// locale directory holds <name>.html, defining "content", and <name>.txt,
+// defining "subject" and "content"; layout.html and layout.txt wrap every
+// email's content. A directory laid out the same way can replace them.
+func BuiltinTemplates() fs.FS {
+	sub, err := fs.Sub(builtinTemplates, "templates")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// templateFuncs are available in every template.
+var templateFuncs = map[string]interface{}{
+	// date formats a time, or a pointer to one, as "January 2, 2006".
+	"date": func(v interface{}) string { return formatTime(v, "January 2, 2006") },
+	// datetime adds the UTC time of day, for deadlines shorter than a day.
+	"datetime": func(v interface{}) string { return formatTime(v, "January 2, 2006 at 15:04 MST") },
+}
+
+func formatTime(v interface{}, layout string) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC().Format(layout)
+	case *time.Time:
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(layout)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type localized struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Renderer holds every template, parsed once at startup.
+type Renderer struct {
+	defaultLocale string
+	templates     map[string]map[string]localized // name, then locale
+}
+
+var _ port.EmailRenderer = (*Renderer)(nil)
+
+// NewRenderer parses the templates in fsys, laid out as BuiltinTemplates. Every
+// template must exist in the default locale, so any lookup can fall back to it.
+func NewRenderer(fsys fs.FS, defaultLocale string) (*Renderer, error) {
+	layoutHTML, err := fs.ReadFile(fsys, "layout.html")
+	if err != nil {
+		return nil, err
+	}
+	layoutText, err := fs.ReadFile(fsys, "layout.txt")
+	if err != nil {
+		return nil, err
+	}
+	files, err := fs.Glob(fsys, "*/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Renderer{defaultLocale: defaultLocale, templates: map[string]map[string]localized{}}
+	for _, file := range files {
+		locale, name := path.Dir(file), strings.TrimSuffix(path.Base(file), ".html")
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		textContent, err := fs.ReadFile(fsys, path.Join(locale, name+".txt"))
+		if err != nil {
+			return nil, fmt.Errorf("email template %s/%s: %w", locale, name, err)
+		}
+		html, err := htmltemplate.New("layout").Funcs(templateFuncs).Option("missingkey=error").Parse(string(layoutHTML))
+		if err == nil {
+			_, err = html.Parse(string(content))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("email template %s/%s.html: %w", locale, name, err)
+		}
+		text, err := texttemplate.New("layout").Funcs(templateFuncs).Option("missingkey=error").Parse(string(layoutText))
+		if err == nil {
+			_, err = text.Parse(string(textContent))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("email template %s/%s.txt: %w", locale, name, err)
+		}
+		if text.Lookup("subject") == nil {
+			return nil, fmt.Errorf("email template %s/%s.txt: no subject", locale, name)
+		}
+		if r.templates[name] == nil {
+			r.templates[name] = map[string]localized{}
+		}
+		r.templates[name][locale] = localized{html: html, text: text}
+	}
+	for name, locales := range r.templates {
+		if _, ok := locales[defaultLocale]; !ok {
+			return nil, fmt.Errorf("email template %s has no %s version", name, defaultLocale)
+		}
+	}
+	return r, nil
+}
+
+func (r *Renderer) Templates() []port.EmailTemplate {
+	out := make([]port.EmailTemplate, 0, len(r.templates))
+	for name, locales := range r.templates {
+		t := port.EmailTemplate{Name: name, SampleData: sampleData[name]}
+		for locale := range locales {
+			t.Locales = append(t.Locales, locale)
+		}
+		sort.Strings(t.Locales)
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Render picks the closest locale the template has: the exact tag, then its
+// language ("pt" for "pt-BR"), then the default.
+func (r *Renderer) Render(name, locale string, data interface{}) (*port.RenderedEmail, error) {
+	locales, ok := r.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+	t, ok := locales[locale]
+	if !ok {
+		language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+		if t, ok = locales[language]; !ok {
+			t = locales[r.defaultLocale]
+		}
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("render %s text: %w", name, err)
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("render %s html: %w", name, err)
+	}
+	return &port.RenderedEmail{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		HTML:    html.String(),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}, nil
+}
@@ -0,0 +1,128 @@
+package email_test
+
+import (
+	"context"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/email"
+)
+
+func newRenderer(t *testing.T) *email.Renderer {
+	t.Helper()
+	r, err := email.NewRenderer(email.BuiltinTemplates(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// TestBuiltinTemplatesRender catches a template that references a field its
+// data type doesn't have, in any locale.
+func TestBuiltinTemplatesRender(t *testing.T) {
+	r := newRenderer(t)
+	for _, tmpl := range r.Templates() {
+		if tmpl.SampleData == nil {
+			t.Errorf("%s has no sample data", tmpl.Name)
+			continue
+		}
+		for _, locale := range tmpl.Locales {
+			out, err := r.Render(tmpl.Name, locale, tmpl.SampleData)
+			if err != nil {
+				t.Errorf("%s/%s: %v", tmpl.Name, locale, err)
+				continue
+			}
+			if out.Subject == "" || strings.Contains(out.Subject, "\n") {
+				t.Errorf("%s/%s: subject %q", tmpl.Name, locale, out.Subject)
+			}
+			if !strings.Contains(out.HTML, "<html>") || strings.TrimSpace(out.Text) == "" {
+				t.Errorf("%s/%s: missing layout or text part", tmpl.Name, locale)
+			}
+		}
+	}
+}
+
+func TestRenderFallsBackAndEscapes(t *testing.T) {
+	r := newRenderer(t)
+	data := port.TeamInvitationEmail{TeamName: `<b>Acme</b>`, InviteURL: "https://joinus.example/invitations/accept?token=t"}
+
+	de, err := r.Render(port.EmailTeamInvitation, "de-AT", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if de.Subject != "Einladung zum Team <b>Acme</b>" {
+		t.Fatalf("de-AT subject = %q", de.Subject)
+	}
+	if !strings.Contains(de.HTML, "&lt;b&gt;Acme&lt;/b&gt;") || strings.Contains(de.HTML, "<b>Acme") {
+		t.Fatalf("team name not escaped in HTML:\n%s", de.HTML)
+	}
+
+	fr, err := r.Render(port.EmailTeamInvitation, "fr", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(fr.Subject, "You've been invited") {
+		t.Fatalf("fr did not fall back to en: %q", fr.Subject)
+	}
+
+	if _, err := r.Render("no_such_template", "en", data); err == nil {
+		t.Fatal("expected an error for an unknown template")
+	}
+}
+
+// TestDatesRenderInUTC keeps a deadline's day from depending on the zone the
+// sending process runs in.
+func TestDatesRenderInUTC(t *testing.T) {
+	r := newRenderer(t)
+	expires := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC).In(time.FixedZone("UTC+2", 2*60*60))
+	out, err := r.Render(port.EmailVerification, "en", port.EmailVerificationEmail{Name: "Ann", ExpiresAt: expires})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.Text, "expires on March 1, 2026") {
+		t.Fatalf("text part:\n%s", out.Text)
+	}
+}
+
+func TestFileTransportWritesReadableEML(t *testing.T) {
+	dir := t.TempDir()
+	transport, err := email.NewFileTransport(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := email.NewService(newRenderer(t), transport, "JoinUs <noreply@joinus.example>")
+	err = svc.Send(context.Background(), port.EmailDomainVerification, port.DomainVerificationEmail{
+		StartupName: "Acme Größe", Domain: "acme.example", Code: "123456",
+	}, port.EmailRecipient{Email: "founder@acme.example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("wrote %d files", len(files))
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	msg, err := mail.ReadMessage(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Verify acme.example for Acme Größe on JoinUs" {
+		t.Fatalf("subject = %q, %v", subject, err)
+	}
+	if msg.Header.Get("To") != "founder@acme.example" ||
+		!strings.HasPrefix(msg.Header.Get("Content-Type"), "multipart/alternative") {
+		t.Fatalf("headers = %v", msg.Header)
+	}
+}
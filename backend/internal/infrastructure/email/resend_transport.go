@@ -0,0 +1,31 @@
+package email
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+// ResendTransport sends through Resend's HTTP API.
+type ResendTransport struct {
+	client *resend.Client
+}
+
+func NewResendTransport(apiKey string) *ResendTransport {
+	return &ResendTransport{client: resend.NewCustomClient(tracing.Client(http.DefaultClient), apiKey)}
+}
+
+func (t *ResendTransport) Deliver(ctx context.Context, msg *Message) error {
+	params := &resend.SendEmailRequest{
+		From:    msg.From,
+		To:      []string{msg.To},
+		ReplyTo: msg.ReplyTo,
+		Subject: msg.Subject,
+		Html:    msg.HTML,
+		Text:    msg.Text,
+	}
+	_, err := t.client.Emails.SendWithContext(ctx, params)
+	return err
+}
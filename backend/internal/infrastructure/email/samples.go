@@ -0,0 +1,43 @@
+package email
+
+import (
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+var sampleExpiry = time.Date(2030, time.March, 14, 0, 0, 0, 0, time.UTC)
+
+// sampleData fills each template for admin previews; its JSON form also
+// shows the keys custom preview data needs.
+var sampleData = map[string]interface{}{
+	port.EmailTeamInvitation: port.TeamInvitationEmail{
+		TeamName: "Acme Robotics", InviteURL: "https://joinus.example/invitations/accept?token=sample",
+	},
+	port.EmailStartupInvitation: port.StartupInvitationEmail{
+		StartupName: "Acme Robotics", Role: "recruiter", InviteURL: "https://joinus.example/invitations/sample/accept",
+	},
+	port.EmailJoinRequest: port.JoinRequestEmail{StartupName: "Acme Robotics"},
+	port.EmailVerification: port.EmailVerificationEmail{
+		Name: "Ada Lovelace", VerifyURL: "https://joinus.example/verify-email?token=sample", ExpiresAt: sampleExpiry,
+	},
+	port.EmailPasswordReset: port.PasswordResetEmail{
+		Name: "Ada Lovelace", ResetURL: "https://joinus.example/reset-password?token=sample", ExpiresAt: sampleExpiry,
+	},
+	port.EmailPasswordChanged: port.PasswordChangedEmail{
+		Name: "Ada Lovelace", ChangedAt: sampleExpiry, ResetURL: "https://joinus.example/forgot-password",
+	},
+	port.EmailDomainVerification: port.DomainVerificationEmail{
+		StartupName: "Acme Robotics", Domain: "acme.example", Code: "482913",
+	},
+	port.EmailJobReminder: port.JobReminderEmail{
+		Kind: entity.JobReminderExpiring, JobTitle: "Senior Backend Engineer", StartupName: "Acme Robotics",
+		ExpiresAt: &sampleExpiry, ExtendURL: "https://joinus.example/job-actions/extend",
+		FillURL: "https://joinus.example/job-actions/fill", CloseURL: "https://joinus.example/job-actions/close",
+	},
+	port.EmailFollowDigest: port.FollowDigestEmail{Items: []port.FollowDigestItem{
+		{StartupName: "Acme Robotics", JobTitle: "Senior Backend Engineer", JobURL: "https://joinus.example/jobs/1"},
+		{StartupName: "Globex", JobTitle: "Product Designer", JobURL: "https://joinus.example/jobs/2"},
+	}, UnsubscribeURL: "https://joinus.example/follows/unsubscribe?token=sample"},
+}
@@ -0,0 +1,25 @@
+package email
+
+import (
+	"fmt"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/config"
+)
+
+func NewTransport(cfg config.EmailConfig) (Transport, error) {
+	switch cfg.Transport {
+	case "resend":
+		return NewResendTransport(cfg.ResendAPIKey), nil
+	case "smtp":
+		if cfg.SMTP.Host == "" {
+			return nil, fmt.Errorf("SMTP transport requires SMTP_HOST")
+		}
+		return NewSMTPTransport(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password), nil
+	case "file":
+		return NewFileTransport(cfg.FileDir)
+	case "memory":
+		return NewMemoryTransport(), nil
+	default:
+		return nil, fmt.Errorf("unknown email transport %q", cfg.Transport)
+	}
+}
@@ -0,0 +1,66 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+type subscription struct {
+	name   string
+	handle port.EventHandler
+}
+
+// Bus is the in-process event bus the outbox relay dispatches to. Handlers
+// are registered once at startup, by name, for the event types they need.
+type Bus struct {
+	mu   sync.RWMutex
+	subs map[entity.DomainEventType][]subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: map[entity.DomainEventType][]subscription{}}
+}
+
+var _ port.EventDispatcher = (*Bus)(nil)
+
+// Subscribe registers handle for the given event types. The name appears in
+// errors so a failing subscriber can be told apart from the others.
+func (b *Bus) Subscribe(name string, handle port.EventHandler, types ...entity.DomainEventType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, t := range types {
+		b.subs[t] = append(b.subs[t], subscription{name: name, handle: handle})
+	}
+}
+
+// Dispatch runs every subscriber of the event's type, even after one fails,
+// and returns their errors joined. An event nobody subscribes to succeeds.
+func (b *Bus) Dispatch(ctx context.Context, event *entity.DomainEvent) error {
+	b.mu.RLock()
+	subs := b.subs[event.Type]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, s := range subs {
+		if err := run(ctx, s, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// run turns a handler panic into an error, so one bad handler can't take
+// the relay down with it.
+func run(ctx context.Context, s subscription, event *entity.DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.handle(ctx, event)
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusDegraded     = "degraded" // an optional component is failing
+	StatusFailing      = "failing"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Check is one component readiness depends on.
+type Check struct {
+	Name string
+	// Critical checks fail readiness; the others only mark it degraded.
+	Critical bool
+	// Timeout bounds Run; zero uses the checker's default.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// ComponentStatus is the outcome of one check.
+type ComponentStatus struct {
+	Status     string `json:"status"`
+	Critical   bool   `json:"critical"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// Report is the readiness answer for the whole service.
+type Report struct {
+	Status string                     `json:"status"`
+	Checks map[string]ComponentStatus `json:"checks,omitempty"`
+}
+
+// Ready reports whether the service should receive traffic.
+func (r Report) Ready() bool {
+	return r.Status == StatusOK || r.Status == StatusDegraded
+}
+
+// Checker runs the registered checks concurrently, each under its own
+// timeout. Once shutdown starts it reports not ready without running them.
+type Checker struct {
+	timeout      time.Duration
+	checks       []Check
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	return &Checker{timeout: timeout}
+}
+
+// Add registers a check. It is not safe to call once Check is being served.
+func (c *Checker) Add(check Check) {
+	c.checks = append(c.checks, check)
+}
+
+// SetShuttingDown makes every later report fail, so load balancers stop
+// routing here while in-flight requests drain.
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+func (c *Checker) Check(ctx context.Context) Report {
+	if c.shuttingDown.Load() {
+		return Report{Status: StatusShuttingDown}
+	}
+
+	results := make([]ComponentStatus, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]ComponentStatus, len(c.checks))}
+	for i, check := range c.checks {
+		report.Checks[check.Name] = results[i]
+		if results[i].Status == StatusOK {
+			continue
+		}
+		if check.Critical {
+			report.Status = StatusFailing
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, check Check) ComponentStatus {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = c.timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- check.Run(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// Drivers that ignore the context must not hold up the probe.
+		err = ctx.Err()
+	}
+
+	status := ComponentStatus{Status: StatusOK, Critical: check.Critical, DurationMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		status.Status = StatusFailing
+		status.Error = err.Error()
+	}
+	return status
+}
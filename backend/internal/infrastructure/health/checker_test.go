@@ -0,0 +1,80 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/health"
+)
+
+func ok(context.Context) error { return nil }
+
+func TestCheckerStatus(t *testing.T) {
+	fail := func(context.Context) error { return errors.New("down") }
+
+	tests := []struct {
+		name   string
+		checks []health.Check
+		want   string
+		ready  bool
+	}{
+		{"all ok", []health.Check{{Name: "db", Critical: true, Run: ok}}, health.StatusOK, true},
+		{"optional failing", []health.Check{{Name: "db", Critical: true, Run: ok}, {Name: "email", Run: fail}}, health.StatusDegraded, true},
+		{"critical failing", []health.Check{{Name: "db", Critical: true, Run: fail}, {Name: "email", Run: ok}}, health.StatusFailing, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := health.NewChecker(time.Second)
+			for _, check := range tt.checks {
+				c.Add(check)
+			}
+			report := c.Check(context.Background())
+			if report.Status != tt.want || report.Ready() != tt.ready {
+				t.Fatalf("status %q ready %v, want %q %v", report.Status, report.Ready(), tt.want, tt.ready)
+			}
+			if len(report.Checks) != len(tt.checks) {
+				t.Fatalf("%d components reported, want %d", len(report.Checks), len(tt.checks))
+			}
+		})
+	}
+}
+
+func TestCheckerTimesOutHungCheck(t *testing.T) {
+	c := health.NewChecker(time.Second)
+	block := make(chan struct{})
+	defer close(block)
+	c.Add(health.Check{Name: "storage", Critical: true, Timeout: 20 * time.Millisecond, Run: func(context.Context) error {
+		<-block // ignores its context
+		return nil
+	}})
+
+	start := time.Now()
+	report := c.Check(context.Background())
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("check took %v", elapsed)
+	}
+	got := report.Checks["storage"]
+	if report.Ready() || got.Status != health.StatusFailing || got.Error == "" {
+		t.Fatalf("report = %+v", report)
+	}
+}
+
+func TestCheckerFailsDuringShutdown(t *testing.T) {
+	c := health.NewChecker(time.Second)
+	c.Add(health.Check{Name: "db", Critical: true, Run: ok})
+	c.SetShuttingDown()
+
+	if report := c.Check(context.Background()); report.Ready() || report.Status != health.StatusShuttingDown {
+		t.Fatalf("report = %+v", report)
+	}
+}
+
+func TestConfiguredNamesMissingSettings(t *testing.T) {
+	check := health.Configured("stripe", true, map[string]string{"STRIPE_SECRET_KEY": "", "STRIPE_WEBHOOK_SECRET": "", "X": "set"})
+	err := check.Run(context.Background())
+	if err == nil || err.Error() != "not configured: STRIPE_SECRET_KEY, STRIPE_WEBHOOK_SECRET" {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+// Database pings the connection pool.
+func Database(db *sql.DB) Check {
+	return Check{Name: "database", Critical: true, Run: db.PingContext}
+}
+
+// PendingMigrations is implemented by migration.Migrator.
+type PendingMigrations interface {
+	Pending(ctx context.Context) (int, error)
+}
+
+// Migrations fails while the schema is behind the binary.
+func Migrations(m PendingMigrations) Check {
+	return Check{Name: "migrations", Critical: true, Run: func(ctx context.Context) error {
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("%d migrations pending", pending)
+		}
+		return nil
+	}}
+}
+
+// Storage fails when no backend could be initialized and otherwise asks the
+// backend to reach its bucket, when it knows how.
+func Storage(s port.StorageService) Check {
+	return Check{Name: "storage", Critical: true, Run: func(ctx context.Context) error {
+		if s == nil {
+			return errors.New("storage is not initialized")
+		}
+		if pinger, ok := s.(port.StoragePinger); ok {
+			return pinger.Ping(ctx)
+		}
+		return nil
+	}}
+}
+
+// Configured fails when any of the settings, keyed by environment variable,
+// is empty.
+func Configured(name string, critical bool, settings map[string]string) Check {
+	return Check{Name: name, Critical: critical, Run: func(context.Context) error {
+		var missing []string
+		for env, value := range settings {
+			if value == "" {
+				missing = append(missing, env)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("not configured: %s", strings.Join(missing, ", "))
+	}}
+}
@@ -0,0 +1,136 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	// maxPixels bounds the decoded size so a small, highly compressed file
+	// cannot expand into gigabytes of pixels.
+	maxPixels   = 25_000_000
+	jpegQuality = 90
+)
+
+type decoder struct {
+	decode       func([]byte) (image.Image, error)
+	decodeConfig func([]byte) (image.Config, error)
+}
+
+// Only these formats are accepted; the registry in package image is not used
+// so nothing else linked into the binary widens the attack surface.
+var decoders = map[string]decoder{
+	"jpeg": {
+		decode:       func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) },
+		decodeConfig: func(b []byte) (image.Config, error) { return jpeg.DecodeConfig(bytes.NewReader(b)) },
+	},
+	"png": {
+		decode:       func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) },
+		decodeConfig: func(b []byte) (image.Config, error) { return png.DecodeConfig(bytes.NewReader(b)) },
+	},
+	"gif": {
+		decode:       func(b []byte) (image.Image, error) { return gif.Decode(bytes.NewReader(b)) },
+		decodeConfig: func(b []byte) (image.Config, error) { return gif.DecodeConfig(bytes.NewReader(b)) },
+	},
+	"webp": {
+		decode:       func(b []byte) (image.Image, error) { return webp.Decode(bytes.NewReader(b)) },
+		decodeConfig: func(b []byte) (image.Config, error) { return webp.DecodeConfig(bytes.NewReader(b)) },
+	},
+}
+
+type Processor struct{}
+
+func NewProcessor() port.ImageProcessor {
+	return &Processor{}
+}
+
+// Process decodes data by sniffing its signature, re-encodes it (JPEG stays
+// JPEG, everything else becomes PNG; GIFs keep their first frame) and renders
+// a WebP and PNG variant per size that fits within a size×size box.
+func (p *Processor) Process(data []byte, sizes []int) (*port.ProcessedImage, error) {
+	format := sniffFormat(data)
+	dec, ok := decoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported image format")
+	}
+	cfg, err := dec.decodeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s header: %w", format, err)
+	}
+	if cfg.Width < 1 || cfg.Height < 1 || cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("image dimensions %dx%d not allowed", cfg.Width, cfg.Height)
+	}
+	img, err := dec.decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", format, err)
+	}
+
+	out := &port.ProcessedImage{Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+		out.MimeType, out.Extension = "image/jpeg", ".jpg"
+	} else {
+		err = png.Encode(&buf, img)
+		out.MimeType, out.Extension = "image/png", ".png"
+	}
+	if err != nil {
+		return nil, err
+	}
+	out.Data = buf.Bytes()
+
+	for _, size := range sizes {
+		scaled := fit(img, size)
+		var webpBuf, pngBuf bytes.Buffer
+		if err := EncodeWebP(&webpBuf, scaled); err != nil {
+			return nil, err
+		}
+		if err := png.Encode(&pngBuf, scaled); err != nil {
+			return nil, err
+		}
+		out.Variants = append(out.Variants,
+			port.ImageVariant{Size: size, Format: "webp", MimeType: "image/webp", Data: webpBuf.Bytes()},
+			port.ImageVariant{Size: size, Format: "png", MimeType: "image/png", Data: pngBuf.Bytes()},
+		)
+	}
+	return out, nil
+}
+
+// fit scales img down to fit within size×size, keeping the aspect ratio.
+// Smaller images are not upscaled.
+func fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/w)
+		} else {
+			w, h = max(1, w*size/h), size
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+func sniffFormat(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return "jpeg"
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return "png"
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return "gif"
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return "webp"
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/imaging"
+	"golang.org/x/image/webp"
+)
+
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 7), G: uint8(y * 3), B: uint8(x ^ y), A: uint8(255 - x%64)})
+		}
+	}
+	return img
+}
+
+func TestEncodeWebPRoundTrip(t *testing.T) {
+	for _, src := range []*image.NRGBA{
+		testImage(97, 41),
+		image.NewNRGBA(image.Rect(0, 0, 3, 5)), // single-symbol channels
+	} {
+		var buf bytes.Buffer
+		if err := imaging.EncodeWebP(&buf, src); err != nil {
+			t.Fatal(err)
+		}
+		got, err := webp.Decode(&buf)
+		if err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if got.Bounds() != src.Bounds() {
+			t.Fatalf("bounds = %v, want %v", got.Bounds(), src.Bounds())
+		}
+		for y := 0; y < src.Rect.Dy(); y++ {
+			for x := 0; x < src.Rect.Dx(); x++ {
+				want := src.NRGBAAt(x, y)
+				if have := color.NRGBAModel.Convert(got.At(x, y)).(color.NRGBA); have != want && want.A != 0 {
+					t.Fatalf("pixel (%d,%d) = %v, want %v", x, y, have, want)
+				}
+			}
+		}
+	}
+}
+
+func TestProcessStripsTrailingPayloadAndBuildsVariants(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(300, 150)); err != nil {
+		t.Fatal(err)
+	}
+	polyglot := append(buf.Bytes(), []byte("<script>alert(1)</script>")...)
+
+	out, err := imaging.NewProcessor().Process(polyglot, []int{64, 256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(out.Data, []byte("<script>")) {
+		t.Fatal("re-encoded image kept the trailing payload")
+	}
+	if out.MimeType != "image/png" || out.Width != 300 || out.Height != 150 {
+		t.Fatalf("unexpected output %s %dx%d", out.MimeType, out.Width, out.Height)
+	}
+	if len(out.Variants) != 4 {
+		t.Fatalf("got %d variants, want 4", len(out.Variants))
+	}
+	for _, v := range out.Variants {
+		var cfg image.Config
+		var err error
+		if v.Format == "webp" {
+			cfg, err = webp.DecodeConfig(bytes.NewReader(v.Data))
+		} else {
+			cfg, err = png.DecodeConfig(bytes.NewReader(v.Data))
+		}
+		if err != nil {
+			t.Fatalf("%d %s: %v", v.Size, v.Format, err)
+		}
+		if cfg.Width != v.Size || cfg.Height != v.Size/2 {
+			t.Fatalf("%d %s is %dx%d", v.Size, v.Format, cfg.Width, cfg.Height)
+		}
+	}
+}
+
+func TestProcessRejectsUndecodableImages(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"truncated png": []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"),
+		"fake jpeg":     append([]byte("\xff\xd8\xff\xe0"), bytes.Repeat([]byte{0}, 64)...),
+		"svg":           []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`),
+		"bmp":           []byte("BM\x00\x00\x00\x00"),
+	} {
+		if _, err := imaging.NewProcessor().Process(data, []int{64}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package imaging
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+)
+
+// EncodeWebP writes img as a lossless WebP (VP8L) file.
+//
+// There is no pure-Go WebP encoder in the standard library or x/image, so
+// this is a deliberately small one: the subtract-green transform followed by
+// per-channel Huffman coding of literal pixels. It has no backward references
+// or predictors, so files are larger than libwebp's, but for logo-sized
+// variants it is comparable to PNG and needs no cgo.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > 1<<14 || height > 1<<14 {
+		return errors.New("webp: image dimensions out of range")
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(nrgba, nrgba.Rect, img, b.Min, draw.Src)
+
+	// Subtract green from red and blue, then count symbols per channel.
+	pix := nrgba.Pix
+	opaque := true
+	var hist [4][]int
+	hist[0] = make([]int, 256+24) // green plus the (unused) length prefixes
+	for i := 1; i < 4; i++ {
+		hist[i] = make([]int, 256)
+	}
+	for i := 0; i < len(pix); i += 4 {
+		g := pix[i+1]
+		pix[i] -= g
+		pix[i+2] -= g
+		hist[0][g]++
+		hist[1][pix[i]]++
+		hist[2][pix[i+2]]++
+		hist[3][pix[i+3]]++
+		if pix[i+3] != 0xff {
+			opaque = false
+		}
+	}
+
+	var bw bitWriter
+	bw.write(0x2f, 8)
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	if opaque {
+		bw.write(0, 1)
+	} else {
+		bw.write(1, 1)
+	}
+	bw.write(0, 3) // version
+
+	bw.write(1, 1) // transform present
+	bw.write(2, 2) // SUBTRACT_GREEN
+	bw.write(0, 1) // no more transforms
+
+	bw.write(0, 1) // no color cache
+	bw.write(0, 1) // no meta prefix codes
+
+	var codes [4]prefixCode
+	for i := range codes {
+		codes[i] = writePrefixCode(&bw, hist[i])
+	}
+	writePrefixCode(&bw, make([]int, 40)) // distance code, never used
+
+	// Channel order in the bitstream is green, red, blue, alpha.
+	for i := 0; i < len(pix); i += 4 {
+		codes[0].emit(&bw, pix[i+1])
+		codes[1].emit(&bw, pix[i])
+		codes[2].emit(&bw, pix[i+2])
+		codes[3].emit(&bw, pix[i+3])
+	}
+	data := bw.flush()
+
+	var out bytes.Buffer
+	chunkSize := len(data)
+	padded := chunkSize + chunkSize&1
+	out.WriteString("RIFF")
+	binary.Write(&out, binary.LittleEndian, uint32(4+8+padded))
+	out.WriteString("WEBPVP8L")
+	binary.Write(&out, binary.LittleEndian, uint32(chunkSize))
+	out.Write(data)
+	if chunkSize&1 == 1 {
+		out.WriteByte(0)
+	}
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// bitWriter packs values least-significant bit first, as VP8L expects.
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (w *bitWriter) write(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+func (w *bitWriter) flush() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
+
+// prefixCode maps symbols to bit-reversed canonical Huffman codes. A code
+// with a single symbol costs zero bits per symbol.
+type prefixCode struct {
+	codes   []uint32
+	lengths []uint8
+}
+
+func (c *prefixCode) emit(w *bitWriter, symbol uint8) {
+	if n := c.lengths[symbol]; n > 0 {
+		w.write(c.codes[symbol], uint(n))
+	}
+}
+
+// writePrefixCode writes the code for the histogram and returns it.
+func writePrefixCode(w *bitWriter, hist []int) prefixCode {
+	var used []int
+	for s, n := range hist {
+		if n > 0 {
+			used = append(used, s)
+		}
+	}
+	// Simple codes cover up to two 8-bit symbols.
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < 256) {
+		if len(used) == 0 {
+			used = []int{0}
+		}
+		w.write(1, 1)
+		w.write(uint32(len(used)-1), 1)
+		w.write(1, 1) // first symbol uses 8 bits
+		w.write(uint32(used[0]), 8)
+		lengths := make([]uint8, len(hist))
+		codes := make([]uint32, len(hist))
+		if len(used) == 2 {
+			w.write(uint32(used[1]), 8)
+			lengths[used[0]], lengths[used[1]] = 1, 1
+			codes[used[1]] = 1
+		}
+		return prefixCode{codes: codes, lengths: lengths}
+	}
+
+	lengths := huffmanLengths(hist, 15)
+
+	// Code lengths are themselves Huffman coded; only literal lengths 0-15
+	// are used, without the run-length symbols.
+	clHist := make([]int, 19)
+	for _, l := range lengths {
+		clHist[l]++
+	}
+	clLengths := huffmanLengths(padToTwo(clHist), 7)
+	clCodes := canonicalCodes(clLengths)
+
+	order := [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	numCodes := 19
+	for numCodes > 4 && clLengths[order[numCodes-1]] == 0 {
+		numCodes--
+	}
+	w.write(0, 1) // normal code
+	w.write(uint32(numCodes-4), 4)
+	for i := 0; i < numCodes; i++ {
+		w.write(uint32(clLengths[order[i]]), 3)
+	}
+	w.write(0, 1) // max_symbol = alphabet size
+	for _, l := range lengths {
+		w.write(clCodes[l], uint(clLengths[l]))
+	}
+	return prefixCode{codes: canonicalCodes(lengths), lengths: lengths}
+}
+
+// padToTwo gives a zero-frequency symbol a count so the code has at least two
+// symbols and every emitted length costs at least one bit.
+func padToTwo(hist []int) []int {
+	used := 0
+	for _, n := range hist {
+		if n > 0 {
+			used++
+		}
+	}
+	if used >= 2 {
+		return hist
+	}
+	out := append([]int(nil), hist...)
+	for s := range out {
+		if out[s] == 0 {
+			out[s] = 1
+			break
+		}
+	}
+	return out
+}
+
+// canonicalCodes assigns canonical Huffman codes (shorter first, then by
+// symbol) and bit-reverses them for the LSB-first writer.
+func canonicalCodes(lengths []uint8) []uint32 {
+	var count [16]uint32
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+	var next [16]uint32
+	code := uint32(0)
+	for l := 1; l < 16; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+	codes := make([]uint32, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		c := next[l]
+		next[l]++
+		var rev uint32
+		for i := uint8(0); i < l; i++ {
+			rev = rev<<1 | (c>>i)&1
+		}
+		codes[s] = rev
+	}
+	return codes
+}
+
+type huffNode struct {
+	weight int
+	symbol int // -1 for internal nodes
+	left   *huffNode
+	right  *huffNode
+}
+
+type huffHeap []*huffNode
+
+func (h huffHeap) Len() int { return len(h) }
+func (h huffHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].symbol < h[j].symbol
+}
+func (h huffHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffHeap) Push(x any)   { *h = append(*h, x.(*huffNode)) }
+func (h *huffHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// huffmanLengths builds code lengths no longer than maxLen. When the optimal
+// tree is too deep the counts are flattened and the tree rebuilt, which is
+// what libwebp does as well.
+func huffmanLengths(hist []int, maxLen uint8) []uint8 {
+	counts := append([]int(nil), hist...)
+	for {
+		lengths := make([]uint8, len(counts))
+		h := huffHeap{}
+		for s, n := range counts {
+			if n > 0 {
+				h = append(h, &huffNode{weight: n, symbol: s})
+			}
+		}
+		if len(h) == 1 {
+			lengths[h[0].symbol] = 1
+			return lengths
+		}
+		heap.Init(&h)
+		for h.Len() > 1 {
+			a := heap.Pop(&h).(*huffNode)
+			b := heap.Pop(&h).(*huffNode)
+			heap.Push(&h, &huffNode{weight: a.weight + b.weight, symbol: -1, left: a, right: b})
+		}
+		deepest := assignLengths(h[0], 0, lengths)
+		if deepest <= int(maxLen) {
+			return lengths
+		}
+		for s, n := range counts {
+			if n > 0 {
+				// Converges to all ones, a balanced tree that always fits.
+				counts[s] = n/2 + 1
+			}
+		}
+	}
+}
+
+func assignLengths(n *huffNode, depth int, lengths []uint8) int {
+	if n.symbol >= 0 {
+		lengths[n.symbol] = uint8(depth)
+		return depth
+	}
+	l := assignLengths(n.left, depth+1, lengths)
+	r := assignLengths(n.right, depth+1, lengths)
+	if l > r {
+		return l
+	}
+	return r
+}
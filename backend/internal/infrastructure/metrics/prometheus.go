@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/cache"
+)
+
+// Prometheus keeps the backend's metrics in a private registry, so tests and
+// several instances in one process don't collide on the global one.
+type Prometheus struct {
+	registry       *prometheus.Registry
+	httpDuration   *prometheus.HistogramVec
+	rateLimited    *prometheus.CounterVec
+	stripeWebhooks *prometheus.CounterVec
+	emails         *prometheus.CounterVec
+}
+
+var _ port.Metrics = (*Prometheus)(nil)
+
+func NewPrometheus() *Prometheus {
+	m := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by route template and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rate_limit_rejections_total",
+			Help: "Requests answered 429, by rate-limit bucket.",
+		}, []string{"bucket"}),
+		stripeWebhooks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "stripe_webhooks_total",
+			Help: "Stripe webhook events by type and outcome.",
+		}, []string{"event_type", "outcome"}),
+		emails: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "emails_sent_total",
+			Help: "Email send attempts by kind and result.",
+		}, []string{"kind", "result"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration, m.rateLimited, m.stripeWebhooks, m.emails,
+	)
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// WatchDB exports connection pool statistics.
+func (m *Prometheus) WatchDB(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, "postgres"))
+}
+
+// WatchResponseCache exports the public response cache's lookup counters.
+func (m *Prometheus) WatchResponseCache(c *cache.MemoryResponseCache) {
+	m.registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "response_cache_hits_total", Help: "Public responses served from the cache.",
+		}, func() float64 { return float64(c.Stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "response_cache_misses_total", Help: "Public response cache lookups that missed.",
+		}, func() float64 { return float64(c.Stats().Misses) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "response_cache_entries", Help: "Responses currently cached.",
+		}, func() float64 { return float64(c.Stats().Entries) }),
+	)
+}
+
+func (m *Prometheus) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = "unmatched"
+	}
+	m.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func (m *Prometheus) RateLimitRejected(bucket string) {
+	m.rateLimited.WithLabelValues(bucket).Inc()
+}
+
+func (m *Prometheus) StripeWebhookHandled(eventType, outcome string) {
+	m.stripeWebhooks.WithLabelValues(eventType, outcome).Inc()
+}
+
+func (m *Prometheus) EmailSent(kind string, err error) {
+	result := "sent"
+	if err != nil {
+		result = "failed"
+	}
+	m.emails.WithLabelValues(kind, result).Inc()
+}
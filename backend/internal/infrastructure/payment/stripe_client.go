@@ -1,7 +1,12 @@
 package payment
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/infrastructure/config"
+	"github.com/startup-job-board/backend/pkg/tracing"
 	"github.com/stripe/stripe-go/v82"
 	"github.com/stripe/stripe-go/v82/checkout/session"
 	"github.com/stripe/stripe-go/v82/webhook"
@@ -19,6 +24,8 @@ type StripeClient struct {
 
 func NewStripeClient(cfg config.StripeConfig) *StripeClient {
 	stripe.Key = cfg.SecretKey
+	// Same timeout as the SDK's default client.
+	stripe.SetHTTPClient(tracing.Client(&http.Client{Timeout: 80 * time.Second}))
 	return &StripeClient{webhookSecret: cfg.WebhookSecret}
 }
 
@@ -38,7 +45,7 @@ type CheckoutSessionOutput struct {
 	URL string
 }
 
-func (s *StripeClient) CreateCheckoutSession(input CheckoutSessionInput) (*CheckoutSessionOutput, error) {
+func (s *StripeClient) CreateCheckoutSession(ctx context.Context, input CheckoutSessionInput) (*CheckoutSessionOutput, error) {
 	params := &stripe.CheckoutSessionParams{
 		Mode:       stripe.String(input.Mode),
 		SuccessURL: stripe.String(input.SuccessURL),
@@ -51,6 +58,7 @@ func (s *StripeClient) CreateCheckoutSession(input CheckoutSessionInput) (*Check
 		},
 		Metadata: input.Metadata,
 	}
+	params.Context = ctx
 
 	if input.CustomerID != "" {
 		params.Customer = stripe.String(input.CustomerID)
@@ -0,0 +1,23 @@
+package gorm_model
+
+import "time"
+
+type BackgroundJob struct {
+	ID          string    `gorm:"type:uuid;primary_key"`
+	Queue       string    `gorm:"type:varchar(50);not null;index:idx_background_jobs_due,priority:1"`
+	Kind        string    `gorm:"type:varchar(100);not null;index"`
+	Payload     string    `gorm:"type:text;not null"`
+	Status      string    `gorm:"type:varchar(20);not null;index:idx_background_jobs_due,priority:2"`
+	Attempts    int       `gorm:"not null;default:0"`
+	MaxAttempts int       `gorm:"not null"`
+	RunAt       time.Time `gorm:"not null;index:idx_background_jobs_due,priority:3"`
+	UniqueKey   *string   `gorm:"type:varchar(255)"`
+	LastError   string    `gorm:"type:text"`
+	LockedUntil *time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	CreatedAt   time.Time `gorm:"index"`
+	UpdatedAt   time.Time
+}
+
+func (BackgroundJob) TableName() string { return "background_jobs" }
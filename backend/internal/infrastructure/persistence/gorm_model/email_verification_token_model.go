@@ -0,0 +1,14 @@
+package gorm_model
+
+import "time"
+
+type EmailVerificationToken struct {
+	ID        string    `gorm:"type:uuid;primary_key"`
+	UserID    string    `gorm:"type:uuid;not null;index"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (EmailVerificationToken) TableName() string { return "email_verification_tokens" }
@@ -5,13 +5,14 @@ import (
 )
 
 type File struct {
-	ID         string    `gorm:"type:uuid;primary_key"`
-	FileName   string    `gorm:"type:varchar(255);not null"`
-	FileSize   int64     `gorm:"type:bigint;not null"`
-	MimeType   string    `gorm:"type:varchar(100);not null"`
-	StorageKey string    `gorm:"type:varchar(500);not null"`
-	URL        string    `gorm:"type:varchar(500);not null"`
-	UploadedBy string    `gorm:"type:uuid;not null"`
+	ID         string        `gorm:"type:uuid;primary_key"`
+	FileName   string        `gorm:"type:varchar(255);not null"`
+	FileSize   int64         `gorm:"type:bigint;not null"`
+	MimeType   string        `gorm:"type:varchar(100);not null"`
+	StorageKey string        `gorm:"type:varchar(500);not null"`
+	URL        string        `gorm:"type:varchar(500);not null"`
+	UploadedBy string        `gorm:"type:uuid;not null"`
+	Variants   []FileVariant `gorm:"foreignKey:FileID;constraint:OnDelete:CASCADE"`
 	CreatedAt  time.Time
 }
 
@@ -19,5 +20,28 @@ func (File) TableName() string {
 	return "files"
 }
 
+type FileVariant struct {
+	ID         string `gorm:"type:uuid;primary_key"`
+	FileID     string `gorm:"type:uuid;not null;index"`
+	Size       int    `gorm:"not null"`
+	Format     string `gorm:"type:varchar(10);not null"`
+	MimeType   string `gorm:"type:varchar(100);not null"`
+	StorageKey string `gorm:"type:varchar(500);not null"`
+	URL        string `gorm:"type:varchar(500);not null"`
+}
 
+func (FileVariant) TableName() string {
+	return "file_variants"
+}
 
+type FileReference struct {
+	ID        string `gorm:"type:uuid;primary_key"`
+	FileID    string `gorm:"type:uuid;not null;index;uniqueIndex:idx_file_references_owner_file,priority:3"`
+	OwnerType string `gorm:"type:varchar(30);not null;uniqueIndex:idx_file_references_owner_file,priority:1"`
+	OwnerID   string `gorm:"type:uuid;not null;uniqueIndex:idx_file_references_owner_file,priority:2"`
+	CreatedAt time.Time
+}
+
+func (FileReference) TableName() string {
+	return "file_references"
+}
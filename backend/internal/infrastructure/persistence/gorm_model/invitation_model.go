@@ -14,6 +14,7 @@ type Invitation struct {
 	ExpiresAt time.Time  `gorm:"type:timestamp;not null"`
 	AcceptedAt *time.Time `gorm:"type:timestamp"`
 	Status    string     `gorm:"type:varchar(50);not null;default:'pending'"`
+	Locale    string     `gorm:"type:varchar(16);not null;default:''"`
 	CreatedAt time.Time
 }
 
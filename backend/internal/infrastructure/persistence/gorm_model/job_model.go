@@ -19,9 +19,12 @@ type Job struct {
 	Currency        string     `gorm:"type:varchar(3);not null"`
 	ApplicationURL  *string    `gorm:"type:varchar(500)"`
 	ApplicationEmail *string   `gorm:"type:varchar(255)"`
+	ApplyRelayToken *string    `gorm:"type:varchar(32);uniqueIndex"`
 	Status          string     `gorm:"type:varchar(50);not null;default:'active'"`
 	ExpiresAt       *time.Time `gorm:"type:timestamp"`
 	BoostedUntil    *time.Time `gorm:"type:timestamp;index"`
+	ExpiryReminderSentAt *time.Time `gorm:"type:timestamp"`
+	StaleReminderSentAt  *time.Time `gorm:"type:timestamp"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -0,0 +1,23 @@
+package gorm_model
+
+import "time"
+
+type OutboxEvent struct {
+	ID            string    `gorm:"type:uuid;primary_key"`
+	Type          string    `gorm:"type:varchar(50);not null"`
+	AggregateType string    `gorm:"type:varchar(30);not null"`
+	AggregateID   string    `gorm:"type:uuid;not null"`
+	StartupID     *string   `gorm:"type:uuid"`
+	TeamID        *string   `gorm:"type:uuid"`
+	Payload       string    `gorm:"type:text;not null"`
+	Status        string    `gorm:"type:varchar(20);not null;index:idx_outbox_events_due,priority:1"`
+	Attempts      int       `gorm:"not null;default:0"`
+	NextAttemptAt time.Time `gorm:"not null;index:idx_outbox_events_due,priority:2"`
+	LastError     string    `gorm:"type:text"`
+	ProcessedAt   *time.Time
+	OccurredAt    time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (OutboxEvent) TableName() string { return "outbox_events" }
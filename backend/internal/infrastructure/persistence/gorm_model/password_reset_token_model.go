@@ -0,0 +1,14 @@
+package gorm_model
+
+import "time"
+
+type PasswordResetToken struct {
+	ID        string    `gorm:"type:uuid;primary_key"`
+	UserID    string    `gorm:"type:uuid;not null;index"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (PasswordResetToken) TableName() string { return "password_reset_tokens" }
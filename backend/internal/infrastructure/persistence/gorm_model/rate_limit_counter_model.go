@@ -0,0 +1,13 @@
+package gorm_model
+
+import "time"
+
+// RateLimitCounter is one fixed window of a shared rate-limit key.
+type RateLimitCounter struct {
+	Key         string    `gorm:"type:varchar(255);primaryKey"`
+	WindowStart time.Time `gorm:"primaryKey"`
+	Count       int       `gorm:"not null;default:0"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+}
+
+func (RateLimitCounter) TableName() string { return "rate_limit_counters" }
@@ -0,0 +1,13 @@
+package gorm_model
+
+import "time"
+
+type SlugHistory struct {
+	ID        string `gorm:"type:uuid;primary_key"`
+	OwnerType string `gorm:"type:varchar(20);not null;uniqueIndex:idx_slug_history_owner_type_slug"`
+	OwnerID   string `gorm:"type:uuid;not null;index"`
+	Slug      string `gorm:"type:varchar(255);not null;uniqueIndex:idx_slug_history_owner_type_slug"`
+	CreatedAt time.Time
+}
+
+func (SlugHistory) TableName() string { return "slug_history" }
@@ -0,0 +1,20 @@
+package gorm_model
+
+import "time"
+
+type StartupAPIToken struct {
+	ID        string `gorm:"type:uuid;primary_key"`
+	StartupID string `gorm:"type:uuid;not null;index"`
+	Name      string `gorm:"type:varchar(100);not null"`
+	TokenHash string `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Prefix    string `gorm:"type:varchar(16);not null"`
+	// Scopes is a space-separated list, e.g. "jobs:read jobs:write".
+	Scopes     string `gorm:"type:varchar(255);not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedBy  *string `gorm:"type:uuid"`
+	CreatedAt  time.Time
+}
+
+func (StartupAPIToken) TableName() string { return "startup_api_tokens" }
@@ -0,0 +1,37 @@
+package gorm_model
+
+import "time"
+
+type StartupClaim struct {
+	ID              string  `gorm:"type:uuid;primary_key"`
+	StartupID       string  `gorm:"type:uuid;not null;index"`
+	UserID          string  `gorm:"type:uuid;not null;index"`
+	TeamID          *string `gorm:"type:uuid"`
+	Method          string  `gorm:"type:varchar(20);not null"`
+	Status          string  `gorm:"type:varchar(20);not null;index"`
+	Domain          string  `gorm:"type:varchar(255)"`
+	Message         string  `gorm:"type:text"`
+	Token           string  `gorm:"type:varchar(64)"`
+	Email           string  `gorm:"type:varchar(255)"`
+	CodeHash        string  `gorm:"type:varchar(64)"`
+	CodeAttempts    int     `gorm:"not null;default:0"`
+	CodeExpiresAt   *time.Time
+	RejectionReason string  `gorm:"type:text"`
+	ReviewedBy      *string `gorm:"type:uuid"`
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (StartupClaim) TableName() string { return "startup_claims" }
+
+type StartupClaimEvent struct {
+	ID        string  `gorm:"type:uuid;primary_key"`
+	ClaimID   string  `gorm:"type:uuid;not null;index"`
+	ActorID   *string `gorm:"type:uuid"`
+	Action    string  `gorm:"type:varchar(30);not null"`
+	Note      string  `gorm:"type:text"`
+	CreatedAt time.Time
+}
+
+func (StartupClaimEvent) TableName() string { return "startup_claim_events" }
@@ -0,0 +1,23 @@
+package gorm_model
+
+import "time"
+
+type StartupFollow struct {
+	ID        string `gorm:"type:uuid;primary_key"`
+	UserID    string `gorm:"type:uuid;not null;uniqueIndex:idx_startup_follows_user_startup"`
+	StartupID string `gorm:"type:uuid;not null;uniqueIndex:idx_startup_follows_user_startup;index"`
+	CreatedAt time.Time
+}
+
+func (StartupFollow) TableName() string { return "startup_follows" }
+
+type FollowNotification struct {
+	ID        string `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    string `gorm:"type:uuid;not null;index"`
+	StartupID string `gorm:"type:uuid;not null"`
+	JobID     string `gorm:"type:uuid;not null"`
+	CreatedAt time.Time
+	SentAt    *time.Time `gorm:"index"`
+}
+
+func (FollowNotification) TableName() string { return "follow_notifications" }
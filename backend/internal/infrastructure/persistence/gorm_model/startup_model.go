@@ -19,7 +19,6 @@ type Startup struct {
 	LinkedIn        string     `gorm:"type:varchar(500)"`
 	Twitter         string     `gorm:"type:varchar(500)"`
 	GitHub          string     `gorm:"type:varchar(500)"`
-	APIToken        string     `gorm:"type:varchar(255);uniqueIndex;not null"`
 	AllowPublicJoin bool       `gorm:"default:false"`
 	JoinCode        *string    `gorm:"type:varchar(255)"`
 	Status          string     `gorm:"type:varchar(50);not null;default:'active'"`
@@ -28,6 +27,7 @@ type Startup struct {
 	PlanExpiresAt        *time.Time `gorm:"type:timestamp"`
 	StripeCustomerID     *string    `gorm:"type:varchar(255);index"`
 	StripeSubscriptionID *string    `gorm:"type:varchar(255);index"`
+	VerifiedAt           *time.Time `gorm:"type:timestamp;index"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       gorm.DeletedAt `gorm:"index"`
@@ -0,0 +1,21 @@
+package gorm_model
+
+import "time"
+
+type StartupVerification struct {
+	ID            string     `gorm:"type:uuid;primary_key"`
+	StartupID     string     `gorm:"type:uuid;uniqueIndex;not null"`
+	Domain        string     `gorm:"type:varchar(255);not null"`
+	Method        string     `gorm:"type:varchar(20);not null"`
+	Token         string     `gorm:"type:varchar(64);not null"`
+	Email         string     `gorm:"type:varchar(255)"`
+	CodeHash      string     `gorm:"type:varchar(64)"`
+	ExpiresAt     time.Time  `gorm:"not null"`
+	VerifiedAt    *time.Time `gorm:"index"`
+	LastCheckedAt *time.Time `gorm:"index"`
+	Failures      int        `gorm:"not null;default:0"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (StartupVerification) TableName() string { return "startup_verifications" }
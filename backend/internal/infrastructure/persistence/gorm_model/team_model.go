@@ -57,6 +57,7 @@ type TeamInvitation struct {
 	InvitedBy string    `gorm:"type:uuid;not null"`
 	Status    string    `gorm:"type:varchar(50);not null;default:'pending'"`
 	ExpiresAt time.Time `gorm:"not null"`
+	Locale    string    `gorm:"type:varchar(16);not null;default:''"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -0,0 +1,18 @@
+package gorm_model
+
+import "time"
+
+type UploadIntent struct {
+	ID          string     `gorm:"type:uuid;primary_key"`
+	UserID      string     `gorm:"type:uuid;not null;index"`
+	StorageKey  string     `gorm:"type:varchar(500);not null"`
+	FileName    string     `gorm:"type:varchar(255);not null"`
+	ContentType string     `gorm:"type:varchar(100);not null"`
+	MaxSize     int64      `gorm:"type:bigint;not null"`
+	ExpiresAt   time.Time  `gorm:"not null;index"`
+	FileID      *string    `gorm:"type:uuid"`
+	CompletedAt *time.Time `gorm:"index"`
+	CreatedAt   time.Time
+}
+
+func (UploadIntent) TableName() string { return "upload_intents" }
@@ -13,6 +13,8 @@ type User struct {
 	StartupID *string   `gorm:"type:uuid"`
 	Status       string    `gorm:"type:varchar(50);not null;default:'active'"`
 	TokenVersion int       `gorm:"not null;default:0"`
+	EmailVerifiedAt *time.Time
+	Locale       string    `gorm:"type:varchar(16);not null;default:''"`
 	CreatedAt    time.Time
 	UpdatedAt time.Time
 }
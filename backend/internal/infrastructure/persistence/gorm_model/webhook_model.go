@@ -0,0 +1,36 @@
+package gorm_model
+
+import "time"
+
+type WebhookEndpoint struct {
+	ID     string `gorm:"type:uuid;primary_key"`
+	TeamID string `gorm:"type:uuid;not null;index"`
+	URL    string `gorm:"type:varchar(2048);not null"`
+	Secret string `gorm:"type:varchar(128);not null"`
+	// Events is a space-separated list, e.g. "job.created job.closed".
+	Events    string `gorm:"type:varchar(255);not null"`
+	Active    bool   `gorm:"not null;default:true"`
+	CreatedBy string `gorm:"type:uuid;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (WebhookEndpoint) TableName() string { return "webhook_endpoints" }
+
+type WebhookDelivery struct {
+	ID             string    `gorm:"type:uuid;primary_key"`
+	EndpointID     string    `gorm:"type:uuid;not null;index"`
+	Event          string    `gorm:"type:varchar(50);not null"`
+	Payload        string    `gorm:"type:text;not null"`
+	Status         string    `gorm:"type:varchar(20);not null;index:idx_webhook_deliveries_due,priority:1"`
+	Attempts       int       `gorm:"not null;default:0"`
+	NextAttemptAt  time.Time `gorm:"not null;index:idx_webhook_deliveries_due,priority:2"`
+	ResponseStatus *int
+	ResponseBody   string `gorm:"type:text"`
+	LastError      string `gorm:"type:text"`
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time `gorm:"index"`
+	UpdatedAt      time.Time
+}
+
+func (WebhookDelivery) TableName() string { return "webhook_deliveries" }
@@ -0,0 +1,253 @@
+package migration_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/migration"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/utils"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+func TestLoadPairsAndOrdersMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0002_add_b.up.sql":      {Data: []byte("ALTER TABLE a ADD COLUMN b int;")},
+		"sql/0002_add_b.down.sql":    {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+		"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id int);")},
+		"sql/0001_create_a.down.sql": {Data: []byte("")},
+	}
+	migrations, err := migration.Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].Version != 1 || migrations[1].Name != "add_b" {
+		t.Fatalf("migrations = %+v", migrations)
+	}
+	if migrations[0].Checksum == "" || migrations[0].Checksum == migrations[1].Checksum {
+		t.Fatalf("checksums %q %q", migrations[0].Checksum, migrations[1].Checksum)
+	}
+}
+
+func TestLoadRejectsIncompleteMigrations(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing down": {"sql/0001_a.up.sql": {Data: []byte("SELECT 1;")}},
+		"missing up":   {"sql/0001_a.down.sql": {Data: []byte("SELECT 1;")}},
+		"bad name":     {"sql/create_a.up.sql": {Data: []byte("SELECT 1;")}},
+		"two names": {
+			"sql/0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"sql/0001_b.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	} {
+		if _, err := migration.Load(fsys); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// models lists every table the application maps.
+var models = []interface{}{
+	&gorm_model.User{}, &gorm_model.Startup{}, &gorm_model.StartupMember{}, &gorm_model.Invitation{},
+	&gorm_model.Job{}, &gorm_model.File{}, &gorm_model.Contact{}, &gorm_model.Team{},
+	&gorm_model.TeamMember{}, &gorm_model.Role{}, &gorm_model.RoleScope{}, &gorm_model.TeamInvitation{},
+	&gorm_model.OAuthAccount{}, &gorm_model.OAuthLoginCode{}, &gorm_model.StartupVerification{},
+	&gorm_model.StartupFollow{}, &gorm_model.FollowNotification{}, &gorm_model.StartupAPIToken{},
+	&gorm_model.WebhookEndpoint{}, &gorm_model.WebhookDelivery{}, &gorm_model.StartupClaim{},
+	&gorm_model.StartupClaimEvent{}, &gorm_model.SlugHistory{}, &gorm_model.FileVariant{},
+	&gorm_model.UploadIntent{}, &gorm_model.FileReference{}, &gorm_model.RateLimitCounter{},
+	&gorm_model.OutboxEvent{}, &gorm_model.BackgroundJob{}, &gorm_model.EmailVerificationToken{},
+	&gorm_model.PasswordResetToken{},
+}
+
+// TestMigrationsCoverModels catches a model field added without the SQL
+// migration that creates its column.
+func TestMigrationsCoverModels(t *testing.T) {
+	migrations, err := migration.Load(migration.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var all strings.Builder
+	for _, m := range migrations {
+		all.WriteString(strings.ToLower(m.Up))
+		all.WriteString("\n")
+	}
+	sql := all.String()
+
+	cache := &sync.Map{}
+	for _, model := range models {
+		s, err := schema.Parse(model, cache, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := regexp.MustCompile(`(?s)create table if not exists ` + s.Table + ` \((.*?)\n\);`).FindStringSubmatch(sql)
+		if block == nil {
+			t.Errorf("no migration creates table %s", s.Table)
+			continue
+		}
+		for _, column := range s.DBNames {
+			created := regexp.MustCompile(`(?m)^\s+` + column + ` `).MatchString(block[1])
+			added := regexp.MustCompile(`alter table ` + s.Table + `\s+add column (if not exists )?` + column + ` `).MatchString(sql)
+			if !created && !added {
+				t.Errorf("no migration adds column %s.%s", s.Table, column)
+			}
+		}
+	}
+}
+
+// statements splits SQL into normalized statements, dropping comments.
+func statements(sql string) []string {
+	sql = regexp.MustCompile(`--[^\n]*`).ReplaceAllString(sql, "")
+	var out []string
+	for _, stmt := range strings.Split(sql, ";") {
+		if stmt = strings.Join(strings.Fields(stmt), " "); stmt != "" {
+			out = append(out, stmt)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TestBaselineMatchesPreSeriesSchema keeps later changes out of 0001: a
+// database that AutoMigrate created before versioned migrations skips it, so
+// anything new there would never reach that database.
+func TestBaselineMatchesPreSeriesSchema(t *testing.T) {
+	migrations, err := migration.Load(migration.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	preSeries, err := os.ReadFile("testdata/pre_series_schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var baseline []string
+	for _, stmt := range statements(migrations[0].Up) {
+		if !strings.HasPrefix(stmt, "CREATE EXTENSION") {
+			baseline = append(baseline, stmt)
+		}
+	}
+	if got, want := strings.Join(baseline, ";\n"), strings.Join(statements(string(preSeries)), ";\n"); got != want {
+		t.Fatalf("0001 differs from testdata/pre_series_schema.sql:\n%s\n\nwant:\n%s", got, want)
+	}
+}
+
+// TestMigrateFromPreSeriesSchema upgrades a database AutoMigrate created
+// before versioned migrations, in a scratch schema of TEST_DATABASE_URL.
+func TestMigrateFromPreSeriesSchema(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db := openScratchSchema(t, dsn)
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	preSeries, err := os.ReadFile("testdata/pre_series_schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.ExecContext(ctx, string(preSeries)); err != nil {
+		t.Fatal(err)
+	}
+	// One legacy token was hashed on use before the series, one never was.
+	for i, token := range []string{"sb_plaintext_legacy", utils.HashToken("sb_hashed_legacy")} {
+		id := "00000000-0000-0000-0000-00000000000" + strconv.Itoa(i+1)
+		if err := db.Exec(`INSERT INTO startups (id, name, slug, description, website, founded_year, industry, company_size, location, api_token, created_at, updated_at)
+			VALUES (?, ?, ?, '', '', 2020, '', '', '', ?, now(), now())`, id, "Startup "+id, "startup-"+id, token).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, err := migration.NewMigrator(sqlDB, migration.Files, logger.New(logger.Options{Output: io.Discard}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+
+	cache := &sync.Map{}
+	for _, model := range models {
+		s, err := schema.Parse(model, cache, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, column := range s.DBNames {
+			if !db.Migrator().HasColumn(s.Table, column) {
+				t.Errorf("column %s.%s is missing after migrating", s.Table, column)
+			}
+		}
+	}
+
+	var tokens []gorm_model.StartupAPIToken
+	if err := db.Order("prefix").Find(&tokens).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 ||
+		tokens[0].TokenHash != utils.HashToken("sb_hashed_legacy") || tokens[0].Prefix != "" ||
+		tokens[1].TokenHash != utils.HashToken("sb_plaintext_legacy") || tokens[1].Prefix != "sb_plain" {
+		t.Fatalf("legacy tokens = %+v", tokens)
+	}
+	for _, token := range tokens {
+		if token.Name != "legacy" || token.Scopes != "jobs:read jobs:write jobs:delete" {
+			t.Errorf("legacy token %s: name %q scopes %q", token.ID, token.Name, token.Scopes)
+		}
+	}
+	var leftover int64
+	if err := db.Table("startups").Where("api_token IS NOT NULL").Count(&leftover).Error; err != nil || leftover != 0 {
+		t.Fatalf("startups still holding api_token: %d (%v)", leftover, err)
+	}
+
+	if _, err := m.To(ctx, 0); err != nil {
+		t.Fatalf("roll back: %v", err)
+	}
+}
+
+// openScratchSchema connects to dsn with a fresh schema first on the search
+// path and drops the schema when the test ends.
+func openScratchSchema(t *testing.T, dsn string) *gorm.DB {
+	t.Helper()
+	config := &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)}
+	admin, err := gorm.Open(postgres.Open(dsn), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := "migration_test_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := admin.Exec("CREATE SCHEMA " + name).Error; err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Exec("DROP SCHEMA " + name + " CASCADE") })
+
+	sep := " "
+	if strings.Contains(dsn, "://") {
+		sep = "&"
+		if !strings.Contains(dsn, "?") {
+			sep = "?"
+		}
+	}
+	db, err := gorm.Open(postgres.Open(dsn+sep+"search_path="+name+",public"), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
@@ -0,0 +1,305 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// lockKey names the Postgres advisory lock held while migrating.
+const lockKey int64 = 0x73_6a_62_6d_69_67 // "sjbmig"
+
+const createTableSQL = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    bigint PRIMARY KEY,
+	name       text NOT NULL,
+	checksum   char(64) NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`
+
+// Migrator applies migrations to a Postgres database. Every operation holds
+// a session advisory lock, so replicas starting together wait for each
+// other instead of applying the same migration twice.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     logger.Logger
+}
+
+// Status describes one migration known to the binary, the database or both.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	Modified  bool // applied with a different checksum than the file has now
+	Unknown   bool // applied, but this binary has no file for it
+}
+
+type appliedMigration struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+func NewMigrator(db *sql.DB, fsys fs.FS, logger logger.Logger) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, logger: logger}, nil
+}
+
+// Latest returns the highest version the binary ships, or 0 when it has none.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies every pending migration and reports how many ran.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	return m.To(ctx, math.MaxInt64)
+}
+
+// To brings the schema to version: pending migrations up to it are applied
+// in order, applied ones above it are rolled back newest first.
+func (m *Migrator) To(ctx context.Context, version int64) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, done := applied[mig.Version]; done || mig.Version > version {
+				continue
+			}
+			if err := m.run(ctx, conn, mig, true); err != nil {
+				return err
+			}
+			count++
+		}
+
+		var rollback []int64
+		for v := range applied {
+			if v > version {
+				rollback = append(rollback, v)
+			}
+		}
+		sort.Slice(rollback, func(i, j int) bool { return rollback[i] > rollback[j] })
+		for _, v := range rollback {
+			mig, ok := m.find(v)
+			if !ok {
+				return fmt.Errorf("cannot roll back migration %d_%s: this binary has no file for it", v, applied[v].name)
+			}
+			if err := m.run(ctx, conn, mig, false); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down rolls back the newest steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		versions := make([]int64, 0, len(applied))
+		for v := range applied {
+			versions = append(versions, v)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		for _, v := range versions[:min(steps, len(versions))] {
+			mig, ok := m.find(v)
+			if !ok {
+				return fmt.Errorf("cannot roll back migration %d_%s: this binary has no file for it", v, applied[v].name)
+			}
+			if err := m.run(ctx, conn, mig, false); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Status lists every migration, applied or not, by version.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			s := Status{Version: mig.Version, Name: mig.Name}
+			if a, ok := applied[mig.Version]; ok {
+				s.AppliedAt = &a.appliedAt
+				s.Modified = a.checksum != mig.Checksum
+				delete(applied, mig.Version)
+			}
+			statuses = append(statuses, s)
+		}
+		for v, a := range applied {
+			statuses = append(statuses, Status{Version: v, Name: a.name, AppliedAt: &a.appliedAt, Unknown: true})
+		}
+		return nil
+	})
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, err
+}
+
+// Pending counts the migrations this binary ships that the database has not
+// applied. It takes no lock, so readiness probes can call it while another
+// replica is migrating.
+func (m *Migrator) Pending(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			m.logger.Warn("Failed to release migration lock: %v", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Conn.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func (m *Migrator) applied(ctx context.Context, q queryer) (map[int64]appliedMigration, error) {
+	rows, err := q.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int64]appliedMigration{}
+	for rows.Next() {
+		var v int64
+		var a appliedMigration
+		if err := rows.Scan(&v, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[v] = a
+	}
+	return applied, rows.Err()
+}
+
+// verify loads the applied migrations and refuses to continue when one of
+// them was edited after it ran.
+func (m *Migrator) verify(ctx context.Context, conn *sql.Conn) (map[int64]appliedMigration, error) {
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	for v, a := range applied {
+		mig, ok := m.find(v)
+		if !ok {
+			m.logger.Warn("Database has migration %d_%s, which this binary does not know", v, a.name)
+			continue
+		}
+		if a.checksum != mig.Checksum {
+			return nil, fmt.Errorf("migration %d_%s was modified after it was applied", v, mig.Name)
+		}
+	}
+	return applied, nil
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig, true
+		}
+	}
+	return Migration{}, false
+}
+
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, mig Migration, up bool) error {
+	script, inTx, direction := mig.Up, mig.upInTx(), "up"
+	record := func(exec execer) error {
+		_, err := exec.ExecContext(ctx,
+			"INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+			mig.Version, mig.Name, mig.Checksum)
+		return err
+	}
+	if !up {
+		script, inTx, direction = mig.Down, mig.downInTx(), "down"
+		record = func(exec execer) error {
+			_, err := exec.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", mig.Version)
+			return err
+		}
+	}
+
+	start := time.Now()
+	if inTx {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %d_%s %s: %w", mig.Version, mig.Name, direction, err)
+		}
+		if err := record(tx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("record migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration %d_%s %s: %w", mig.Version, mig.Name, direction, err)
+		}
+	} else {
+		if _, err := conn.ExecContext(ctx, script); err != nil {
+			return fmt.Errorf("migration %d_%s %s: %w", mig.Version, mig.Name, direction, err)
+		}
+		if err := record(conn); err != nil {
+			return fmt.Errorf("record migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+	m.logger.Info("Migrated %d_%s %s in %s", mig.Version, mig.Name, direction, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
@@ -0,0 +1,16 @@
+-- Drops every table the baseline creates; only for scratch databases.
+
+DROP TABLE IF EXISTS oauth_login_codes;
+DROP TABLE IF EXISTS oauth_accounts;
+DROP TABLE IF EXISTS team_invitations;
+DROP TABLE IF EXISTS role_scopes;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+DROP TABLE IF EXISTS contacts;
+DROP TABLE IF EXISTS files;
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS invitations;
+DROP TABLE IF EXISTS startup_members;
+DROP TABLE IF EXISTS startups;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,231 @@
+-- Baseline: the schema GORM AutoMigrate produced before versioned migrations.
+-- IF NOT EXISTS lets databases created by AutoMigrate adopt it unchanged.
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+CREATE TABLE IF NOT EXISTS users (
+    id uuid,
+    email varchar(255) NOT NULL,
+    password varchar(255) NOT NULL,
+    name varchar(255) NOT NULL,
+    role varchar(50) NOT NULL DEFAULT 'candidate',
+    startup_id uuid,
+    status varchar(50) NOT NULL DEFAULT 'active',
+    token_version bigint NOT NULL DEFAULT 0,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+
+CREATE TABLE IF NOT EXISTS startups (
+    id uuid,
+    name varchar(255) NOT NULL,
+    slug varchar(255) NOT NULL,
+    description text NOT NULL,
+    logo_url varchar(500),
+    website varchar(500) NOT NULL,
+    founded_year bigint NOT NULL,
+    industry varchar(255) NOT NULL,
+    company_size varchar(100) NOT NULL,
+    location varchar(255) NOT NULL,
+    linked_in varchar(500),
+    twitter varchar(500),
+    git_hub varchar(500),
+    api_token varchar(255) NOT NULL,
+    allow_public_join boolean DEFAULT false,
+    join_code varchar(255),
+    status varchar(50) NOT NULL DEFAULT 'active',
+    team_id uuid,
+    plan varchar(20) NOT NULL DEFAULT 'free',
+    plan_expires_at timestamp,
+    stripe_customer_id varchar(255),
+    stripe_subscription_id varchar(255),
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startups_deleted_at ON startups (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_startups_stripe_subscription_id ON startups (stripe_subscription_id);
+CREATE INDEX IF NOT EXISTS idx_startups_stripe_customer_id ON startups (stripe_customer_id);
+CREATE INDEX IF NOT EXISTS idx_startups_team_id ON startups (team_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_startups_api_token ON startups (api_token);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_startups_slug ON startups (slug);
+
+CREATE TABLE IF NOT EXISTS startup_members (
+    id uuid,
+    startup_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role varchar(50) NOT NULL DEFAULT 'member',
+    status varchar(50) NOT NULL DEFAULT 'active',
+    invited_by uuid,
+    invited_at timestamptz NOT NULL,
+    joined_at timestamp,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startup_members_user_id ON startup_members (user_id);
+CREATE INDEX IF NOT EXISTS idx_startup_members_startup_id ON startup_members (startup_id);
+
+CREATE TABLE IF NOT EXISTS invitations (
+    id uuid,
+    startup_id uuid NOT NULL,
+    email varchar(255) NOT NULL,
+    token varchar(255) NOT NULL,
+    role varchar(50) NOT NULL DEFAULT 'member',
+    invited_by uuid NOT NULL,
+    expires_at timestamp NOT NULL,
+    accepted_at timestamp,
+    status varchar(50) NOT NULL DEFAULT 'pending',
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invitations_token ON invitations (token);
+CREATE INDEX IF NOT EXISTS idx_invitations_email ON invitations (email);
+CREATE INDEX IF NOT EXISTS idx_invitations_startup_id ON invitations (startup_id);
+
+CREATE TABLE IF NOT EXISTS jobs (
+    id uuid,
+    startup_id uuid NOT NULL,
+    title varchar(255) NOT NULL,
+    description text NOT NULL,
+    requirements text NOT NULL,
+    job_type varchar(50) NOT NULL,
+    location_type varchar(50) NOT NULL,
+    city varchar(255),
+    country varchar(255) NOT NULL,
+    salary_min integer,
+    salary_max integer,
+    currency varchar(3) NOT NULL,
+    application_url varchar(500),
+    application_email varchar(255),
+    status varchar(50) NOT NULL DEFAULT 'active',
+    expires_at timestamp,
+    boosted_until timestamp,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_jobs_boosted_until ON jobs (boosted_until);
+CREATE INDEX IF NOT EXISTS idx_jobs_startup_id ON jobs (startup_id);
+
+CREATE TABLE IF NOT EXISTS files (
+    id uuid,
+    file_name varchar(255) NOT NULL,
+    file_size bigint NOT NULL,
+    mime_type varchar(100) NOT NULL,
+    storage_key varchar(500) NOT NULL,
+    url varchar(500) NOT NULL,
+    uploaded_by uuid NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS contacts (
+    id uuid,
+    name varchar(255) NOT NULL,
+    email varchar(255) NOT NULL,
+    subject varchar(500),
+    message text NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id uuid,
+    name varchar(255) NOT NULL,
+    slug varchar(255) NOT NULL,
+    created_by uuid NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_teams_created_by ON teams (created_by);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_slug ON teams (slug);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    id uuid,
+    team_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role_id uuid NOT NULL,
+    status varchar(50) NOT NULL DEFAULT 'active',
+    invited_by uuid,
+    invited_at timestamptz,
+    joined_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_team_members_role_id ON team_members (role_id);
+CREATE INDEX IF NOT EXISTS idx_team_members_user_id ON team_members (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_user ON team_members (team_id,user_id);
+
+CREATE TABLE IF NOT EXISTS roles (
+    id uuid,
+    team_id uuid,
+    name varchar(100) NOT NULL,
+    slug varchar(100) NOT NULL,
+    is_system boolean NOT NULL DEFAULT false,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_roles_slug ON roles (slug);
+CREATE INDEX IF NOT EXISTS idx_roles_team_id ON roles (team_id);
+
+CREATE TABLE IF NOT EXISTS role_scopes (
+    id uuid,
+    role_id uuid NOT NULL,
+    scope varchar(100) NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_role_scope ON role_scopes (role_id,scope);
+
+CREATE TABLE IF NOT EXISTS team_invitations (
+    id uuid,
+    team_id uuid NOT NULL,
+    email varchar(255) NOT NULL,
+    role_id uuid NOT NULL,
+    token varchar(255) NOT NULL,
+    invited_by uuid NOT NULL,
+    status varchar(50) NOT NULL DEFAULT 'pending',
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_invitations_token ON team_invitations (token);
+CREATE INDEX IF NOT EXISTS idx_team_invitations_email ON team_invitations (email);
+CREATE INDEX IF NOT EXISTS idx_team_invitations_team_id ON team_invitations (team_id);
+
+CREATE TABLE IF NOT EXISTS oauth_accounts (
+    id uuid,
+    user_id uuid NOT NULL,
+    provider varchar(50) NOT NULL,
+    provider_user_id varchar(255) NOT NULL,
+    email varchar(255) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_oauth_provider_user ON oauth_accounts (provider,provider_user_id);
+CREATE INDEX IF NOT EXISTS idx_oauth_accounts_user_id ON oauth_accounts (user_id);
+
+CREATE TABLE IF NOT EXISTS oauth_login_codes (
+    id uuid,
+    code_hash varchar(64) NOT NULL,
+    access_token text NOT NULL,
+    refresh_token text NOT NULL,
+    user_id uuid NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_oauth_login_codes_used_at ON oauth_login_codes (used_at);
+CREATE INDEX IF NOT EXISTS idx_oauth_login_codes_expires_at ON oauth_login_codes (expires_at);
+CREATE INDEX IF NOT EXISTS idx_oauth_login_codes_user_id ON oauth_login_codes (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_oauth_login_codes_code_hash ON oauth_login_codes (code_hash);
@@ -0,0 +1,3 @@
+-- The plaintext of the moved tokens is gone, so startups.api_token stays
+-- empty and the legacy tokens are dropped with the rest.
+DROP TABLE IF EXISTS startup_api_tokens;
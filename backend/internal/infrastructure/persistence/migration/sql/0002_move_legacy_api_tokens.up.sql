@@ -0,0 +1,35 @@
+-- Named, scoped API tokens replace the single startups.api_token.
+CREATE TABLE IF NOT EXISTS startup_api_tokens (
+    id uuid,
+    startup_id uuid NOT NULL,
+    name varchar(100) NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    prefix varchar(16) NOT NULL,
+    scopes varchar(255) NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    created_by uuid,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_startup_api_tokens_token_hash ON startup_api_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_startup_api_tokens_startup_id ON startup_api_tokens (startup_id);
+
+-- Move each startup's single legacy API token into startup_api_tokens as a
+-- token named "legacy" with every jobs scope, so it is listed and can be
+-- revoked like the others. Tokens not used since hashing was introduced are
+-- still plaintext and are hashed on the way; only those keep a display
+-- prefix.
+INSERT INTO startup_api_tokens (id, startup_id, name, token_hash, prefix, scopes, created_at)
+SELECT gen_random_uuid(), id, 'legacy',
+       CASE WHEN api_token ~ '^[0-9a-fA-F]{64}$' THEN lower(api_token)
+            ELSE encode(digest(api_token, 'sha256'), 'hex') END,
+       CASE WHEN api_token ~ '^[0-9a-fA-F]{64}$' THEN '' ELSE left(api_token, 8) END,
+       'jobs:read jobs:write jobs:delete', now()
+FROM startups
+WHERE api_token IS NOT NULL AND api_token <> '' AND deleted_at IS NULL
+ON CONFLICT (token_hash) DO NOTHING;
+
+ALTER TABLE startups ALTER COLUMN api_token DROP NOT NULL;
+UPDATE startups SET api_token = NULL;
@@ -0,0 +1,22 @@
+-- Domain events written in the same transaction as the change they record,
+-- dispatched to in-process subscribers by the outbox relay.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id uuid,
+    type varchar(50) NOT NULL,
+    aggregate_type varchar(30) NOT NULL,
+    aggregate_id uuid NOT NULL,
+    startup_id uuid,
+    team_id uuid,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error text,
+    processed_at timestamptz,
+    occurred_at timestamptz NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_due ON outbox_events (status,next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_occurred_at ON outbox_events (occurred_at);
@@ -0,0 +1,25 @@
+-- Durable background jobs, claimed by workers with FOR UPDATE SKIP LOCKED.
+CREATE TABLE IF NOT EXISTS background_jobs (
+    id uuid,
+    queue varchar(50) NOT NULL,
+    kind varchar(100) NOT NULL,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    max_attempts bigint NOT NULL,
+    run_at timestamptz NOT NULL,
+    unique_key varchar(255),
+    last_error text,
+    locked_until timestamptz,
+    started_at timestamptz,
+    finished_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_background_jobs_due ON background_jobs (queue,status,run_at);
+CREATE INDEX IF NOT EXISTS idx_background_jobs_kind ON background_jobs (kind);
+CREATE INDEX IF NOT EXISTS idx_background_jobs_created_at ON background_jobs (created_at);
+-- A unique key only blocks duplicates while a job holding it may still run.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_background_jobs_unique_key ON background_jobs (unique_key)
+    WHERE unique_key IS NOT NULL AND status IN ('queued', 'running');
@@ -0,0 +1,3 @@
+ALTER TABLE invitations DROP COLUMN IF EXISTS locale;
+ALTER TABLE team_invitations DROP COLUMN IF EXISTS locale;
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,5 @@
+-- Emails are rendered in the recipient's language when a template has it;
+-- an empty locale uses the default.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE team_invitations ADD COLUMN IF NOT EXISTS locale varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS locale varchar(16) NOT NULL DEFAULT '';
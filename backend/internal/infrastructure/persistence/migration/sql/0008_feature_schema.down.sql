@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS rate_limit_counters;
+DROP TABLE IF EXISTS file_references;
+DROP TABLE IF EXISTS upload_intents;
+DROP TABLE IF EXISTS file_variants;
+DROP TABLE IF EXISTS slug_history;
+DROP TABLE IF EXISTS startup_claim_events;
+DROP TABLE IF EXISTS startup_claims;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
+DROP TABLE IF EXISTS follow_notifications;
+DROP TABLE IF EXISTS startup_follows;
+DROP TABLE IF EXISTS startup_verifications;
+ALTER TABLE startups DROP COLUMN IF EXISTS verified_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS stale_reminder_sent_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS expiry_reminder_sent_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS apply_relay_token;
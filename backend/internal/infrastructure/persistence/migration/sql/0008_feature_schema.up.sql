@@ -0,0 +1,182 @@
+-- Schema of the features built while AutoMigrate still managed the tables.
+-- Databases that ran those builds already have it, hence IF NOT EXISTS.
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS apply_relay_token varchar(32);
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS expiry_reminder_sent_at timestamp;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS stale_reminder_sent_at timestamp;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_jobs_apply_relay_token ON jobs (apply_relay_token);
+
+ALTER TABLE startups ADD COLUMN IF NOT EXISTS verified_at timestamp;
+CREATE INDEX IF NOT EXISTS idx_startups_verified_at ON startups (verified_at);
+
+CREATE TABLE IF NOT EXISTS startup_verifications (
+    id uuid,
+    startup_id uuid NOT NULL,
+    domain varchar(255) NOT NULL,
+    method varchar(20) NOT NULL,
+    token varchar(64) NOT NULL,
+    email varchar(255),
+    code_hash varchar(64),
+    expires_at timestamptz NOT NULL,
+    verified_at timestamptz,
+    last_checked_at timestamptz,
+    failures bigint NOT NULL DEFAULT 0,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startup_verifications_last_checked_at ON startup_verifications (last_checked_at);
+CREATE INDEX IF NOT EXISTS idx_startup_verifications_verified_at ON startup_verifications (verified_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_startup_verifications_startup_id ON startup_verifications (startup_id);
+
+CREATE TABLE IF NOT EXISTS startup_follows (
+    id uuid,
+    user_id uuid NOT NULL,
+    startup_id uuid NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startup_follows_startup_id ON startup_follows (startup_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_startup_follows_user_startup ON startup_follows (user_id,startup_id);
+
+CREATE TABLE IF NOT EXISTS follow_notifications (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    startup_id uuid NOT NULL,
+    job_id uuid NOT NULL,
+    created_at timestamptz,
+    sent_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_follow_notifications_sent_at ON follow_notifications (sent_at);
+CREATE INDEX IF NOT EXISTS idx_follow_notifications_user_id ON follow_notifications (user_id);
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id uuid,
+    team_id uuid NOT NULL,
+    url varchar(2048) NOT NULL,
+    secret varchar(128) NOT NULL,
+    events varchar(255) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    created_by uuid NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_team_id ON webhook_endpoints (team_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid,
+    endpoint_id uuid NOT NULL,
+    event varchar(50) NOT NULL,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    response_status bigint,
+    response_body text,
+    last_error text,
+    delivered_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON webhook_deliveries (created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status,next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id ON webhook_deliveries (endpoint_id);
+
+CREATE TABLE IF NOT EXISTS startup_claims (
+    id uuid,
+    startup_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    team_id uuid,
+    method varchar(20) NOT NULL,
+    status varchar(20) NOT NULL,
+    domain varchar(255),
+    message text,
+    token varchar(64),
+    email varchar(255),
+    code_hash varchar(64),
+    code_attempts bigint NOT NULL DEFAULT 0,
+    code_expires_at timestamptz,
+    rejection_reason text,
+    reviewed_by uuid,
+    reviewed_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startup_claims_status ON startup_claims (status);
+CREATE INDEX IF NOT EXISTS idx_startup_claims_user_id ON startup_claims (user_id);
+CREATE INDEX IF NOT EXISTS idx_startup_claims_startup_id ON startup_claims (startup_id);
+
+CREATE TABLE IF NOT EXISTS startup_claim_events (
+    id uuid,
+    claim_id uuid NOT NULL,
+    actor_id uuid,
+    action varchar(30) NOT NULL,
+    note text,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_startup_claim_events_claim_id ON startup_claim_events (claim_id);
+
+CREATE TABLE IF NOT EXISTS slug_history (
+    id uuid,
+    owner_type varchar(20) NOT NULL,
+    owner_id uuid NOT NULL,
+    slug varchar(255) NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_slug_history_owner_id ON slug_history (owner_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_slug_history_owner_type_slug ON slug_history (owner_type,slug);
+
+CREATE TABLE IF NOT EXISTS file_variants (
+    id uuid,
+    file_id uuid NOT NULL,
+    size bigint NOT NULL,
+    format varchar(10) NOT NULL,
+    mime_type varchar(100) NOT NULL,
+    storage_key varchar(500) NOT NULL,
+    url varchar(500) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_files_variants FOREIGN KEY (file_id) REFERENCES files(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_file_variants_file_id ON file_variants (file_id);
+
+CREATE TABLE IF NOT EXISTS upload_intents (
+    id uuid,
+    user_id uuid NOT NULL,
+    storage_key varchar(500) NOT NULL,
+    file_name varchar(255) NOT NULL,
+    content_type varchar(100) NOT NULL,
+    max_size bigint NOT NULL,
+    expires_at timestamptz NOT NULL,
+    file_id uuid,
+    completed_at timestamptz,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_upload_intents_completed_at ON upload_intents (completed_at);
+CREATE INDEX IF NOT EXISTS idx_upload_intents_expires_at ON upload_intents (expires_at);
+CREATE INDEX IF NOT EXISTS idx_upload_intents_user_id ON upload_intents (user_id);
+
+CREATE TABLE IF NOT EXISTS file_references (
+    id uuid,
+    file_id uuid NOT NULL,
+    owner_type varchar(30) NOT NULL,
+    owner_id uuid NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_file_references_owner_file ON file_references (owner_type,owner_id,file_id);
+CREATE INDEX IF NOT EXISTS idx_file_references_file_id ON file_references (file_id);
+
+CREATE TABLE IF NOT EXISTS rate_limit_counters (
+    key varchar(255),
+    window_start timestamptz,
+    count bigint NOT NULL DEFAULT 0,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (key,window_start)
+);
+CREATE INDEX IF NOT EXISTS idx_rate_limit_counters_expires_at ON rate_limit_counters (expires_at);
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BackgroundJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBackgroundJobRepository(db *gorm.DB) repository.BackgroundJobRepository {
+	return &BackgroundJobRepositoryImpl{db: db}
+}
+
+func (r *BackgroundJobRepositoryImpl) Create(ctx context.Context, job *entity.BackgroundJob) (bool, error) {
+	// The conflict target names the partial unique index so only a clash on
+	// an active job's key is skipped; any other violation still fails.
+	res := conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "unique_key"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "unique_key IS NOT NULL AND status IN ('queued', 'running')"}}},
+		DoNothing:   true,
+	}).Create(toBackgroundJobModel(job))
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *BackgroundJobRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.BackgroundJob, error) {
+	var m gorm_model.BackgroundJob
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		return nil, err
+	}
+	return toBackgroundJobDomain(&m), nil
+}
+
+func (r *BackgroundJobRepositoryImpl) List(ctx context.Context, filter repository.BackgroundJobFilter, page, pageSize int) ([]*entity.BackgroundJob, int64, error) {
+	q := conn(ctx, r.db).Model(&gorm_model.BackgroundJob{})
+	if filter.Queue != "" {
+		q = q.Where("queue = ?", filter.Queue)
+	}
+	if filter.Kind != "" {
+		q = q.Where("kind = ?", filter.Kind)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", string(filter.Status))
+	}
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	var models []gorm_model.BackgroundJob
+	if err := q.Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	return toBackgroundJobList(models), total, nil
+}
+
+func (r *BackgroundJobRepositoryImpl) ClaimDue(ctx context.Context, queue string, now, lockUntil time.Time, limit int) ([]*entity.BackgroundJob, error) {
+	var models []gorm_model.BackgroundJob
+	err := conn(ctx, r.db).Raw(`
+		UPDATE background_jobs
+		SET status = ?, attempts = attempts + 1, locked_until = ?, started_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM background_jobs
+			WHERE queue = ? AND (
+				(status = ? AND run_at <= ?) OR
+				(status = ? AND locked_until < ?)
+			)
+			ORDER BY run_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		string(entity.BackgroundJobRunning), lockUntil, now, now,
+		queue, string(entity.BackgroundJobQueued), now, string(entity.BackgroundJobRunning), now, limit,
+	).Scan(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	out := toBackgroundJobList(models)
+	// RETURNING does not keep the subquery's order.
+	sort.SliceStable(out, func(i, j int) bool { return out[i].RunAt.Before(out[j].RunAt) })
+	return out, nil
+}
+
+func (r *BackgroundJobRepositoryImpl) Finish(ctx context.Context, job *entity.BackgroundJob) (bool, error) {
+	res := conn(ctx, r.db).Model(&gorm_model.BackgroundJob{}).
+		Where("id = ? AND status = ? AND attempts = ?", job.ID, string(entity.BackgroundJobRunning), job.Attempts).
+		Updates(map[string]interface{}{
+			"status": string(job.Status), "run_at": job.RunAt, "last_error": job.LastError,
+			"locked_until": job.LockedUntil, "finished_at": job.FinishedAt, "updated_at": job.UpdatedAt,
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *BackgroundJobRepositoryImpl) Requeue(ctx context.Context, id string, runAt time.Time) (bool, error) {
+	res := conn(ctx, r.db).Exec(`
+		UPDATE background_jobs j
+		SET status = ?, attempts = 0, run_at = ?, last_error = '', locked_until = NULL,
+			started_at = NULL, finished_at = NULL, updated_at = ?
+		WHERE j.id = ? AND j.status IN (?, ?) AND (j.unique_key IS NULL OR NOT EXISTS (
+			SELECT 1 FROM background_jobs o
+			WHERE o.unique_key = j.unique_key AND o.status IN (?, ?)
+		))`,
+		string(entity.BackgroundJobQueued), runAt, time.Now(),
+		id, string(entity.BackgroundJobDead), string(entity.BackgroundJobCancelled),
+		string(entity.BackgroundJobQueued), string(entity.BackgroundJobRunning),
+	)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *BackgroundJobRepositoryImpl) Cancel(ctx context.Context, id string) (bool, error) {
+	now := time.Now()
+	res := conn(ctx, r.db).Model(&gorm_model.BackgroundJob{}).
+		Where("id = ? AND status IN ?", id, []string{string(entity.BackgroundJobQueued), string(entity.BackgroundJobRunning)}).
+		Updates(map[string]interface{}{
+			"status": string(entity.BackgroundJobCancelled), "locked_until": nil, "finished_at": now, "updated_at": now,
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *BackgroundJobRepositoryImpl) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := conn(ctx, r.db).
+		Where("status IN ? AND finished_at < ?", []string{string(entity.BackgroundJobSucceeded), string(entity.BackgroundJobCancelled)}, before).
+		Delete(&gorm_model.BackgroundJob{})
+	return res.RowsAffected, res.Error
+}
+
+func toBackgroundJobList(models []gorm_model.BackgroundJob) []*entity.BackgroundJob {
+	out := make([]*entity.BackgroundJob, len(models))
+	for i := range models {
+		out[i] = toBackgroundJobDomain(&models[i])
+	}
+	return out
+}
+
+func toBackgroundJobModel(j *entity.BackgroundJob) *gorm_model.BackgroundJob {
+	return &gorm_model.BackgroundJob{
+		ID: j.ID, Queue: j.Queue, Kind: j.Kind, Payload: j.Payload, Status: string(j.Status),
+		Attempts: j.Attempts, MaxAttempts: j.MaxAttempts, RunAt: j.RunAt, UniqueKey: j.UniqueKey,
+		LastError: j.LastError, LockedUntil: j.LockedUntil, StartedAt: j.StartedAt, FinishedAt: j.FinishedAt,
+		CreatedAt: j.CreatedAt, UpdatedAt: j.UpdatedAt,
+	}
+}
+
+func toBackgroundJobDomain(m *gorm_model.BackgroundJob) *entity.BackgroundJob {
+	return &entity.BackgroundJob{
+		ID: m.ID, Queue: m.Queue, Kind: m.Kind, Payload: m.Payload, Status: entity.BackgroundJobStatus(m.Status),
+		Attempts: m.Attempts, MaxAttempts: m.MaxAttempts, RunAt: m.RunAt, UniqueKey: m.UniqueKey,
+		LastError: m.LastError, LockedUntil: m.LockedUntil, StartedAt: m.StartedAt, FinishedAt: m.FinishedAt,
+		CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -19,7 +19,7 @@ func NewContactRepository(db *gorm.DB) repository.ContactRepository {
 
 func (r *ContactRepositoryImpl) Create(ctx context.Context, contact *entity.Contact) error {
 	model := r.toModel(contact)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *ContactRepositoryImpl) toModel(contact *entity.Contact) *gorm_model.Contact {
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type EmailVerificationTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEmailVerificationTokenRepository(db *gorm.DB) repository.EmailVerificationTokenRepository {
+	return &EmailVerificationTokenRepositoryImpl{db: db}
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) Create(ctx context.Context, token *entity.EmailVerificationToken) error {
+	return conn(ctx, r.db).Create(&gorm_model.EmailVerificationToken{
+		ID: token.ID, UserID: token.UserID, TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt, UsedAt: token.UsedAt, CreatedAt: token.CreatedAt,
+	}).Error
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error) {
+	return r.first(conn(ctx, r.db).Where("token_hash = ?", tokenHash))
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) FindLatestByUser(ctx context.Context, userID string) (*entity.EmailVerificationToken, error) {
+	return r.first(conn(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC"))
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	res := conn(ctx, r.db).Model(&gorm_model.EmailVerificationToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) DeleteExpired(ctx context.Context) error {
+	return conn(ctx, r.db).
+		Where("expires_at < ? OR used_at IS NOT NULL", time.Now().Add(-1*time.Hour)).
+		Delete(&gorm_model.EmailVerificationToken{}).Error
+}
+
+func (r *EmailVerificationTokenRepositoryImpl) first(q *gorm.DB) (*entity.EmailVerificationToken, error) {
+	var m gorm_model.EmailVerificationToken
+	if err := q.First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entity.EmailVerificationToken{
+		ID: m.ID, UserID: m.UserID, TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt, UsedAt: m.UsedAt, CreatedAt: m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FileReferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFileReferenceRepository(db *gorm.DB) repository.FileReferenceRepository {
+	return &FileReferenceRepositoryImpl{db: db}
+}
+
+func (r *FileReferenceRepositoryImpl) Add(ctx context.Context, ref *entity.FileReference) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&gorm_model.FileReference{
+		ID: ref.ID, FileID: ref.FileID, OwnerType: string(ref.OwnerType), OwnerID: ref.OwnerID, CreatedAt: ref.CreatedAt,
+	}).Error
+}
+
+func (r *FileReferenceRepositoryImpl) DeleteByOwner(ctx context.Context, ownerType entity.FileReferenceType, ownerID string) error {
+	return conn(ctx, r.db).
+		Where("owner_type = ? AND owner_id = ?", string(ownerType), ownerID).
+		Delete(&gorm_model.FileReference{}).Error
+}
+
+func (r *FileReferenceRepositoryImpl) ExistsForFile(ctx context.Context, fileID string) (bool, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&gorm_model.FileReference{}).Where("file_id = ?", fileID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *FileReferenceRepositoryImpl) ReconcileStartupLogos(ctx context.Context) (int64, error) {
+	// Soft-deleted startups are included on purpose: a restore should not
+	// find its logo gone.
+	res := conn(ctx, r.db).Exec(`
+		INSERT INTO file_references (id, file_id, owner_type, owner_id, created_at)
+		SELECT gen_random_uuid(), f.id, ?, s.id, NOW()
+		FROM startups s
+		JOIN files f ON f.url = s.logo_url
+			OR f.id IN (SELECT v.file_id FROM file_variants v WHERE v.url = s.logo_url)
+		WHERE s.logo_url IS NOT NULL AND s.logo_url <> ''
+		ON CONFLICT DO NOTHING`, string(entity.FileRefStartupLogo))
+	return res.RowsAffected, res.Error
+}
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"time"
+
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
@@ -18,22 +20,119 @@ func NewFileRepository(db *gorm.DB) repository.FileRepository {
 
 func (r *FileRepositoryImpl) Create(ctx context.Context, file *entity.File) error {
 	model := r.toModel(file)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *FileRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.File, error) {
 	var model gorm_model.File
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Variants").Where("id = ?", id).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.toDomain(&model), nil
+}
+
+func (r *FileRepositoryImpl) FindByURL(ctx context.Context, url string) (*entity.File, error) {
+	var model gorm_model.File
+	err := conn(ctx, r.db).Preload("Variants").
+		Where("url = ? OR id IN (SELECT file_id FROM file_variants WHERE url = ?)", url, url).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return r.toDomain(&model), nil
 }
 
+func (r *FileRepositoryImpl) ListByUploaders(ctx context.Context, userIDs []string, page, pageSize int) ([]*entity.File, int64, error) {
+	if len(userIDs) == 0 {
+		return []*entity.File{}, 0, nil
+	}
+	var total int64
+	q := conn(ctx, r.db).Model(&gorm_model.File{}).Where("uploaded_by IN ?", userIDs)
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	var models []gorm_model.File
+	if err := q.Preload("Variants").Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	return r.toDomainList(models), total, nil
+}
+
+func (r *FileRepositoryImpl) ListUnreferenced(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.File, error) {
+	var models []gorm_model.File
+	err := conn(ctx, r.db).Preload("Variants").
+		Where("created_at < ?", createdBefore).
+		Where("NOT EXISTS (SELECT 1 FROM file_references WHERE file_references.file_id = files.id)").
+		Order("created_at").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomainList(models), nil
+}
+
+func (r *FileRepositoryImpl) UnknownKeys(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	var known []string
+	err := conn(ctx, r.db).Raw(`
+		SELECT storage_key FROM files WHERE storage_key IN ?
+		UNION SELECT storage_key FROM file_variants WHERE storage_key IN ?
+		UNION SELECT storage_key FROM upload_intents WHERE storage_key IN ?`,
+		keys, keys, keys).Scan(&known).Error
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(known))
+	for _, k := range known {
+		seen[k] = true
+	}
+	var unknown []string
+	for _, k := range keys {
+		if !seen[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	return unknown, nil
+}
+
 func (r *FileRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&gorm_model.File{}, id).Error
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("file_id = ?", id).Delete(&gorm_model.FileVariant{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("file_id = ?", id).Delete(&gorm_model.FileReference{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&gorm_model.File{}, "id = ?", id).Error
+	})
+}
+
+func (r *FileRepositoryImpl) toDomainList(models []gorm_model.File) []*entity.File {
+	out := make([]*entity.File, len(models))
+	for i := range models {
+		out[i] = r.toDomain(&models[i])
+	}
+	return out
 }
 
 func (r *FileRepositoryImpl) toModel(file *entity.File) *gorm_model.File {
+	variants := make([]gorm_model.FileVariant, len(file.Variants))
+	for i, v := range file.Variants {
+		variants[i] = gorm_model.FileVariant{
+			ID: v.ID, FileID: file.ID, Size: v.Size, Format: v.Format,
+			MimeType: v.MimeType, StorageKey: v.StorageKey, URL: v.URL,
+		}
+	}
 	return &gorm_model.File{
 		ID:         file.ID,
 		FileName:   file.FileName,
@@ -42,11 +141,19 @@ func (r *FileRepositoryImpl) toModel(file *entity.File) *gorm_model.File {
 		StorageKey: file.StorageKey,
 		URL:        file.URL,
 		UploadedBy: file.UploadedBy,
+		Variants:   variants,
 		CreatedAt:  file.CreatedAt,
 	}
 }
 
 func (r *FileRepositoryImpl) toDomain(model *gorm_model.File) *entity.File {
+	variants := make([]entity.FileVariant, len(model.Variants))
+	for i, v := range model.Variants {
+		variants[i] = entity.FileVariant{
+			ID: v.ID, FileID: v.FileID, Size: v.Size, Format: v.Format,
+			MimeType: v.MimeType, StorageKey: v.StorageKey, URL: v.URL,
+		}
+	}
 	return &entity.File{
 		ID:         model.ID,
 		FileName:   model.FileName,
@@ -55,6 +162,7 @@ func (r *FileRepositoryImpl) toDomain(model *gorm_model.File) *entity.File {
 		StorageKey: model.StorageKey,
 		URL:        model.URL,
 		UploadedBy: model.UploadedBy,
+		Variants:   variants,
 		CreatedAt:  model.CreatedAt,
 	}
 }
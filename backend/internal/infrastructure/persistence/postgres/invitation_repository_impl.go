@@ -20,17 +20,17 @@ func NewInvitationRepository(db *gorm.DB) repository.InvitationRepository {
 
 func (r *InvitationRepositoryImpl) Create(ctx context.Context, invitation *entity.Invitation) error {
 	model := r.toModel(invitation)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *InvitationRepositoryImpl) Update(ctx context.Context, invitation *entity.Invitation) error {
 	model := r.toModel(invitation)
-	return r.db.WithContext(ctx).Save(model).Error
+	return conn(ctx, r.db).Save(model).Error
 }
 
 func (r *InvitationRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.Invitation, error) {
 	var model gorm_model.Invitation
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
@@ -38,7 +38,7 @@ func (r *InvitationRepositoryImpl) FindByID(ctx context.Context, id string) (*en
 
 func (r *InvitationRepositoryImpl) FindByToken(ctx context.Context, token string) (*entity.Invitation, error) {
 	var model gorm_model.Invitation
-	if err := r.db.WithContext(ctx).Where("token = ?", token).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("token = ?", token).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
@@ -46,7 +46,7 @@ func (r *InvitationRepositoryImpl) FindByToken(ctx context.Context, token string
 
 func (r *InvitationRepositoryImpl) FindByEmail(ctx context.Context, email string) ([]*entity.Invitation, error) {
 	var models []gorm_model.Invitation
-	if err := r.db.WithContext(ctx).Where("email = ?", email).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("email = ?", email).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -59,7 +59,7 @@ func (r *InvitationRepositoryImpl) FindByEmail(ctx context.Context, email string
 
 func (r *InvitationRepositoryImpl) FindPendingByStartup(ctx context.Context, startupID string) ([]*entity.Invitation, error) {
 	var models []gorm_model.Invitation
-	if err := r.db.WithContext(ctx).Where("startup_id = ? AND status = ?", startupID, "pending").Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("startup_id = ? AND status = ?", startupID, "pending").Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -72,7 +72,7 @@ func (r *InvitationRepositoryImpl) FindPendingByStartup(ctx context.Context, sta
 
 func (r *InvitationRepositoryImpl) ExpireOldInvitations(ctx context.Context) error {
 	now := time.Now()
-	return r.db.WithContext(ctx).Model(&gorm_model.Invitation{}).
+	return conn(ctx, r.db).Model(&gorm_model.Invitation{}).
 		Where("status = ? AND expires_at < ?", "pending", now).
 		Update("status", "expired").Error
 }
@@ -88,6 +88,7 @@ func (r *InvitationRepositoryImpl) toModel(invitation *entity.Invitation) *gorm_
 		ExpiresAt: invitation.ExpiresAt,
 		AcceptedAt: invitation.AcceptedAt,
 		Status:    string(invitation.Status),
+		Locale:    invitation.Locale,
 		CreatedAt: invitation.CreatedAt,
 	}
 }
@@ -103,6 +104,7 @@ func (r *InvitationRepositoryImpl) toDomain(model *gorm_model.Invitation) *entit
 		ExpiresAt: model.ExpiresAt,
 		AcceptedAt: model.AcceptedAt,
 		Status:    entity.InvitationStatus(model.Status),
+		Locale:    model.Locale,
 		CreatedAt: model.CreatedAt,
 	}
 }
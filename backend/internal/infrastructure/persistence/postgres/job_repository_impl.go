@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
@@ -21,28 +22,83 @@ func NewJobRepository(db *gorm.DB) repository.JobRepository {
 
 func (r *JobRepositoryImpl) Create(ctx context.Context, job *entity.Job) error {
 	model := r.toModel(job)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *JobRepositoryImpl) Update(ctx context.Context, job *entity.Job) error {
 	model := r.toModel(job)
-	return r.db.WithContext(ctx).Save(model).Error
+	return conn(ctx, r.db).Save(model).Error
 }
 
 func (r *JobRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&gorm_model.Job{}, id).Error
+	return conn(ctx, r.db).Delete(&gorm_model.Job{}, id).Error
 }
 
 func (r *JobRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.Job, error) {
 	var model gorm_model.Job
-	if err := r.db.WithContext(ctx).Where(&gorm_model.Job{ID: id}).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where(&gorm_model.Job{ID: id}).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
 }
 
+func (r *JobRepositoryImpl) FindByApplyRelayToken(ctx context.Context, token string) (*entity.Job, error) {
+	if token == "" {
+		return nil, gorm.ErrRecordNotFound
+	}
+	var model gorm_model.Job
+	if err := conn(ctx, r.db).Where("apply_relay_token = ?", token).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.toDomain(&model), nil
+}
+
+// SetApplyRelayToken writes only the relay token so backfilling it does not
+// bump updated_at (which drives stale-listing reminders).
+func (r *JobRepositoryImpl) SetApplyRelayToken(ctx context.Context, id, token string) error {
+	return conn(ctx, r.db).Model(&gorm_model.Job{}).Where("id = ?", id).
+		UpdateColumn("apply_relay_token", token).Error
+}
+
+// FindExpiringForReminder returns active jobs expiring before the given time
+// that have not been reminded since their last update.
+func (r *JobRepositoryImpl) FindExpiringForReminder(ctx context.Context, before time.Time, limit int) ([]*entity.Job, error) {
+	var models []gorm_model.Job
+	err := conn(ctx, r.db).
+		Where("status = ? AND expires_at > NOW() AND expires_at <= ?", string(entity.JobStatusActive), before).
+		Where("expiry_reminder_sent_at IS NULL OR expiry_reminder_sent_at < updated_at").
+		Order("expires_at ASC").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomainList(models), nil
+}
+
+// FindStaleForReminder returns active jobs not updated since updatedBefore
+// that have not been reminded since their last update.
+func (r *JobRepositoryImpl) FindStaleForReminder(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Job, error) {
+	var models []gorm_model.Job
+	err := conn(ctx, r.db).
+		Where("status = ? AND updated_at < ?", string(entity.JobStatusActive), updatedBefore).
+		Where("stale_reminder_sent_at IS NULL OR stale_reminder_sent_at < updated_at").
+		Order("updated_at ASC").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomainList(models), nil
+}
+
+// MarkReminderSent records a reminder without touching updated_at.
+func (r *JobRepositoryImpl) MarkReminderSent(ctx context.Context, id string, kind entity.JobReminderKind, at time.Time) error {
+	column := "expiry_reminder_sent_at"
+	if kind == entity.JobReminderStale {
+		column = "stale_reminder_sent_at"
+	}
+	return conn(ctx, r.db).Model(&gorm_model.Job{}).Where("id = ?", id).UpdateColumn(column, at).Error
+}
+
 func (r *JobRepositoryImpl) List(ctx context.Context, filter repository.JobFilter) ([]*entity.Job, int64, error) {
-	query := r.db.WithContext(ctx).Model(&gorm_model.Job{})
+	query := conn(ctx, r.db).Model(&gorm_model.Job{})
 
 	if filter.StartupID != "" {
 		query = query.Where(&gorm_model.Job{StartupID: filter.StartupID})
@@ -110,7 +166,7 @@ func (r *JobRepositoryImpl) List(ctx context.Context, filter repository.JobFilte
 
 func (r *JobRepositoryImpl) FindByStartupID(ctx context.Context, startupID string, limit int) ([]*entity.Job, error) {
 	var models []gorm_model.Job
-	query := r.db.WithContext(ctx).Where(&gorm_model.Job{StartupID: startupID})
+	query := conn(ctx, r.db).Where(&gorm_model.Job{StartupID: startupID})
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
@@ -125,6 +181,14 @@ func (r *JobRepositoryImpl) FindByStartupID(ctx context.Context, startupID strin
 	return jobs, nil
 }
 
+func (r *JobRepositoryImpl) toDomainList(models []gorm_model.Job) []*entity.Job {
+	jobs := make([]*entity.Job, len(models))
+	for i := range models {
+		jobs[i] = r.toDomain(&models[i])
+	}
+	return jobs
+}
+
 func (r *JobRepositoryImpl) toModel(job *entity.Job) *gorm_model.Job {
 	return &gorm_model.Job{
 		ID:              job.ID,
@@ -141,9 +205,12 @@ func (r *JobRepositoryImpl) toModel(job *entity.Job) *gorm_model.Job {
 		Currency:        job.Currency,
 		ApplicationURL:  job.ApplicationURL,
 		ApplicationEmail: job.ApplicationEmail,
+		ApplyRelayToken: relayTokenPtr(job.ApplyRelayToken),
 		Status:          string(job.Status),
 		ExpiresAt:       job.ExpiresAt,
 		BoostedUntil:    job.BoostedUntil,
+		ExpiryReminderSentAt: job.ExpiryReminderSentAt,
+		StaleReminderSentAt:  job.StaleReminderSentAt,
 		CreatedAt:       job.CreatedAt,
 		UpdatedAt:       job.UpdatedAt,
 	}
@@ -165,9 +232,12 @@ func (r *JobRepositoryImpl) toDomain(model *gorm_model.Job) *entity.Job {
 		Currency:        model.Currency,
 		ApplicationURL:  model.ApplicationURL,
 		ApplicationEmail: model.ApplicationEmail,
+		ApplyRelayToken: relayTokenValue(model.ApplyRelayToken),
 		Status:          entity.JobStatus(model.Status),
 		ExpiresAt:       model.ExpiresAt,
 		BoostedUntil:    model.BoostedUntil,
+		ExpiryReminderSentAt: model.ExpiryReminderSentAt,
+		StaleReminderSentAt:  model.StaleReminderSentAt,
 		CreatedAt:       model.CreatedAt,
 		UpdatedAt:       model.UpdatedAt,
 	}
@@ -175,3 +245,18 @@ func (r *JobRepositoryImpl) toDomain(model *gorm_model.Job) *entity.Job {
 
 
 
+
+// Unassigned relay tokens are stored as NULL so the unique index ignores them.
+func relayTokenPtr(token string) *string {
+	if token == "" {
+		return nil
+	}
+	return &token
+}
+
+func relayTokenValue(token *string) string {
+	if token == nil {
+		return ""
+	}
+	return *token
+}
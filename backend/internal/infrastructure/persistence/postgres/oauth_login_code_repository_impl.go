@@ -19,7 +19,7 @@ func NewOAuthLoginCodeRepository(db *gorm.DB) repository.OAuthLoginCodeRepositor
 }
 
 func (r *OAuthLoginCodeRepositoryImpl) Create(ctx context.Context, code *entity.OAuthLoginCode) error {
-	return r.db.WithContext(ctx).Create(&gorm_model.OAuthLoginCode{
+	return conn(ctx, r.db).Create(&gorm_model.OAuthLoginCode{
 		ID: code.ID, CodeHash: code.CodeHash,
 		AccessToken: code.AccessToken, RefreshToken: code.RefreshToken,
 		UserID: code.UserID, ExpiresAt: code.ExpiresAt, UsedAt: code.UsedAt,
@@ -29,7 +29,7 @@ func (r *OAuthLoginCodeRepositoryImpl) Create(ctx context.Context, code *entity.
 
 func (r *OAuthLoginCodeRepositoryImpl) FindByCodeHash(ctx context.Context, codeHash string) (*entity.OAuthLoginCode, error) {
 	var m gorm_model.OAuthLoginCode
-	if err := r.db.WithContext(ctx).Where("code_hash = ?", codeHash).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("code_hash = ?", codeHash).First(&m).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -44,7 +44,7 @@ func (r *OAuthLoginCodeRepositoryImpl) FindByCodeHash(ctx context.Context, codeH
 }
 
 func (r *OAuthLoginCodeRepositoryImpl) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
-	res := r.db.WithContext(ctx).Model(&gorm_model.OAuthLoginCode{}).
+	res := conn(ctx, r.db).Model(&gorm_model.OAuthLoginCode{}).
 		Where("id = ? AND used_at IS NULL", id).
 		Update("used_at", usedAt)
 	if res.Error != nil {
@@ -57,7 +57,7 @@ func (r *OAuthLoginCodeRepositoryImpl) MarkUsed(ctx context.Context, id string,
 }
 
 func (r *OAuthLoginCodeRepositoryImpl) DeleteExpired(ctx context.Context) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Where("expires_at < ? OR used_at IS NOT NULL", time.Now().Add(-1*time.Hour)).
 		Delete(&gorm_model.OAuthLoginCode{}).Error
 }
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+func (r *OutboxRepositoryImpl) Append(ctx context.Context, events ...*entity.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	models := make([]*gorm_model.OutboxEvent, len(events))
+	for i, e := range events {
+		models[i] = toOutboxEventModel(&entity.OutboxEvent{
+			DomainEvent: *e, Status: entity.OutboxPending, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now,
+		})
+	}
+	return conn(ctx, r.db).Create(models).Error
+}
+
+func (r *OutboxRepositoryImpl) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var models []gorm_model.OutboxEvent
+	err := conn(ctx, r.db).Raw(`
+		UPDATE outbox_events SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY occurred_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, string(entity.OutboxPending), now, limit).Scan(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.OutboxEvent, len(models))
+	for i := range models {
+		out[i] = toOutboxEventDomain(&models[i])
+	}
+	// RETURNING does not keep the subquery's order.
+	sort.SliceStable(out, func(i, j int) bool { return out[i].OccurredAt.Before(out[j].OccurredAt) })
+	return out, nil
+}
+
+func (r *OutboxRepositoryImpl) Update(ctx context.Context, e *entity.OutboxEvent) error {
+	return conn(ctx, r.db).Save(toOutboxEventModel(e)).Error
+}
+
+func (r *OutboxRepositoryImpl) DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := conn(ctx, r.db).Where("status = ? AND occurred_at < ?", string(entity.OutboxProcessed), before).
+		Delete(&gorm_model.OutboxEvent{})
+	return res.RowsAffected, res.Error
+}
+
+func toOutboxEventModel(e *entity.OutboxEvent) *gorm_model.OutboxEvent {
+	return &gorm_model.OutboxEvent{
+		ID: e.ID, Type: string(e.Type), AggregateType: e.AggregateType, AggregateID: e.AggregateID,
+		StartupID: optionalString(e.StartupID), TeamID: optionalString(e.TeamID), Payload: e.Payload,
+		Status: string(e.Status), Attempts: e.Attempts, NextAttemptAt: e.NextAttemptAt, LastError: e.LastError,
+		ProcessedAt: e.ProcessedAt, OccurredAt: e.OccurredAt, CreatedAt: e.CreatedAt, UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func toOutboxEventDomain(m *gorm_model.OutboxEvent) *entity.OutboxEvent {
+	e := &entity.OutboxEvent{
+		DomainEvent: entity.DomainEvent{
+			ID: m.ID, Type: entity.DomainEventType(m.Type), AggregateType: m.AggregateType, AggregateID: m.AggregateID,
+			Payload: m.Payload, OccurredAt: m.OccurredAt,
+		},
+		Status: entity.OutboxStatus(m.Status), Attempts: m.Attempts, NextAttemptAt: m.NextAttemptAt,
+		LastError: m.LastError, ProcessedAt: m.ProcessedAt, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+	if m.StartupID != nil {
+		e.StartupID = *m.StartupID
+	}
+	if m.TeamID != nil {
+		e.TeamID = *m.TeamID
+	}
+	return e
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
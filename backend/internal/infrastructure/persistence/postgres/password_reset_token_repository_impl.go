@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type PasswordResetTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetTokenRepository(db *gorm.DB) repository.PasswordResetTokenRepository {
+	return &PasswordResetTokenRepositoryImpl{db: db}
+}
+
+func (r *PasswordResetTokenRepositoryImpl) Create(ctx context.Context, token *entity.PasswordResetToken) error {
+	return conn(ctx, r.db).Create(&gorm_model.PasswordResetToken{
+		ID: token.ID, UserID: token.UserID, TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt, UsedAt: token.UsedAt, CreatedAt: token.CreatedAt,
+	}).Error
+}
+
+func (r *PasswordResetTokenRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	return r.first(conn(ctx, r.db).Where("token_hash = ?", tokenHash))
+}
+
+func (r *PasswordResetTokenRepositoryImpl) FindLatestByUser(ctx context.Context, userID string) (*entity.PasswordResetToken, error) {
+	return r.first(conn(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC"))
+}
+
+func (r *PasswordResetTokenRepositoryImpl) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	res := conn(ctx, r.db).Model(&gorm_model.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *PasswordResetTokenRepositoryImpl) MarkAllUsed(ctx context.Context, userID string, usedAt time.Time) error {
+	return conn(ctx, r.db).Model(&gorm_model.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", usedAt).Error
+}
+
+func (r *PasswordResetTokenRepositoryImpl) DeleteExpired(ctx context.Context) error {
+	return conn(ctx, r.db).
+		Where("expires_at < ? OR used_at IS NOT NULL", time.Now().Add(-1*time.Hour)).
+		Delete(&gorm_model.PasswordResetToken{}).Error
+}
+
+func (r *PasswordResetTokenRepositoryImpl) first(q *gorm.DB) (*entity.PasswordResetToken, error) {
+	var m gorm_model.PasswordResetToken
+	if err := q.First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entity.PasswordResetToken{
+		ID: m.ID, UserID: m.UserID, TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt, UsedAt: m.UsedAt, CreatedAt: m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SlugHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSlugHistoryRepository(db *gorm.DB) repository.SlugHistoryRepository {
+	return &SlugHistoryRepositoryImpl{db: db}
+}
+
+func (r *SlugHistoryRepositoryImpl) Record(ctx context.Context, h *entity.SlugHistory) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&gorm_model.SlugHistory{
+		ID: h.ID, OwnerType: string(h.OwnerType), OwnerID: h.OwnerID, Slug: h.Slug, CreatedAt: h.CreatedAt,
+	}).Error
+}
+
+func (r *SlugHistoryRepositoryImpl) FindBySlug(ctx context.Context, ownerType entity.SlugOwnerType, slug string) (*entity.SlugHistory, error) {
+	var m gorm_model.SlugHistory
+	if err := conn(ctx, r.db).Where("owner_type = ? AND slug = ?", string(ownerType), slug).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entity.SlugHistory{
+		ID: m.ID, OwnerType: entity.SlugOwnerType(m.OwnerType), OwnerID: m.OwnerID, Slug: m.Slug, CreatedAt: m.CreatedAt,
+	}, nil
+}
+
+func (r *SlugHistoryRepositoryImpl) Delete(ctx context.Context, ownerType entity.SlugOwnerType, ownerID, slug string) error {
+	return conn(ctx, r.db).
+		Where("owner_type = ? AND owner_id = ? AND slug = ?", string(ownerType), ownerID, slug).
+		Delete(&gorm_model.SlugHistory{}).Error
+}
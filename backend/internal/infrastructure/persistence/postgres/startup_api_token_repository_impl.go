@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type StartupAPITokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStartupAPITokenRepository(db *gorm.DB) repository.StartupAPITokenRepository {
+	return &StartupAPITokenRepositoryImpl{db: db}
+}
+
+func (r *StartupAPITokenRepositoryImpl) Create(ctx context.Context, t *entity.StartupAPIToken) error {
+	return conn(ctx, r.db).Create(toStartupAPITokenModel(t)).Error
+}
+
+func (r *StartupAPITokenRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.StartupAPIToken, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// startupAPITokenWithPlan is a token row joined with its startup's plan.
+type startupAPITokenWithPlan struct {
+	gorm_model.StartupAPIToken `gorm:"embedded"`
+	StartupPlan                string
+}
+
+func (r *StartupAPITokenRepositoryImpl) FindByHash(ctx context.Context, tokenHash string) (*entity.StartupAPIToken, error) {
+	var row startupAPITokenWithPlan
+	if err := conn(ctx, r.db).Table("startup_api_tokens").
+		Select("startup_api_tokens.*, startups.plan AS startup_plan").
+		Joins("JOIN startups ON startups.id = startup_api_tokens.startup_id").
+		Where("startup_api_tokens.token_hash = ?", tokenHash).
+		Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	t := toStartupAPITokenDomain(&row.StartupAPIToken)
+	t.StartupPlan = row.StartupPlan
+	return t, nil
+}
+
+func (r *StartupAPITokenRepositoryImpl) findOne(ctx context.Context, query string, arg string) (*entity.StartupAPIToken, error) {
+	var m gorm_model.StartupAPIToken
+	if err := conn(ctx, r.db).Where(query, arg).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toStartupAPITokenDomain(&m), nil
+}
+
+func (r *StartupAPITokenRepositoryImpl) ListByStartupID(ctx context.Context, startupID string) ([]*entity.StartupAPIToken, error) {
+	var models []gorm_model.StartupAPIToken
+	if err := conn(ctx, r.db).Where("startup_id = ?", startupID).
+		Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*entity.StartupAPIToken, len(models))
+	for i := range models {
+		out[i] = toStartupAPITokenDomain(&models[i])
+	}
+	return out, nil
+}
+
+func (r *StartupAPITokenRepositoryImpl) Revoke(ctx context.Context, id string, at time.Time) error {
+	return conn(ctx, r.db).Model(&gorm_model.StartupAPIToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at).Error
+}
+
+func (r *StartupAPITokenRepositoryImpl) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	return conn(ctx, r.db).Model(&gorm_model.StartupAPIToken{}).
+		Where("id = ?", id).Update("last_used_at", at).Error
+}
+
+func toStartupAPITokenModel(t *entity.StartupAPIToken) *gorm_model.StartupAPIToken {
+	scopes := make([]string, len(t.Scopes))
+	for i, s := range t.Scopes {
+		scopes[i] = string(s)
+	}
+	return &gorm_model.StartupAPIToken{
+		ID: t.ID, StartupID: t.StartupID, Name: t.Name, TokenHash: t.TokenHash, Prefix: t.Prefix,
+		Scopes: strings.Join(scopes, " "), ExpiresAt: t.ExpiresAt, LastUsedAt: t.LastUsedAt,
+		RevokedAt: t.RevokedAt, CreatedBy: t.CreatedBy, CreatedAt: t.CreatedAt,
+	}
+}
+
+func toStartupAPITokenDomain(m *gorm_model.StartupAPIToken) *entity.StartupAPIToken {
+	var scopes []entity.Scope
+	for _, s := range strings.Fields(m.Scopes) {
+		scopes = append(scopes, entity.Scope(s))
+	}
+	return &entity.StartupAPIToken{
+		ID: m.ID, StartupID: m.StartupID, Name: m.Name, TokenHash: m.TokenHash, Prefix: m.Prefix,
+		Scopes: scopes, ExpiresAt: m.ExpiresAt, LastUsedAt: m.LastUsedAt,
+		RevokedAt: m.RevokedAt, CreatedBy: m.CreatedBy, CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type StartupClaimRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStartupClaimRepository(db *gorm.DB) repository.StartupClaimRepository {
+	return &StartupClaimRepositoryImpl{db: db}
+}
+
+func (r *StartupClaimRepositoryImpl) Create(ctx context.Context, c *entity.StartupClaim) error {
+	return conn(ctx, r.db).Create(toStartupClaimModel(c)).Error
+}
+
+func (r *StartupClaimRepositoryImpl) Update(ctx context.Context, c *entity.StartupClaim) error {
+	return conn(ctx, r.db).Save(toStartupClaimModel(c)).Error
+}
+
+func (r *StartupClaimRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.StartupClaim, error) {
+	var m gorm_model.StartupClaim
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toStartupClaimDomain(&m), nil
+}
+
+func (r *StartupClaimRepositoryImpl) FindPendingByStartupAndUser(ctx context.Context, startupID, userID string) (*entity.StartupClaim, error) {
+	var m gorm_model.StartupClaim
+	err := conn(ctx, r.db).
+		Where("startup_id = ? AND user_id = ? AND status = ?", startupID, userID, string(entity.ClaimStatusPending)).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toStartupClaimDomain(&m), nil
+}
+
+func (r *StartupClaimRepositoryImpl) ListPendingByStartupID(ctx context.Context, startupID string) ([]*entity.StartupClaim, error) {
+	var models []gorm_model.StartupClaim
+	if err := conn(ctx, r.db).Where("startup_id = ? AND status = ?", startupID, string(entity.ClaimStatusPending)).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return toStartupClaimList(models), nil
+}
+
+func (r *StartupClaimRepositoryImpl) ListByUserID(ctx context.Context, userID string) ([]*entity.StartupClaim, error) {
+	var models []gorm_model.StartupClaim
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return toStartupClaimList(models), nil
+}
+
+func (r *StartupClaimRepositoryImpl) List(ctx context.Context, status entity.ClaimStatus, page, pageSize int) ([]*entity.StartupClaim, int64, error) {
+	q := conn(ctx, r.db).Model(&gorm_model.StartupClaim{})
+	if status != "" {
+		q = q.Where("status = ?", string(status))
+	}
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	var models []gorm_model.StartupClaim
+	if err := q.Order("created_at ASC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	return toStartupClaimList(models), total, nil
+}
+
+func (r *StartupClaimRepositoryImpl) AddEvent(ctx context.Context, e *entity.StartupClaimEvent) error {
+	return conn(ctx, r.db).Create(&gorm_model.StartupClaimEvent{
+		ID: e.ID, ClaimID: e.ClaimID, ActorID: e.ActorID, Action: string(e.Action), Note: e.Note, CreatedAt: e.CreatedAt,
+	}).Error
+}
+
+func (r *StartupClaimRepositoryImpl) ListEvents(ctx context.Context, claimID string) ([]*entity.StartupClaimEvent, error) {
+	var models []gorm_model.StartupClaimEvent
+	if err := conn(ctx, r.db).Where("claim_id = ?", claimID).Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*entity.StartupClaimEvent, len(models))
+	for i, m := range models {
+		out[i] = &entity.StartupClaimEvent{
+			ID: m.ID, ClaimID: m.ClaimID, ActorID: m.ActorID, Action: entity.ClaimEventAction(m.Action),
+			Note: m.Note, CreatedAt: m.CreatedAt,
+		}
+	}
+	return out, nil
+}
+
+func toStartupClaimList(models []gorm_model.StartupClaim) []*entity.StartupClaim {
+	out := make([]*entity.StartupClaim, len(models))
+	for i := range models {
+		out[i] = toStartupClaimDomain(&models[i])
+	}
+	return out
+}
+
+func toStartupClaimModel(c *entity.StartupClaim) *gorm_model.StartupClaim {
+	return &gorm_model.StartupClaim{
+		ID: c.ID, StartupID: c.StartupID, UserID: c.UserID, TeamID: c.TeamID,
+		Method: string(c.Method), Status: string(c.Status), Domain: c.Domain, Message: c.Message,
+		Token: c.Token, Email: c.Email, CodeHash: c.CodeHash, CodeAttempts: c.CodeAttempts,
+		CodeExpiresAt: c.CodeExpiresAt, RejectionReason: c.RejectionReason,
+		ReviewedBy: c.ReviewedBy, ReviewedAt: c.ReviewedAt, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toStartupClaimDomain(m *gorm_model.StartupClaim) *entity.StartupClaim {
+	return &entity.StartupClaim{
+		ID: m.ID, StartupID: m.StartupID, UserID: m.UserID, TeamID: m.TeamID,
+		Method: entity.ClaimMethod(m.Method), Status: entity.ClaimStatus(m.Status), Domain: m.Domain,
+		Message: m.Message, Token: m.Token, Email: m.Email, CodeHash: m.CodeHash,
+		CodeAttempts: m.CodeAttempts, CodeExpiresAt: m.CodeExpiresAt, RejectionReason: m.RejectionReason,
+		ReviewedBy: m.ReviewedBy, ReviewedAt: m.ReviewedAt, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+}
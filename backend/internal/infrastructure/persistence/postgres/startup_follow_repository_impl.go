@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StartupFollowRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStartupFollowRepository(db *gorm.DB) repository.StartupFollowRepository {
+	return &StartupFollowRepositoryImpl{db: db}
+}
+
+func (r *StartupFollowRepositoryImpl) Create(ctx context.Context, f *entity.StartupFollow) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&gorm_model.StartupFollow{
+		ID: f.ID, UserID: f.UserID, StartupID: f.StartupID, CreatedAt: f.CreatedAt,
+	}).Error
+}
+
+func (r *StartupFollowRepositoryImpl) Delete(ctx context.Context, userID, startupID string) error {
+	return conn(ctx, r.db).
+		Where("user_id = ? AND startup_id = ?", userID, startupID).
+		Delete(&gorm_model.StartupFollow{}).Error
+}
+
+func (r *StartupFollowRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	res := conn(ctx, r.db).Where("user_id = ?", userID).Delete(&gorm_model.StartupFollow{})
+	return res.RowsAffected, res.Error
+}
+
+func (r *StartupFollowRepositoryImpl) ListByUserID(ctx context.Context, userID string, page, pageSize int) ([]*entity.StartupFollow, int64, error) {
+	query := conn(ctx, r.db).Model(&gorm_model.StartupFollow{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	var models []gorm_model.StartupFollow
+	if err := query.Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	out := make([]*entity.StartupFollow, len(models))
+	for i, m := range models {
+		out[i] = &entity.StartupFollow{ID: m.ID, UserID: m.UserID, StartupID: m.StartupID, CreatedAt: m.CreatedAt}
+	}
+	return out, total, nil
+}
+
+func (r *StartupFollowRepositoryImpl) CountByStartupID(ctx context.Context, startupID string) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&gorm_model.StartupFollow{}).Where("startup_id = ?", startupID).Count(&count).Error
+	return count, err
+}
+
+type FollowNotificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFollowNotificationRepository(db *gorm.DB) repository.FollowNotificationRepository {
+	return &FollowNotificationRepositoryImpl{db: db}
+}
+
+func (r *FollowNotificationRepositoryImpl) EnqueueForJob(ctx context.Context, startupID, jobID string, at time.Time) (int64, error) {
+	res := conn(ctx, r.db).Exec(`
+		INSERT INTO follow_notifications (id, user_id, startup_id, job_id, created_at)
+		SELECT gen_random_uuid(), user_id, startup_id, ?, ?
+		FROM startup_follows WHERE startup_id = ?`, jobID, at, startupID)
+	return res.RowsAffected, res.Error
+}
+
+func (r *FollowNotificationRepositoryImpl) ListPendingUserIDs(ctx context.Context, limit int) ([]string, error) {
+	var ids []string
+	err := conn(ctx, r.db).Model(&gorm_model.FollowNotification{}).
+		Where("sent_at IS NULL").Distinct("user_id").Limit(limit).Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+func (r *FollowNotificationRepositoryImpl) ListPendingByUserID(ctx context.Context, userID string) ([]*entity.FollowNotification, error) {
+	var models []gorm_model.FollowNotification
+	if err := conn(ctx, r.db).Where("user_id = ? AND sent_at IS NULL", userID).
+		Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*entity.FollowNotification, len(models))
+	for i, m := range models {
+		out[i] = &entity.FollowNotification{
+			ID: m.ID, UserID: m.UserID, StartupID: m.StartupID, JobID: m.JobID,
+			CreatedAt: m.CreatedAt, SentAt: m.SentAt,
+		}
+	}
+	return out, nil
+}
+
+func (r *FollowNotificationRepositoryImpl) MarkSent(ctx context.Context, ids []string, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).Model(&gorm_model.FollowNotification{}).
+		Where("id IN ?", ids).Update("sent_at", at).Error
+}
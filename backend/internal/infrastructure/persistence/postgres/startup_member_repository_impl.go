@@ -18,21 +18,21 @@ func NewStartupMemberRepository(db *gorm.DB) repository.StartupMemberRepository
 
 func (r *StartupMemberRepositoryImpl) Create(ctx context.Context, member *entity.StartupMember) error {
 	model := r.toModel(member)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *StartupMemberRepositoryImpl) Update(ctx context.Context, member *entity.StartupMember) error {
 	model := r.toModel(member)
-	return r.db.WithContext(ctx).Save(model).Error
+	return conn(ctx, r.db).Save(model).Error
 }
 
 func (r *StartupMemberRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&gorm_model.StartupMember{}, id).Error
+	return conn(ctx, r.db).Delete(&gorm_model.StartupMember{}, id).Error
 }
 
 func (r *StartupMemberRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.StartupMember, error) {
 	var model gorm_model.StartupMember
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
@@ -40,7 +40,7 @@ func (r *StartupMemberRepositoryImpl) FindByID(ctx context.Context, id string) (
 
 func (r *StartupMemberRepositoryImpl) FindByUserAndStartup(ctx context.Context, userID, startupID string) (*entity.StartupMember, error) {
 	var model gorm_model.StartupMember
-	if err := r.db.WithContext(ctx).Where("user_id = ? AND startup_id = ?", userID, startupID).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("user_id = ? AND startup_id = ?", userID, startupID).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
@@ -48,7 +48,7 @@ func (r *StartupMemberRepositoryImpl) FindByUserAndStartup(ctx context.Context,
 
 func (r *StartupMemberRepositoryImpl) FindByStartupID(ctx context.Context, startupID string) ([]*entity.StartupMember, error) {
 	var models []gorm_model.StartupMember
-	if err := r.db.WithContext(ctx).Where("startup_id = ?", startupID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("startup_id = ?", startupID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -61,7 +61,7 @@ func (r *StartupMemberRepositoryImpl) FindByStartupID(ctx context.Context, start
 
 func (r *StartupMemberRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]*entity.StartupMember, error) {
 	var models []gorm_model.StartupMember
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
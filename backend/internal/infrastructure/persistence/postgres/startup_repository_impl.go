@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
@@ -21,62 +22,66 @@ func NewStartupRepository(db *gorm.DB) repository.StartupRepository {
 
 func (r *StartupRepositoryImpl) Create(ctx context.Context, startup *entity.Startup) error {
 	model := r.toModel(startup)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *StartupRepositoryImpl) Update(ctx context.Context, startup *entity.Startup) error {
 	model := r.toModel(startup)
-	return r.db.WithContext(ctx).Save(model).Error
+	return conn(ctx, r.db).Save(model).Error
+}
+
+func (r *StartupRepositoryImpl) LinkTeam(ctx context.Context, id, teamID string, at time.Time) (bool, error) {
+	res := conn(ctx, r.db).Model(&gorm_model.Startup{}).
+		Where("id = ? AND team_id IS NULL", id).
+		Updates(map[string]interface{}{"team_id": teamID, "updated_at": at})
+	return res.RowsAffected == 1, res.Error
 }
 
 func (r *StartupRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&gorm_model.Startup{}, id).Error
+	return conn(ctx, r.db).Delete(&gorm_model.Startup{}, id).Error
 }
 
 func (r *StartupRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.Startup, error) {
 	var model gorm_model.Startup
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
 }
 
-func (r *StartupRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
-	var model gorm_model.Startup
-	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&model).Error; err != nil {
+func (r *StartupRepositoryImpl) FindByIDs(ctx context.Context, ids []string) ([]*entity.Startup, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var models []gorm_model.Startup
+	if err := conn(ctx, r.db).Where("id IN ?", ids).Find(&models).Error; err != nil {
 		return nil, err
 	}
-	return r.toDomain(&model), nil
+	startups := make([]*entity.Startup, len(models))
+	for i := range models {
+		startups[i] = r.toDomain(&models[i])
+	}
+	return startups, nil
 }
 
-func (r *StartupRepositoryImpl) FindByAPIToken(ctx context.Context, token string) (*entity.Startup, error) {
+func (r *StartupRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*entity.Startup, error) {
 	var model gorm_model.Startup
-	hashed := utils.HashToken(token)
-	err := r.db.WithContext(ctx).Where("api_token = ?", hashed).First(&model).Error
-	if err == nil {
-		return r.toDomain(&model), nil
-	}
-	// Upgrade path: legacy plaintext tokens are hashed on first successful use.
-	if err := r.db.WithContext(ctx).Where("api_token = ?", token).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("slug = ?", slug).First(&model).Error; err != nil {
 		return nil, err
 	}
-	if !utils.LooksLikeTokenHash(model.APIToken) {
-		_ = r.db.WithContext(ctx).Model(&model).Update("api_token", hashed).Error
-		model.APIToken = hashed
-	}
 	return r.toDomain(&model), nil
 }
 
 func (r *StartupRepositoryImpl) FindByStripeSubscriptionID(ctx context.Context, subscriptionID string) (*entity.Startup, error) {
 	var model gorm_model.Startup
-	if err := r.db.WithContext(ctx).Where("stripe_subscription_id = ?", subscriptionID).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("stripe_subscription_id = ?", subscriptionID).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
 }
 
 func (r *StartupRepositoryImpl) List(ctx context.Context, filter repository.StartupFilter) ([]*entity.Startup, int64, error) {
-	query := r.db.WithContext(ctx).Model(&gorm_model.Startup{})
+	query := conn(ctx, r.db).Model(&gorm_model.Startup{})
 
 	if filter.Industry != "" {
 		query = query.Where("industry = ?", filter.Industry)
@@ -96,6 +101,13 @@ func (r *StartupRepositoryImpl) List(ctx context.Context, filter repository.Star
 		companySize := "%" + strings.ToLower(filter.CompanySize) + "%"
 		query = query.Where("LOWER(company_size) LIKE ?", companySize)
 	}
+	if filter.Verified != nil {
+		if *filter.Verified {
+			query = query.Where("verified_at IS NOT NULL")
+		} else {
+			query = query.Where("verified_at IS NULL")
+		}
+	}
 
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
@@ -126,7 +138,7 @@ func (r *StartupRepositoryImpl) List(ctx context.Context, filter repository.Star
 
 func (r *StartupRepositoryImpl) FindByTeamID(ctx context.Context, teamID string) ([]*entity.Startup, error) {
 	var models []gorm_model.Startup
-	if err := r.db.WithContext(ctx).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	startups := make([]*entity.Startup, len(models))
@@ -151,7 +163,6 @@ func (r *StartupRepositoryImpl) toModel(startup *entity.Startup) *gorm_model.Sta
 		LinkedIn:        startup.SocialLinks.LinkedIn,
 		Twitter:         startup.SocialLinks.Twitter,
 		GitHub:          startup.SocialLinks.GitHub,
-		APIToken:        startup.APIToken,
 		AllowPublicJoin: startup.AllowPublicJoin,
 		JoinCode:        startup.JoinCode,
 		Status:          string(startup.Status),
@@ -160,6 +171,7 @@ func (r *StartupRepositoryImpl) toModel(startup *entity.Startup) *gorm_model.Sta
 		PlanExpiresAt:        startup.PlanExpiresAt,
 		StripeCustomerID:     startup.StripeCustomerID,
 		StripeSubscriptionID: startup.StripeSubscriptionID,
+		VerifiedAt:           startup.VerifiedAt,
 		CreatedAt:       startup.CreatedAt,
 		UpdatedAt:       startup.UpdatedAt,
 	}
@@ -186,7 +198,6 @@ func (r *StartupRepositoryImpl) toDomain(model *gorm_model.Startup) *entity.Star
 			Twitter:  model.Twitter,
 			GitHub:   model.GitHub,
 		},
-		APIToken:        model.APIToken,
 		AllowPublicJoin: model.AllowPublicJoin,
 		JoinCode:        model.JoinCode,
 		Status:          entity.StartupStatus(model.Status),
@@ -195,6 +206,7 @@ func (r *StartupRepositoryImpl) toDomain(model *gorm_model.Startup) *entity.Star
 		PlanExpiresAt:        model.PlanExpiresAt,
 		StripeCustomerID:     model.StripeCustomerID,
 		StripeSubscriptionID: model.StripeSubscriptionID,
+		VerifiedAt:           model.VerifiedAt,
 		CreatedAt:       model.CreatedAt,
 		UpdatedAt:       model.UpdatedAt,
 	}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StartupVerificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStartupVerificationRepository(db *gorm.DB) repository.StartupVerificationRepository {
+	return &StartupVerificationRepositoryImpl{db: db}
+}
+
+func (r *StartupVerificationRepositoryImpl) Save(ctx context.Context, v *entity.StartupVerification) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "startup_id"}},
+		UpdateAll: true,
+	}).Create(toStartupVerificationModel(v)).Error
+}
+
+func (r *StartupVerificationRepositoryImpl) FindByStartupID(ctx context.Context, startupID string) (*entity.StartupVerification, error) {
+	var m gorm_model.StartupVerification
+	if err := conn(ctx, r.db).Where("startup_id = ?", startupID).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toStartupVerificationDomain(&m), nil
+}
+
+func (r *StartupVerificationRepositoryImpl) FindDueForRecheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*entity.StartupVerification, error) {
+	var models []gorm_model.StartupVerification
+	err := conn(ctx, r.db).
+		Where("verified_at IS NOT NULL AND method IN ?", []string{string(entity.VerificationMethodDNS), string(entity.VerificationMethodFile)}).
+		Where("last_checked_at IS NULL OR last_checked_at < ?", checkedBefore).
+		Order("last_checked_at ASC NULLS FIRST").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.StartupVerification, len(models))
+	for i := range models {
+		out[i] = toStartupVerificationDomain(&models[i])
+	}
+	return out, nil
+}
+
+func toStartupVerificationModel(v *entity.StartupVerification) *gorm_model.StartupVerification {
+	return &gorm_model.StartupVerification{
+		ID: v.ID, StartupID: v.StartupID, Domain: v.Domain, Method: string(v.Method),
+		Token: v.Token, Email: v.Email, CodeHash: v.CodeHash, ExpiresAt: v.ExpiresAt,
+		VerifiedAt: v.VerifiedAt, LastCheckedAt: v.LastCheckedAt, Failures: v.Failures,
+		CreatedAt: v.CreatedAt, UpdatedAt: v.UpdatedAt,
+	}
+}
+
+func toStartupVerificationDomain(m *gorm_model.StartupVerification) *entity.StartupVerification {
+	return &entity.StartupVerification{
+		ID: m.ID, StartupID: m.StartupID, Domain: m.Domain, Method: entity.VerificationMethod(m.Method),
+		Token: m.Token, Email: m.Email, CodeHash: m.CodeHash, ExpiresAt: m.ExpiresAt,
+		VerifiedAt: m.VerifiedAt, LastCheckedAt: m.LastCheckedAt, Failures: m.Failures,
+		CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+}
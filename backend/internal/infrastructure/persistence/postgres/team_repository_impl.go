@@ -19,14 +19,14 @@ func NewTeamRepository(db *gorm.DB) repository.TeamRepository {
 }
 
 func (r *TeamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Create(&gorm_model.Team{
+	return conn(ctx, r.db).Create(&gorm_model.Team{
 		ID: team.ID, Name: team.Name, Slug: team.Slug, CreatedBy: team.CreatedBy,
 		CreatedAt: team.CreatedAt, UpdatedAt: team.UpdatedAt,
 	}).Error
 }
 
 func (r *TeamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Save(&gorm_model.Team{
+	return conn(ctx, r.db).Save(&gorm_model.Team{
 		ID: team.ID, Name: team.Name, Slug: team.Slug, CreatedBy: team.CreatedBy,
 		CreatedAt: team.CreatedAt, UpdatedAt: team.UpdatedAt,
 	}).Error
@@ -34,7 +34,7 @@ func (r *TeamRepositoryImpl) Update(ctx context.Context, team *entity.Team) erro
 
 func (r *TeamRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.Team, error) {
 	var m gorm_model.Team
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&m), nil
@@ -42,7 +42,7 @@ func (r *TeamRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.T
 
 func (r *TeamRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*entity.Team, error) {
 	var m gorm_model.Team
-	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("slug = ?", slug).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&m), nil
@@ -50,7 +50,7 @@ func (r *TeamRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*enti
 
 func (r *TeamRepositoryImpl) ListByUserID(ctx context.Context, userID string) ([]*entity.Team, error) {
 	var models []gorm_model.Team
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Joins("JOIN team_members ON team_members.team_id = teams.id").
 		Where("team_members.user_id = ? AND team_members.status = ?", userID, string(entity.MemberStatusActive)).
 		Find(&models).Error
@@ -66,7 +66,7 @@ func (r *TeamRepositoryImpl) ListByUserID(ctx context.Context, userID string) ([
 
 func (r *TeamRepositoryImpl) List(ctx context.Context, page, pageSize int) ([]*entity.Team, int64, error) {
 	var total int64
-	q := r.db.WithContext(ctx).Model(&gorm_model.Team{})
+	q := conn(ctx, r.db).Model(&gorm_model.Team{})
 	if err := q.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
@@ -103,20 +103,20 @@ func NewTeamMemberRepository(db *gorm.DB) repository.TeamMemberRepository {
 }
 
 func (r *TeamMemberRepositoryImpl) Create(ctx context.Context, member *entity.TeamMember) error {
-	return r.db.WithContext(ctx).Create(r.toModel(member)).Error
+	return conn(ctx, r.db).Create(r.toModel(member)).Error
 }
 
 func (r *TeamMemberRepositoryImpl) Update(ctx context.Context, member *entity.TeamMember) error {
-	return r.db.WithContext(ctx).Save(r.toModel(member)).Error
+	return conn(ctx, r.db).Save(r.toModel(member)).Error
 }
 
 func (r *TeamMemberRepositoryImpl) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&gorm_model.TeamMember{}, "id = ?", id).Error
+	return conn(ctx, r.db).Delete(&gorm_model.TeamMember{}, "id = ?", id).Error
 }
 
 func (r *TeamMemberRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.TeamMember, error) {
 	var m gorm_model.TeamMember
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&m), nil
@@ -124,7 +124,7 @@ func (r *TeamMemberRepositoryImpl) FindByID(ctx context.Context, id string) (*en
 
 func (r *TeamMemberRepositoryImpl) FindByUserAndTeam(ctx context.Context, userID, teamID string) (*entity.TeamMember, error) {
 	var m gorm_model.TeamMember
-	if err := r.db.WithContext(ctx).Where("user_id = ? AND team_id = ?", userID, teamID).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("user_id = ? AND team_id = ?", userID, teamID).First(&m).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -135,7 +135,7 @@ func (r *TeamMemberRepositoryImpl) FindByUserAndTeam(ctx context.Context, userID
 
 func (r *TeamMemberRepositoryImpl) FindByTeamID(ctx context.Context, teamID string) ([]*entity.TeamMember, error) {
 	var models []gorm_model.TeamMember
-	if err := r.db.WithContext(ctx).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*entity.TeamMember, len(models))
@@ -147,7 +147,7 @@ func (r *TeamMemberRepositoryImpl) FindByTeamID(ctx context.Context, teamID stri
 
 func (r *TeamMemberRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]*entity.TeamMember, error) {
 	var models []gorm_model.TeamMember
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*entity.TeamMember, len(models))
@@ -182,7 +182,7 @@ func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
 }
 
 func (r *RoleRepositoryImpl) Create(ctx context.Context, role *entity.Role) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(&gorm_model.Role{
 			ID: role.ID, TeamID: role.TeamID, Name: role.Name, Slug: role.Slug,
 			IsSystem: role.IsSystem, CreatedAt: role.CreatedAt, UpdatedAt: role.UpdatedAt,
@@ -194,7 +194,7 @@ func (r *RoleRepositoryImpl) Create(ctx context.Context, role *entity.Role) erro
 }
 
 func (r *RoleRepositoryImpl) Update(ctx context.Context, role *entity.Role) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Save(&gorm_model.Role{
 			ID: role.ID, TeamID: role.TeamID, Name: role.Name, Slug: role.Slug,
 			IsSystem: role.IsSystem, CreatedAt: role.CreatedAt, UpdatedAt: role.UpdatedAt,
@@ -207,7 +207,7 @@ func (r *RoleRepositoryImpl) Update(ctx context.Context, role *entity.Role) erro
 
 func (r *RoleRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.Role, error) {
 	var m gorm_model.Role
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(ctx, &m)
@@ -215,7 +215,7 @@ func (r *RoleRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.R
 
 func (r *RoleRepositoryImpl) FindSystemBySlug(ctx context.Context, slug string) (*entity.Role, error) {
 	var m gorm_model.Role
-	if err := r.db.WithContext(ctx).Where("slug = ? AND is_system = ? AND team_id IS NULL", slug, true).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("slug = ? AND is_system = ? AND team_id IS NULL", slug, true).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(ctx, &m)
@@ -224,7 +224,7 @@ func (r *RoleRepositoryImpl) FindSystemBySlug(ctx context.Context, slug string)
 func (r *RoleRepositoryImpl) FindByTeamID(ctx context.Context, teamID string) ([]*entity.Role, error) {
 	var models []gorm_model.Role
 	// Team-specific roles + global system templates
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("team_id = ? OR (is_system = ? AND team_id IS NULL)", teamID, true).
 		Find(&models).Error; err != nil {
 		return nil, err
@@ -242,7 +242,7 @@ func (r *RoleRepositoryImpl) FindByTeamID(ctx context.Context, teamID string) ([
 
 func (r *RoleRepositoryImpl) ListSystem(ctx context.Context) ([]*entity.Role, error) {
 	var models []gorm_model.Role
-	if err := r.db.WithContext(ctx).Where("is_system = ? AND team_id IS NULL", true).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("is_system = ? AND team_id IS NULL", true).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*entity.Role, 0, len(models))
@@ -257,7 +257,7 @@ func (r *RoleRepositoryImpl) ListSystem(ctx context.Context) ([]*entity.Role, er
 }
 
 func (r *RoleRepositoryImpl) ReplaceScopes(ctx context.Context, roleID string, scopes []entity.Scope) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		return r.replaceScopesTx(tx, roleID, scopes)
 	})
 }
@@ -278,7 +278,7 @@ func (r *RoleRepositoryImpl) replaceScopesTx(tx *gorm.DB, roleID string, scopes
 
 func (r *RoleRepositoryImpl) toDomain(ctx context.Context, m *gorm_model.Role) (*entity.Role, error) {
 	var scopes []gorm_model.RoleScope
-	if err := r.db.WithContext(ctx).Where("role_id = ?", m.ID).Find(&scopes).Error; err != nil {
+	if err := conn(ctx, r.db).Where("role_id = ?", m.ID).Find(&scopes).Error; err != nil {
 		return nil, err
 	}
 	out := make([]entity.Scope, len(scopes))
@@ -300,16 +300,24 @@ func NewTeamInvitationRepository(db *gorm.DB) repository.TeamInvitationRepositor
 }
 
 func (r *TeamInvitationRepositoryImpl) Create(ctx context.Context, inv *entity.TeamInvitation) error {
-	return r.db.WithContext(ctx).Create(r.toModel(inv)).Error
+	return conn(ctx, r.db).Create(r.toModel(inv)).Error
 }
 
 func (r *TeamInvitationRepositoryImpl) Update(ctx context.Context, inv *entity.TeamInvitation) error {
-	return r.db.WithContext(ctx).Save(r.toModel(inv)).Error
+	return conn(ctx, r.db).Save(r.toModel(inv)).Error
+}
+
+func (r *TeamInvitationRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.TeamInvitation, error) {
+	var m gorm_model.TeamInvitation
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		return nil, err
+	}
+	return r.toDomain(&m), nil
 }
 
 func (r *TeamInvitationRepositoryImpl) FindByToken(ctx context.Context, token string) (*entity.TeamInvitation, error) {
 	var m gorm_model.TeamInvitation
-	if err := r.db.WithContext(ctx).Where("token = ?", token).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("token = ?", token).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&m), nil
@@ -317,7 +325,7 @@ func (r *TeamInvitationRepositoryImpl) FindByToken(ctx context.Context, token st
 
 func (r *TeamInvitationRepositoryImpl) FindByTeamID(ctx context.Context, teamID string) ([]*entity.TeamInvitation, error) {
 	var models []gorm_model.TeamInvitation
-	if err := r.db.WithContext(ctx).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("team_id = ?", teamID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*entity.TeamInvitation, len(models))
@@ -331,7 +339,7 @@ func (r *TeamInvitationRepositoryImpl) toModel(inv *entity.TeamInvitation) *gorm
 	return &gorm_model.TeamInvitation{
 		ID: inv.ID, TeamID: inv.TeamID, Email: inv.Email, RoleID: inv.RoleID,
 		Token: inv.Token, InvitedBy: inv.InvitedBy, Status: string(inv.Status),
-		ExpiresAt: inv.ExpiresAt, CreatedAt: inv.CreatedAt, UpdatedAt: inv.UpdatedAt,
+		ExpiresAt: inv.ExpiresAt, Locale: inv.Locale, CreatedAt: inv.CreatedAt, UpdatedAt: inv.UpdatedAt,
 	}
 }
 
@@ -339,7 +347,7 @@ func (r *TeamInvitationRepositoryImpl) toDomain(m *gorm_model.TeamInvitation) *e
 	return &entity.TeamInvitation{
 		ID: m.ID, TeamID: m.TeamID, Email: m.Email, RoleID: m.RoleID,
 		Token: m.Token, InvitedBy: m.InvitedBy, Status: entity.InvitationStatus(m.Status),
-		ExpiresAt: m.ExpiresAt, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+		ExpiresAt: m.ExpiresAt, Locale: m.Locale, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
 	}
 }
 
@@ -352,7 +360,7 @@ func NewOAuthAccountRepository(db *gorm.DB) repository.OAuthAccountRepository {
 }
 
 func (r *OAuthAccountRepositoryImpl) Create(ctx context.Context, account *entity.OAuthAccount) error {
-	return r.db.WithContext(ctx).Create(&gorm_model.OAuthAccount{
+	return conn(ctx, r.db).Create(&gorm_model.OAuthAccount{
 		ID: account.ID, UserID: account.UserID, Provider: string(account.Provider),
 		ProviderUserID: account.ProviderUserID, Email: account.Email,
 		CreatedAt: account.CreatedAt, UpdatedAt: account.UpdatedAt,
@@ -361,7 +369,7 @@ func (r *OAuthAccountRepositoryImpl) Create(ctx context.Context, account *entity
 
 func (r *OAuthAccountRepositoryImpl) FindByProviderAndUserID(ctx context.Context, provider entity.OAuthProvider, providerUserID string) (*entity.OAuthAccount, error) {
 	var m gorm_model.OAuthAccount
-	if err := r.db.WithContext(ctx).Where("provider = ? AND provider_user_id = ?", string(provider), providerUserID).First(&m).Error; err != nil {
+	if err := conn(ctx, r.db).Where("provider = ? AND provider_user_id = ?", string(provider), providerUserID).First(&m).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -376,7 +384,7 @@ func (r *OAuthAccountRepositoryImpl) FindByProviderAndUserID(ctx context.Context
 
 func (r *OAuthAccountRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]*entity.OAuthAccount, error) {
 	var models []gorm_model.OAuthAccount
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	out := make([]*entity.OAuthAccount, len(models))
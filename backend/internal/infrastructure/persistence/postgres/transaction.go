@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// TxManager implements port.TxManager. The transaction travels in the
+// context, and every repository here resolves its handle through conn, so
+// use cases compose repositories without passing the transaction around.
+type TxManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) port.TxManager {
+	return &TxManager{db: db}
+}
+
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the transaction carried by ctx, or db outside one, bound to ctx.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
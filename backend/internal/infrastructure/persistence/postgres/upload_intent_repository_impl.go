@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type UploadIntentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUploadIntentRepository(db *gorm.DB) repository.UploadIntentRepository {
+	return &UploadIntentRepositoryImpl{db: db}
+}
+
+func (r *UploadIntentRepositoryImpl) Create(ctx context.Context, intent *entity.UploadIntent) error {
+	return conn(ctx, r.db).Create(toUploadIntentModel(intent)).Error
+}
+
+func (r *UploadIntentRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.UploadIntent, error) {
+	var m gorm_model.UploadIntent
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toUploadIntentDomain(&m), nil
+}
+
+func (r *UploadIntentRepositoryImpl) Update(ctx context.Context, intent *entity.UploadIntent) error {
+	return conn(ctx, r.db).Save(toUploadIntentModel(intent)).Error
+}
+
+func (r *UploadIntentRepositoryImpl) MarkCompleted(ctx context.Context, id, fileID string, at time.Time) (bool, error) {
+	res := conn(ctx, r.db).Model(&gorm_model.UploadIntent{}).
+		Where("id = ? AND completed_at IS NULL", id).
+		Updates(map[string]interface{}{"file_id": fileID, "completed_at": at})
+	return res.RowsAffected == 1, res.Error
+}
+
+func toUploadIntentModel(u *entity.UploadIntent) *gorm_model.UploadIntent {
+	return &gorm_model.UploadIntent{
+		ID: u.ID, UserID: u.UserID, StorageKey: u.StorageKey, FileName: u.FileName,
+		ContentType: u.ContentType, MaxSize: u.MaxSize, ExpiresAt: u.ExpiresAt,
+		FileID: u.FileID, CompletedAt: u.CompletedAt, CreatedAt: u.CreatedAt,
+	}
+}
+
+func toUploadIntentDomain(m *gorm_model.UploadIntent) *entity.UploadIntent {
+	return &entity.UploadIntent{
+		ID: m.ID, UserID: m.UserID, StorageKey: m.StorageKey, FileName: m.FileName,
+		ContentType: m.ContentType, MaxSize: m.MaxSize, ExpiresAt: m.ExpiresAt,
+		FileID: m.FileID, CompletedAt: m.CompletedAt, CreatedAt: m.CreatedAt,
+	}
+}
+
+func (r *UploadIntentRepositoryImpl) ListStale(ctx context.Context, expiredBefore time.Time, limit int) ([]*entity.UploadIntent, error) {
+	var models []gorm_model.UploadIntent
+	err := conn(ctx, r.db).
+		Where("completed_at IS NULL AND expires_at < ?", expiredBefore).
+		Order("expires_at").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.UploadIntent, len(models))
+	for i := range models {
+		out[i] = toUploadIntentDomain(&models[i])
+	}
+	return out, nil
+}
+
+func (r *UploadIntentRepositoryImpl) Delete(ctx context.Context, id string) error {
+	return conn(ctx, r.db).Delete(&gorm_model.UploadIntent{}, "id = ?", id).Error
+}
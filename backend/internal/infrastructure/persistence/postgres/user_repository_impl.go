@@ -18,17 +18,17 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 
 func (r *UserRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
 	model := r.toModel(user)
-	return r.db.WithContext(ctx).Create(model).Error
+	return conn(ctx, r.db).Create(model).Error
 }
 
 func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
 	model := r.toModel(user)
-	return r.db.WithContext(ctx).Save(model).Error
+	return conn(ctx, r.db).Save(model).Error
 }
 
 func (r *UserRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.User, error) {
 	var model gorm_model.User
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
@@ -36,14 +36,14 @@ func (r *UserRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.U
 
 func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var model gorm_model.User
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&model).Error; err != nil {
+	if err := conn(ctx, r.db).Where("email = ?", email).First(&model).Error; err != nil {
 		return nil, err
 	}
 	return r.toDomain(&model), nil
 }
 
 func (r *UserRepositoryImpl) List(ctx context.Context, page, pageSize int, search string) ([]*entity.User, int64, error) {
-	q := r.db.WithContext(ctx).Model(&gorm_model.User{})
+	q := conn(ctx, r.db).Model(&gorm_model.User{})
 	if search != "" {
 		like := "%" + search + "%"
 		q = q.Where("email ILIKE ? OR name ILIKE ?", like, like)
@@ -79,6 +79,8 @@ func (r *UserRepositoryImpl) toModel(user *entity.User) *gorm_model.User {
 		StartupID: user.StartupID,
 		Status:       string(user.Status),
 		TokenVersion: user.TokenVersion,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		Locale:       user.Locale,
 		CreatedAt:    user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -94,6 +96,8 @@ func (r *UserRepositoryImpl) toDomain(model *gorm_model.User) *entity.User {
 		StartupID: model.StartupID,
 		Status:       entity.UserStatus(model.Status),
 		TokenVersion: model.TokenVersion,
+		EmailVerifiedAt: model.EmailVerifiedAt,
+		Locale:       model.Locale,
 		CreatedAt:    model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
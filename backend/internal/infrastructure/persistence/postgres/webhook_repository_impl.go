@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"gorm.io/gorm"
+)
+
+type WebhookEndpointRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookEndpointRepository(db *gorm.DB) repository.WebhookEndpointRepository {
+	return &WebhookEndpointRepositoryImpl{db: db}
+}
+
+func (r *WebhookEndpointRepositoryImpl) Create(ctx context.Context, e *entity.WebhookEndpoint) error {
+	return conn(ctx, r.db).Create(toWebhookEndpointModel(e)).Error
+}
+
+func (r *WebhookEndpointRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.WebhookEndpoint, error) {
+	var m gorm_model.WebhookEndpoint
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toWebhookEndpointDomain(&m), nil
+}
+
+func (r *WebhookEndpointRepositoryImpl) ListByTeamID(ctx context.Context, teamID string) ([]*entity.WebhookEndpoint, error) {
+	var models []gorm_model.WebhookEndpoint
+	if err := conn(ctx, r.db).Where("team_id = ?", teamID).Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*entity.WebhookEndpoint, len(models))
+	for i := range models {
+		out[i] = toWebhookEndpointDomain(&models[i])
+	}
+	return out, nil
+}
+
+func (r *WebhookEndpointRepositoryImpl) Update(ctx context.Context, e *entity.WebhookEndpoint) error {
+	return conn(ctx, r.db).Save(toWebhookEndpointModel(e)).Error
+}
+
+func (r *WebhookEndpointRepositoryImpl) Delete(ctx context.Context, id string) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("endpoint_id = ?", id).Delete(&gorm_model.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&gorm_model.WebhookEndpoint{}).Error
+	})
+}
+
+func toWebhookEndpointModel(e *entity.WebhookEndpoint) *gorm_model.WebhookEndpoint {
+	events := make([]string, len(e.Events))
+	for i, ev := range e.Events {
+		events[i] = string(ev)
+	}
+	return &gorm_model.WebhookEndpoint{
+		ID: e.ID, TeamID: e.TeamID, URL: e.URL, Secret: e.Secret, Events: strings.Join(events, " "),
+		Active: e.Active, CreatedBy: e.CreatedBy, CreatedAt: e.CreatedAt, UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func toWebhookEndpointDomain(m *gorm_model.WebhookEndpoint) *entity.WebhookEndpoint {
+	var events []entity.WebhookEvent
+	for _, ev := range strings.Fields(m.Events) {
+		events = append(events, entity.WebhookEvent(ev))
+	}
+	return &entity.WebhookEndpoint{
+		ID: m.ID, TeamID: m.TeamID, URL: m.URL, Secret: m.Secret, Events: events,
+		Active: m.Active, CreatedBy: m.CreatedBy, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+}
+
+type WebhookDeliveryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{db: db}
+}
+
+func (r *WebhookDeliveryRepositoryImpl) Create(ctx context.Context, d *entity.WebhookDelivery) error {
+	return conn(ctx, r.db).Create(toWebhookDeliveryModel(d)).Error
+}
+
+func (r *WebhookDeliveryRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	var m gorm_model.WebhookDelivery
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toWebhookDeliveryDomain(&m), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ListByEndpointID(ctx context.Context, endpointID string, limit int) ([]*entity.WebhookDelivery, error) {
+	var models []gorm_model.WebhookDelivery
+	if err := conn(ctx, r.db).Where("endpoint_id = ?", endpointID).
+		Order("created_at DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveryList(models), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	var models []gorm_model.WebhookDelivery
+	err := conn(ctx, r.db).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, string(entity.WebhookDeliveryPending), now, limit).Scan(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveryList(models), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) Update(ctx context.Context, d *entity.WebhookDelivery) error {
+	return conn(ctx, r.db).Save(toWebhookDeliveryModel(d)).Error
+}
+
+func toWebhookDeliveryList(models []gorm_model.WebhookDelivery) []*entity.WebhookDelivery {
+	out := make([]*entity.WebhookDelivery, len(models))
+	for i := range models {
+		out[i] = toWebhookDeliveryDomain(&models[i])
+	}
+	return out
+}
+
+func toWebhookDeliveryModel(d *entity.WebhookDelivery) *gorm_model.WebhookDelivery {
+	return &gorm_model.WebhookDelivery{
+		ID: d.ID, EndpointID: d.EndpointID, Event: string(d.Event), Payload: d.Payload,
+		Status: string(d.Status), Attempts: d.Attempts, NextAttemptAt: d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus, ResponseBody: d.ResponseBody, LastError: d.LastError,
+		DeliveredAt: d.DeliveredAt, CreatedAt: d.CreatedAt, UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryDomain(m *gorm_model.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID: m.ID, EndpointID: m.EndpointID, Event: entity.WebhookEvent(m.Event), Payload: m.Payload,
+		Status: entity.WebhookDeliveryStatus(m.Status), Attempts: m.Attempts, NextAttemptAt: m.NextAttemptAt,
+		ResponseStatus: m.ResponseStatus, ResponseBody: m.ResponseBody, LastError: m.LastError,
+		DeliveredAt: m.DeliveredAt, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+)
+
+// cleanupInterval is how often idle keys are dropped from the memory store.
+const cleanupInterval = time.Minute
+
+type visitorBucket struct {
+	mu     sync.Mutex
+	times  []time.Time
+	window time.Duration
+}
+
+// MemoryStore keeps an exact sliding-window log per key in process memory.
+// Each replica counts on its own, so it only suits single-instance setups.
+type MemoryStore struct {
+	mu       sync.Mutex
+	visitors map[string]*visitorBucket
+}
+
+var _ port.RateLimitStore = (*MemoryStore)(nil)
+
+// NewMemoryStore starts a store whose idle keys are swept until ctx ends.
+func NewMemoryStore(ctx context.Context) *MemoryStore {
+	s := &MemoryStore{visitors: make(map[string]*visitorBucket)}
+	go s.cleanupLoop(ctx)
+	return s
+}
+
+func (s *MemoryStore) cleanupLoop(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep()
+		}
+	}
+}
+
+func (s *MemoryStore) sweep() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, v := range s.visitors {
+		v.mu.Lock()
+		if len(v.times) == 0 || now.Sub(v.times[len(v.times)-1]) > v.window {
+			delete(s.visitors, key)
+		}
+		v.mu.Unlock()
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit int, window time.Duration) (port.RateLimitResult, error) {
+	now := time.Now()
+	windowStart := now.Add(-window)
+
+	s.mu.Lock()
+	v, ok := s.visitors[key]
+	if !ok {
+		v = &visitorBucket{}
+		s.visitors[key] = v
+	}
+	s.mu.Unlock()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.window = window
+
+	// Drop timestamps outside the window.
+	kept := v.times[:0]
+	for _, t := range v.times {
+		if t.After(windowStart) {
+			kept = append(kept, t)
+		}
+	}
+	v.times = kept
+
+	if len(v.times) >= limit {
+		return port.RateLimitResult{Reset: window - now.Sub(v.times[0])}, nil
+	}
+
+	v.times = append(v.times, now)
+	return port.RateLimitResult{
+		Allowed:   true,
+		Remaining: limit - len(v.times),
+		Reset:     window - now.Sub(v.times[0]),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"gorm.io/gorm"
+)
+
+// PostgresStore shares counters between replicas through the
+// rate_limit_counters table. It approximates a sliding window from two fixed
+// windows: the previous window's count is weighted by how much of it still
+// overlaps the sliding window.
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+var _ port.RateLimitStore = (*PostgresStore)(nil)
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit int, window time.Duration) (port.RateLimitResult, error) {
+	now := time.Now().UTC()
+	start := now.Truncate(window)
+	elapsed := now.Sub(start)
+	reset := window - elapsed
+	db := s.db.WithContext(ctx)
+
+	var previous []int
+	if err := db.Raw(
+		"SELECT count FROM rate_limit_counters WHERE key = ? AND window_start = ?",
+		key, start.Add(-window),
+	).Scan(&previous).Error; err != nil {
+		return port.RateLimitResult{}, err
+	}
+	carried := 0
+	if len(previous) > 0 {
+		carried = int(float64(previous[0]) * (1 - float64(elapsed)/float64(window)))
+	}
+	budget := limit - carried
+	if budget <= 0 {
+		return port.RateLimitResult{Reset: reset}, nil
+	}
+
+	// The conditional update keeps concurrent replicas from overshooting the
+	// budget; no row comes back when the window is already full.
+	var counts []int
+	if err := db.Raw(`
+		INSERT INTO rate_limit_counters (key, window_start, count, expires_at)
+		VALUES (?, ?, 1, ?)
+		ON CONFLICT (key, window_start) DO UPDATE SET count = rate_limit_counters.count + 1
+		WHERE rate_limit_counters.count < ?
+		RETURNING count`,
+		key, start, start.Add(2*window), budget,
+	).Scan(&counts).Error; err != nil {
+		return port.RateLimitResult{}, err
+	}
+	if len(counts) == 0 {
+		return port.RateLimitResult{Reset: reset}, nil
+	}
+	return port.RateLimitResult{Allowed: true, Remaining: budget - counts[0], Reset: reset}, nil
+}
+
+// DeleteExpired drops windows that can no longer affect a decision.
+func (s *PostgresStore) DeleteExpired(ctx context.Context) error {
+	return s.db.WithContext(ctx).Exec("DELETE FROM rate_limit_counters WHERE expires_at < ?", time.Now().UTC()).Error
+}
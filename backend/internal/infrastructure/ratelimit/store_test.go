@@ -0,0 +1,109 @@
+package ratelimit_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/persistence/gorm_model"
+	"github.com/startup-job-board/backend/internal/infrastructure/ratelimit"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testStoreContract checks the behaviour every port.RateLimitStore promises.
+// The window is long so the Postgres store's fixed windows don't roll over
+// mid-test.
+func testStoreContract(t *testing.T, store port.RateLimitStore) {
+	ctx := context.Background()
+	const window = time.Hour
+
+	t.Run("limit", func(t *testing.T) {
+		key := "contract:" + uuid.NewString()
+		for i := 1; i <= 3; i++ {
+			res, err := store.Take(ctx, key, 3, window)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Allowed || res.Remaining != 3-i {
+				t.Fatalf("take %d: %+v", i, res)
+			}
+			if res.Reset <= 0 || res.Reset > window {
+				t.Fatalf("take %d: reset %v", i, res.Reset)
+			}
+		}
+		res, err := store.Take(ctx, key, 3, window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Allowed || res.Remaining != 0 || res.Reset <= 0 {
+			t.Fatalf("over limit: %+v", res)
+		}
+	})
+
+	t.Run("keys are independent", func(t *testing.T) {
+		a, b := "contract:"+uuid.NewString(), "contract:"+uuid.NewString()
+		if res, err := store.Take(ctx, a, 1, window); err != nil || !res.Allowed {
+			t.Fatalf("a: %+v %v", res, err)
+		}
+		if res, err := store.Take(ctx, b, 1, window); err != nil || !res.Allowed {
+			t.Fatalf("b: %+v %v", res, err)
+		}
+		if res, err := store.Take(ctx, a, 1, window); err != nil || res.Allowed {
+			t.Fatalf("a again: %+v %v", res, err)
+		}
+	})
+
+	t.Run("concurrent takes stay within the limit", func(t *testing.T) {
+		key := "contract:" + uuid.NewString()
+		var allowed atomic.Int32
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := store.Take(ctx, key, 5, window)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if res.Allowed {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		if n := allowed.Load(); n != 5 {
+			t.Fatalf("allowed %d of 20 with limit 5", n)
+		}
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	testStoreContract(t, ratelimit.NewMemoryStore(ctx))
+}
+
+// TestPostgresStore runs against the database in TEST_DATABASE_URL.
+func TestPostgresStore(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&gorm_model.RateLimitCounter{}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Exec("DELETE FROM rate_limit_counters WHERE key LIKE 'contract:%'") })
+	testStoreContract(t, ratelimit.NewPostgresStore(db))
+}
@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// Every runs fn on a fixed interval until ctx is cancelled. The first run
+// happens after one interval so restarts don't stampede background work.
+// Errors are logged; the loop keeps going.
+func Every(ctx context.Context, interval time.Duration, name string, log logger.Logger, fn func(ctx context.Context) error) {
+	if interval <= 0 {
+		log.Warn("Background task %s disabled: non-positive interval", name)
+		return
+	}
+	log = log.With("task", name)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				log.Error("Background task %s failed: %v", name, err)
+			}
+		}
+	}
+}
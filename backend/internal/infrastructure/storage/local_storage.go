@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/config"
+)
+
+var _ port.ObjectLister = (*LocalStorage)(nil)
+
+// LocalFilesPath is the backend route prefix LocalStorage serves objects under.
+const LocalFilesPath = "/api/v1/files/"
+
+// LocalStorage keeps objects on the local filesystem, for development and
+// offline CI. Objects are served by Handler; private keys need a signed link.
+type LocalStorage struct {
+	root    *os.Root
+	baseURL string
+	signer  *URLSigner
+	ttl     time.Duration
+}
+
+func NewLocalStorage(cfg config.LocalStorageConfig, signingSecret string, signedURLTTL time.Duration) (*LocalStorage, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+	// os.Root confines every access to the directory, including via symlinks.
+	root, err := os.OpenRoot(cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storage directory: %w", err)
+	}
+	return &LocalStorage{
+		root:    root,
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		signer:  NewURLSigner(signingSecret),
+		ttl:     signedURLTTL,
+	}, nil
+}
+
+func (s *LocalStorage) Upload(ctx context.Context, fileData []byte, key string, contentType string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	if dir := path.Dir(key); dir != "." {
+		if err := s.root.MkdirAll(dir, 0o750); err != nil {
+			return "", fmt.Errorf("failed to upload file: %w", err)
+		}
+	}
+	// Write then rename so readers never see a partial file.
+	tmp := key + ".tmp"
+	if err := s.root.WriteFile(tmp, fileData, 0o640); err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+	if err := s.root.Rename(tmp, key); err != nil {
+		s.root.Remove(tmp)
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+	return s.GetURL(ctx, key)
+}
+
+// Ping checks the storage directory is still there.
+func (s *LocalStorage) Ping(ctx context.Context) error {
+	_, err := s.root.Stat(".")
+	return err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	if err := s.root.Remove(key); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) GetURL(ctx context.Context, key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	u := s.baseURL + LocalFilesPath + key
+	if port.IsPrivateKey(key) {
+		u += "?" + s.signer.Query(key, s.ttl).Encode()
+	}
+	return u, nil
+}
+
+// Handler serves objects under LocalFilesPath. Public keys are readable by
+// anyone; private keys only with a valid signature.
+func (s *LocalStorage) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		key, err := cleanKey(strings.TrimPrefix(r.URL.Path, LocalFilesPath))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		private := port.IsPrivateKey(key)
+		if private && !s.signer.Verify(key, r.URL.Query()) {
+			// Same answer as a missing object: don't confirm the key exists.
+			http.NotFound(w, r)
+			return
+		}
+
+		f, err := s.root.Open(key)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil || info.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(key))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		h := w.Header()
+		h.Set("Content-Type", contentType)
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("Content-Security-Policy", "default-src 'none'; sandbox")
+		if !strings.HasPrefix(contentType, "image/") {
+			h.Set("Content-Disposition", "attachment")
+		}
+		if private {
+			h.Set("Cache-Control", "private, no-store")
+		} else {
+			h.Set("Cache-Control", "public, max-age=86400")
+		}
+		http.ServeContent(w, r, "", info.ModTime(), f)
+	})
+}
+
+func (s *LocalStorage) ListObjects(ctx context.Context, fn func(obj port.StoredObject) error) error {
+	return fs.WalkDir(s.root.FS(), ".", func(key string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// Skip directories and half-written uploads.
+		if d.IsDir() || strings.HasSuffix(key, ".tmp") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(port.StoredObject{Key: key, Size: info.Size(), LastModified: info.ModTime()})
+	})
+}
+
+// cleanKey rejects keys that are absolute or climb out of the storage root.
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid storage key")
+	}
+	cleaned := path.Clean(key)
+	if cleaned != key || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid storage key")
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,126 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/config"
+	"github.com/startup-job-board/backend/internal/infrastructure/storage"
+)
+
+func newLocal(t *testing.T, ttl time.Duration) *storage.LocalStorage {
+	t.Helper()
+	s, err := storage.NewLocalStorage(config.LocalStorageConfig{Dir: t.TempDir(), BaseURL: "http://api.test/"}, "secret", ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func get(h http.Handler, rawURL string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, rawURL, nil))
+	return rec
+}
+
+func TestLocalStorageServesPublicObjects(t *testing.T) {
+	s := newLocal(t, time.Minute)
+	u, err := s.Upload(context.Background(), []byte("png-bytes"), "uploads/a/logo.png", "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != "http://api.test/api/v1/files/uploads/a/logo.png" {
+		t.Fatalf("url = %s", u)
+	}
+	rec := get(s.Handler(), u)
+	if rec.Code != http.StatusOK || rec.Body.String() != "png-bytes" || rec.Header().Get("Content-Type") != "image/png" {
+		t.Fatalf("got %d %q %s", rec.Code, rec.Body.String(), rec.Header().Get("Content-Type"))
+	}
+
+	if err := s.Delete(context.Background(), "uploads/a/logo.png"); err != nil {
+		t.Fatal(err)
+	}
+	if rec := get(s.Handler(), u); rec.Code != http.StatusNotFound {
+		t.Fatalf("deleted object: got %d", rec.Code)
+	}
+}
+
+func TestLocalStoragePrivateObjectsNeedValidSignature(t *testing.T) {
+	s := newLocal(t, time.Minute)
+	signed, err := s.Upload(context.Background(), []byte("%PDF"), "private/resumes/cv.pdf", "application/pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := get(s.Handler(), signed)
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Disposition") != "attachment" {
+		t.Fatalf("signed: got %d, disposition %q", rec.Code, rec.Header().Get("Content-Disposition"))
+	}
+
+	unsigned := strings.SplitN(signed, "?", 2)[0]
+	if rec := get(s.Handler(), unsigned); rec.Code != http.StatusNotFound {
+		t.Fatalf("unsigned: got %d", rec.Code)
+	}
+
+	// A signature for one key must not open another.
+	parsed, _ := url.Parse(signed)
+	other := "http://api.test/api/v1/files/private/resumes/other.pdf?" + parsed.RawQuery
+	if rec := get(s.Handler(), other); rec.Code != http.StatusNotFound {
+		t.Fatalf("reused signature: got %d", rec.Code)
+	}
+
+	q := parsed.Query()
+	q.Set("expires", "9999999999")
+	if rec := get(s.Handler(), unsigned+"?"+q.Encode()); rec.Code != http.StatusNotFound {
+		t.Fatalf("extended expiry: got %d", rec.Code)
+	}
+
+	expired := newLocal(t, -time.Minute)
+	link, _ := expired.Upload(context.Background(), []byte("%PDF"), "private/cv.pdf", "application/pdf")
+	if rec := get(expired.Handler(), link); rec.Code != http.StatusNotFound {
+		t.Fatalf("expired: got %d", rec.Code)
+	}
+}
+
+func TestLocalStorageRejectsTraversal(t *testing.T) {
+	s := newLocal(t, time.Minute)
+	for _, key := range []string{"../escape.txt", "/etc/passwd", "uploads/../../x", "uploads\\..\\x", ""} {
+		if _, err := s.Upload(context.Background(), []byte("x"), key, "text/plain"); err == nil {
+			t.Errorf("upload %q: expected error", key)
+		}
+	}
+	if rec := get(s.Handler(), "http://api.test/api/v1/files/uploads/%2e%2e/%2e%2e/etc/passwd"); rec.Code != http.StatusNotFound {
+		t.Fatalf("traversal request: got %d", rec.Code)
+	}
+}
+
+func TestLocalStorageListsObjects(t *testing.T) {
+	s := newLocal(t, time.Minute)
+	ctx := context.Background()
+	for _, key := range []string{"uploads/a.png", "private/uploads/b.pdf"} {
+		if _, err := s.Upload(ctx, []byte("data"), key, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var keys []string
+	err := s.ListObjects(ctx, func(obj port.StoredObject) error {
+		if obj.Size != 4 || obj.LastModified.IsZero() {
+			t.Errorf("%s: size %d, modified %v", obj.Key, obj.Size, obj.LastModified)
+		}
+		keys = append(keys, obj.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(keys)
+	if strings.Join(keys, ",") != "private/uploads/b.pdf,uploads/a.png" {
+		t.Fatalf("keys = %v", keys)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"bytes"
 	"fmt"
+	"io"
+	"net/url"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -11,12 +14,18 @@ import (
 	"github.com/startup-job-board/backend/internal/infrastructure/config"
 )
 
+var (
+	_ port.DirectUploadStorage = (*MinIOStorage)(nil)
+	_ port.ObjectLister        = (*MinIOStorage)(nil)
+)
+
 type MinIOStorage struct {
 	client     *minio.Client
 	bucketName string
+	signedTTL  time.Duration
 }
 
-func NewMinIOStorage(cfg config.MinIOConfig) (port.StorageService, error) {
+func NewMinIOStorage(cfg config.MinIOConfig, signedTTL time.Duration) (port.StorageService, error) {
 	client, err := minio.New(cfg.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
 		Secure: cfg.UseSSL,
@@ -28,6 +37,7 @@ func NewMinIOStorage(cfg config.MinIOConfig) (port.StorageService, error) {
 	storage := &MinIOStorage{
 		client:     client,
 		bucketName: cfg.Bucket,
+		signedTTL:  signedTTL,
 	}
 
 	// Ensure bucket exists
@@ -57,9 +67,19 @@ func (s *MinIOStorage) Upload(ctx context.Context, fileData []byte, key string,
 		return "", fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	// Return URL (in production, this would be a proper URL)
-	url := fmt.Sprintf("http://%s/%s/%s", s.client.EndpointURL().Host, s.bucketName, key)
-	return url, nil
+	return s.GetURL(ctx, key)
+}
+
+// Ping checks the bucket exists and the credentials can reach it.
+func (s *MinIOStorage) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %q does not exist", s.bucketName)
+	}
+	return nil
 }
 
 func (s *MinIOStorage) Delete(ctx context.Context, key string) error {
@@ -67,9 +87,78 @@ func (s *MinIOStorage) Delete(ctx context.Context, key string) error {
 }
 
 func (s *MinIOStorage) GetURL(ctx context.Context, key string) (string, error) {
+	if port.IsPrivateKey(key) {
+		u, err := s.client.PresignedGetObject(ctx, s.bucketName, key, s.signedTTL, url.Values{})
+		if err != nil {
+			return "", fmt.Errorf("failed to presign URL: %w", err)
+		}
+		return u.String(), nil
+	}
 	url := fmt.Sprintf("http://%s/%s/%s", s.client.EndpointURL().Host, s.bucketName, key)
 	return url, nil
 }
 
 
 
+
+func (s *MinIOStorage) PresignUpload(ctx context.Context, key, contentType string, maxSize int64, ttl time.Duration) (*port.PresignedUpload, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(s.bucketName); err != nil {
+		return nil, err
+	}
+	if err := policy.SetKey(key); err != nil {
+		return nil, err
+	}
+	if err := policy.SetContentType(contentType); err != nil {
+		return nil, err
+	}
+	if err := policy.SetContentLengthRange(1, maxSize); err != nil {
+		return nil, err
+	}
+	if err := policy.SetExpires(time.Now().UTC().Add(ttl)); err != nil {
+		return nil, err
+	}
+	u, fields, err := s.client.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign upload: %w", err)
+	}
+	return &port.PresignedUpload{URL: u.String(), Fields: fields}, nil
+}
+
+func (s *MinIOStorage) Stat(ctx context.Context, key string) (*port.ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &port.ObjectInfo{Size: info.Size, ContentType: info.ContentType}, nil
+}
+
+func (s *MinIOStorage) Read(ctx context.Context, key string, limit int64) ([]byte, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, limit-1); err != nil {
+		return nil, err
+	}
+	obj, err := s.client.GetObject(ctx, s.bucketName, key, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	return io.ReadAll(io.LimitReader(obj, limit))
+}
+
+func (s *MinIOStorage) ListObjects(ctx context.Context, fn func(obj port.StoredObject) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops the listing goroutine if fn returns early
+	for info := range s.client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{Recursive: true}) {
+		if info.Err != nil {
+			return info.Err
+		}
+		if err := fn(port.StoredObject{Key: info.Key, Size: info.Size, LastModified: info.LastModified}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
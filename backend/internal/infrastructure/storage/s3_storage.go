@@ -3,23 +3,34 @@ package storage
 import (
 	"context"
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/infrastructure/config"
 )
 
+var (
+	_ port.DirectUploadStorage = (*S3Storage)(nil)
+	_ port.ObjectLister        = (*S3Storage)(nil)
+)
+
 type S3Storage struct {
 	client     *s3.Client
+	presigner  *s3.PresignClient
 	bucketName string
 	region     string
+	signedTTL  time.Duration
 }
 
-func NewS3Storage(cfg config.S3Config) (port.StorageService, error) {
+func NewS3Storage(cfg config.S3Config, signedTTL time.Duration) (port.StorageService, error) {
 	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background(),
 		awsconfig.WithRegion(cfg.Region),
 		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cfg.AccessKey, cfg.SecretKey, "")),
@@ -32,8 +43,10 @@ func NewS3Storage(cfg config.S3Config) (port.StorageService, error) {
 
 	return &S3Storage{
 		client:     client,
+		presigner:  s3.NewPresignClient(client),
 		bucketName: cfg.Bucket,
 		region:     cfg.Region,
+		signedTTL:  signedTTL,
 	}, nil
 }
 
@@ -48,8 +61,13 @@ func (s *S3Storage) Upload(ctx context.Context, fileData []byte, key string, con
 		return "", fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, s.region, key)
-	return url, nil
+	return s.GetURL(ctx, key)
+}
+
+// Ping checks the bucket exists and the credentials can reach it.
+func (s *S3Storage) Ping(ctx context.Context) error {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.bucketName)})
+	return err
 }
 
 func (s *S3Storage) Delete(ctx context.Context, key string) error {
@@ -61,9 +79,86 @@ func (s *S3Storage) Delete(ctx context.Context, key string) error {
 }
 
 func (s *S3Storage) GetURL(ctx context.Context, key string) (string, error) {
+	if port.IsPrivateKey(key) {
+		req, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+		}, s3.WithPresignExpires(s.signedTTL))
+		if err != nil {
+			return "", fmt.Errorf("failed to presign URL: %w", err)
+		}
+		return req.URL, nil
+	}
 	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, s.region, key)
 	return url, nil
 }
 
 
 
+
+func (s *S3Storage) PresignUpload(ctx context.Context, key, contentType string, maxSize int64, ttl time.Duration) (*port.PresignedUpload, error) {
+	req, err := s.presigner.PresignPostObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	}, func(o *s3.PresignPostOptions) {
+		o.Expires = ttl
+		o.Conditions = []any{
+			[]any{"eq", "$Content-Type", contentType},
+			[]any{"content-length-range", 1, maxSize},
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign upload: %w", err)
+	}
+	fields := make(map[string]string, len(req.Values)+1)
+	for k, v := range req.Values {
+		fields[k] = v
+	}
+	fields["Content-Type"] = contentType
+	return &port.PresignedUpload{URL: req.URL, Fields: fields}, nil
+}
+
+func (s *S3Storage) Stat(ctx context.Context, key string) (*port.ObjectInfo, error) {
+	out, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &port.ObjectInfo{Size: aws.ToInt64(out.ContentLength), ContentType: aws.ToString(out.ContentType)}, nil
+}
+
+func (s *S3Storage) Read(ctx context.Context, key string, limit int64) ([]byte, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", limit-1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(io.LimitReader(out.Body, limit))
+}
+
+func (s *S3Storage) ListObjects(ctx context.Context, fn func(obj port.StoredObject) error) error {
+	pages := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{Bucket: aws.String(s.bucketName)})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, o := range page.Contents {
+			obj := port.StoredObject{Key: aws.ToString(o.Key), Size: aws.ToInt64(o.Size), LastModified: aws.ToTime(o.LastModified)}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -7,12 +7,15 @@ import (
 )
 
 func NewStorageService(cfg *config.Config) (port.StorageService, error) {
+	if cfg.Storage.Type == "local" {
+		return NewLocalStorage(cfg.Storage.Local, cfg.Storage.SigningSecret, cfg.Storage.SignedURLTTL)
+	}
 	if cfg.Storage.Type == "s3" {
 		// Validate S3 config
 		if cfg.Storage.S3.Bucket == "" || cfg.Storage.S3.AccessKey == "" || cfg.Storage.S3.SecretKey == "" {
 			return nil, fmt.Errorf("S3 storage requires bucket, access key, and secret key")
 		}
-		return NewS3Storage(cfg.Storage.S3)
+		return NewS3Storage(cfg.Storage.S3, cfg.Storage.SignedURLTTL)
 	}
 	
 	// For MinIO, check if endpoint is accessible
@@ -20,7 +23,7 @@ func NewStorageService(cfg *config.Config) (port.StorageService, error) {
 		return nil, fmt.Errorf("MinIO endpoint is not configured")
 	}
 	
-	return NewMinIOStorage(cfg.Storage.MinIO)
+	return NewMinIOStorage(cfg.Storage.MinIO, cfg.Storage.SignedURLTTL)
 }
 
 
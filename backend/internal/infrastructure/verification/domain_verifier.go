@@ -0,0 +1,76 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+const maxWellKnownBytes = 4 << 10
+
+var ErrTokenNotFound = errors.New("verification token not found")
+
+// TXTResolver is satisfied by *net.Resolver; tests pass a fake.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+type DomainVerifier struct {
+	resolver TXTResolver
+	client   *http.Client
+}
+
+// NewDomainVerifier wires the resolver and HTTP client used for checks. In
+// production client should come from ssrf.NewPublicHTTPClient so the
+// well-known fetch can't be pointed at internal addresses.
+func NewDomainVerifier(resolver TXTResolver, client *http.Client) port.DomainVerifier {
+	return &DomainVerifier{resolver: resolver, client: client}
+}
+
+func (v *DomainVerifier) CheckDNS(ctx context.Context, domain, token string) error {
+	records, err := v.resolver.LookupTXT(ctx, domain)
+	if err != nil {
+		return fmt.Errorf("dns lookup: %w", err)
+	}
+	want := entity.VerificationTXTPrefix + token
+	for _, r := range records {
+		if strings.TrimSpace(r) == want {
+			return nil
+		}
+	}
+	return ErrTokenNotFound
+}
+
+func (v *DomainVerifier) CheckWellKnownFile(ctx context.Context, domain, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+domain+entity.VerificationWellKnownPath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "JoinUs-DomainVerifier/1.0")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch well-known file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch well-known file: status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxWellKnownBytes))
+	if err != nil {
+		return fmt.Errorf("read well-known file: %w", err)
+	}
+	for _, line := range strings.Split(string(body), "\n") {
+		if strings.TrimSpace(line) == token {
+			return nil
+		}
+	}
+	return ErrTokenNotFound
+}
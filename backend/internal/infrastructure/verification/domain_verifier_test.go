@@ -0,0 +1,71 @@
+package verification_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/infrastructure/verification"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, ok := f[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+type fakeTransport map[string]string
+
+func (f fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, ok := f[req.URL.String()]
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusNotFound
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestCheckDNS(t *testing.T) {
+	v := verification.NewDomainVerifier(fakeResolver{
+		"acme.io": {"v=spf1 -all", "joinus-verification=tok123"},
+	}, &http.Client{})
+
+	if err := v.CheckDNS(context.Background(), "acme.io", "tok123"); err != nil {
+		t.Fatalf("expected match: %v", err)
+	}
+	if err := v.CheckDNS(context.Background(), "acme.io", "other"); err == nil {
+		t.Fatal("expected wrong token to fail")
+	}
+	if err := v.CheckDNS(context.Background(), "missing.io", "tok123"); err == nil {
+		t.Fatal("expected lookup failure")
+	}
+}
+
+func TestCheckWellKnownFile(t *testing.T) {
+	client := &http.Client{Transport: fakeTransport{
+		"https://acme.io/.well-known/joinus-verification.txt": "tok123\n",
+	}}
+	v := verification.NewDomainVerifier(fakeResolver{}, client)
+
+	if err := v.CheckWellKnownFile(context.Background(), "acme.io", "tok123"); err != nil {
+		t.Fatalf("expected match: %v", err)
+	}
+	if err := v.CheckWellKnownFile(context.Background(), "acme.io", "tok"); err == nil {
+		t.Fatal("expected partial token to fail")
+	}
+	if err := v.CheckWellKnownFile(context.Background(), "nofile.io", "tok123"); err == nil {
+		t.Fatal("expected 404 to fail")
+	}
+}
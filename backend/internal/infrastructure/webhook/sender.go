@@ -0,0 +1,73 @@
+// Package webhook delivers signed event payloads to team endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+)
+
+// Request headers sent with every delivery.
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+)
+
+// maxResponseBody caps how much of the receiver's response is kept in the delivery log.
+const maxResponseBody = 1024
+
+// Sign returns the signature header value for body sent at timestamp:
+// "t=<unix>,v1=<hex HMAC-SHA256(secret, "<unix>.<body>")>". Receivers should
+// recompute v1 and reject stale timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	ts := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender wraps client (expected to be SSRF-guarded). Redirects are not
+// followed: a 3xx counts as a failed attempt.
+func NewHTTPSender(client *http.Client) port.WebhookSender {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	return &HTTPSender{client: &c}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, url, secret string, delivery *entity.WebhookDelivery) (*port.WebhookResponse, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "JoinUs-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(secret, time.Now().Unix(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	return &port.WebhookResponse{StatusCode: resp.StatusCode, Body: string(respBody)}, nil
+}
@@ -0,0 +1,60 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/infrastructure/webhook"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestSignMatchesHMACSHA256(t *testing.T) {
+	// printf '1700000000.{"a":1}' | openssl dgst -sha256 -hmac whsec_test
+	want := "t=1700000000,v1=38877139021993b830af32feea6e18a8da83eb2f6e49ee50bd9e4cf4ca4d3789"
+	if got := webhook.Sign("whsec_test", 1700000000, []byte(`{"a":1}`)); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if webhook.Sign("whsec_test", 1700000001, []byte(`{"a":1}`)) == want {
+		t.Fatal("signature must depend on the timestamp")
+	}
+}
+
+func TestSendSignsAndDoesNotFollowRedirects(t *testing.T) {
+	var seen *http.Request
+	client := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		seen = r
+		return &http.Response{
+			StatusCode: http.StatusFound,
+			Header:     http.Header{"Location": []string{"http://169.254.169.254/"}},
+			Body:       io.NopCloser(strings.NewReader("moved")),
+			Request:    r,
+		}, nil
+	})}
+	sender := webhook.NewHTTPSender(client)
+
+	resp, err := sender.Send(context.Background(), "https://hooks.example.com/in", "whsec_test", &entity.WebhookDelivery{
+		ID: "d1", Event: entity.WebhookEventJobCreated, Payload: `{"type":"job.created"}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("redirect should be returned as-is, got %d", resp.StatusCode)
+	}
+	if seen.URL.Host != "hooks.example.com" {
+		t.Fatalf("request went to %s", seen.URL.Host)
+	}
+	if !strings.HasPrefix(seen.Header.Get(webhook.HeaderSignature), "t=") {
+		t.Fatal("missing signature header")
+	}
+	if seen.Header.Get(webhook.HeaderEvent) != "job.created" || seen.Header.Get(webhook.HeaderDelivery) != "d1" {
+		t.Fatal("missing event/delivery headers")
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	teamusecase "github.com/startup-job-board/backend/internal/application/usecase/team"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+type APITokenHandler struct {
+	listUC    *teamusecase.ListAPITokensUseCase
+	createUC  *teamusecase.CreateAPITokenUseCase
+	rotateUC  *teamusecase.RotateAPITokenUseCase
+	revokeUC  *teamusecase.RevokeAPITokenUseCase
+	validator *validator.Validator
+}
+
+func NewAPITokenHandler(
+	listUC *teamusecase.ListAPITokensUseCase,
+	createUC *teamusecase.CreateAPITokenUseCase,
+	rotateUC *teamusecase.RotateAPITokenUseCase,
+	revokeUC *teamusecase.RevokeAPITokenUseCase,
+	validator *validator.Validator,
+) *APITokenHandler {
+	return &APITokenHandler{listUC: listUC, createUC: createUC, rotateUC: rotateUC, revokeUC: revokeUC, validator: validator}
+}
+
+func (h *APITokenHandler) List(c *gin.Context) {
+	tokens, err := h.listUC.Execute(c.Request.Context(), c.Param("id"), c.Param("startupId"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, tokens)
+}
+
+func (h *APITokenHandler) Create(c *gin.Context) {
+	var input dto.CreateAPITokenInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	token, err := h.createUC.Execute(c.Request.Context(), c.Param("id"), c.Param("startupId"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, token)
+}
+
+func (h *APITokenHandler) Rotate(c *gin.Context) {
+	token, err := h.rotateUC.Execute(c.Request.Context(), c.Param("id"), c.Param("startupId"), c.Param("tokenId"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, token)
+}
+
+func (h *APITokenHandler) Revoke(c *gin.Context) {
+	if err := h.revokeUC.Execute(c.Request.Context(), c.Param("id"), c.Param("startupId"), c.Param("tokenId"), middleware.GetUserID(c)); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"revoked": true})
+}
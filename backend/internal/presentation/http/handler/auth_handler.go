@@ -24,6 +24,11 @@ type AuthHandler struct {
 	completeOAuthUseCase     *authusecase.CompleteOAuthUseCase
 	issueLoginCodeUseCase    *authusecase.IssueOAuthLoginCodeUseCase
 	exchangeLoginCodeUseCase *authusecase.ExchangeOAuthLoginCodeUseCase
+	verifyEmailUseCase       *authusecase.VerifyEmailUseCase
+	resendVerificationUC     *authusecase.ResendVerificationEmailUseCase
+	forgotPasswordUseCase    *authusecase.ForgotPasswordUseCase
+	resetPasswordUseCase     *authusecase.ResetPasswordUseCase
+	changePasswordUseCase    *authusecase.ChangePasswordUseCase
 	frontendURL              string
 	secureCookies            bool
 	validator                *validator.Validator
@@ -39,6 +44,11 @@ func NewAuthHandler(
 	completeOAuthUseCase *authusecase.CompleteOAuthUseCase,
 	issueLoginCodeUseCase *authusecase.IssueOAuthLoginCodeUseCase,
 	exchangeLoginCodeUseCase *authusecase.ExchangeOAuthLoginCodeUseCase,
+	verifyEmailUseCase *authusecase.VerifyEmailUseCase,
+	resendVerificationUC *authusecase.ResendVerificationEmailUseCase,
+	forgotPasswordUseCase *authusecase.ForgotPasswordUseCase,
+	resetPasswordUseCase *authusecase.ResetPasswordUseCase,
+	changePasswordUseCase *authusecase.ChangePasswordUseCase,
 	frontendURL string,
 	secureCookies bool,
 	validator *validator.Validator,
@@ -48,6 +58,8 @@ func NewAuthHandler(
 		refreshTokenUseCase: refreshTokenUseCase, logoutUseCase: logoutUseCase, getMeUseCase: getMeUseCase,
 		startOAuthUseCase: startOAuthUseCase, completeOAuthUseCase: completeOAuthUseCase,
 		issueLoginCodeUseCase: issueLoginCodeUseCase, exchangeLoginCodeUseCase: exchangeLoginCodeUseCase,
+		verifyEmailUseCase: verifyEmailUseCase, resendVerificationUC: resendVerificationUC,
+		forgotPasswordUseCase: forgotPasswordUseCase, resetPasswordUseCase: resetPasswordUseCase, changePasswordUseCase: changePasswordUseCase,
 		frontendURL: frontendURL, secureCookies: secureCookies, validator: validator,
 	}
 }
@@ -62,6 +74,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		response.BadRequest(c, err.Error())
 		return
 	}
+	input.Locale = requestLocale(c)
 	result, err := h.registerUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
 		response.Error(c, http.StatusBadRequest, err)
@@ -157,7 +170,7 @@ func (h *AuthHandler) OAuthCallback(c *gin.Context) {
 	}
 	h.clearOAuthStateCookie(c)
 
-	result, err := h.completeOAuthUseCase.Execute(c.Request.Context(), provider, code)
+	result, err := h.completeOAuthUseCase.Execute(c.Request.Context(), provider, code, requestLocale(c))
 	if err != nil {
 		mapUCError(c, err)
 		return
@@ -192,6 +205,82 @@ func (h *AuthHandler) ExchangeOAuthCode(c *gin.Context) {
 	response.Success(c, result)
 }
 
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var input dto.VerifyEmailInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.verifyEmailUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *AuthHandler) ResendVerificationEmail(c *gin.Context) {
+	if err := h.resendVerificationUC.Execute(c.Request.Context(), middleware.GetUserID(c)); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "verification email sent"})
+}
+
+// ForgotPassword answers 202 whether or not the address has an account.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var input dto.ForgotPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	h.forgotPasswordUseCase.Execute(c.Request.Context(), input)
+	response.Accepted(c, gin.H{"message": "if the address has an account, a reset link is on its way"})
+}
+
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var input dto.ResetPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.resetPasswordUseCase.Execute(c.Request.Context(), input); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "password has been reset"})
+}
+
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var input dto.ChangePasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.changePasswordUseCase.Execute(c.Request.Context(), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
 func (h *AuthHandler) setOAuthStateCookie(c *gin.Context, state string, maxAge int) {
 	c.SetSameSite(http.SameSiteLaxMode)
 	c.SetCookie("oauth_state", state, maxAge, "/", "", h.secureCookies, true)
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	backgroundjobusecase "github.com/startup-job-board/backend/internal/application/usecase/backgroundjob"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+)
+
+// BackgroundJobHandler is the platform admin view of the job queue.
+type BackgroundJobHandler struct {
+	listUC   *backgroundjobusecase.ListJobsUseCase
+	retryUC  *backgroundjobusecase.RetryJobUseCase
+	cancelUC *backgroundjobusecase.CancelJobUseCase
+}
+
+func NewBackgroundJobHandler(
+	listUC *backgroundjobusecase.ListJobsUseCase,
+	retryUC *backgroundjobusecase.RetryJobUseCase,
+	cancelUC *backgroundjobusecase.CancelJobUseCase,
+) *BackgroundJobHandler {
+	return &BackgroundJobHandler{listUC: listUC, retryUC: retryUC, cancelUC: cancelUC}
+}
+
+func (h *BackgroundJobHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	filter := dto.BackgroundJobFilter{Queue: c.Query("queue"), Kind: c.Query("kind"), Status: c.Query("status")}
+	jobs, total, err := h.listUC.Execute(c.Request.Context(), middleware.GetUserID(c), filter, page, pageSize)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"jobs": jobs, "total": total, "page": page, "page_size": pageSize})
+}
+
+func (h *BackgroundJobHandler) Retry(c *gin.Context) {
+	result, err := h.retryUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id"))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *BackgroundJobHandler) Cancel(c *gin.Context) {
+	result, err := h.cancelUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id"))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	teamusecase "github.com/startup-job-board/backend/internal/application/usecase/team"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+type ClaimHandler struct {
+	submitUC   *teamusecase.SubmitClaimUseCase
+	verifyUC   *teamusecase.VerifyClaimUseCase
+	getUC      *teamusecase.GetClaimUseCase
+	listMineUC *teamusecase.ListMyClaimsUseCase
+	listUC     *teamusecase.ListClaimsUseCase
+	approveUC  *teamusecase.ApproveClaimUseCase
+	rejectUC   *teamusecase.RejectClaimUseCase
+	validator  *validator.Validator
+}
+
+func NewClaimHandler(
+	submitUC *teamusecase.SubmitClaimUseCase,
+	verifyUC *teamusecase.VerifyClaimUseCase,
+	getUC *teamusecase.GetClaimUseCase,
+	listMineUC *teamusecase.ListMyClaimsUseCase,
+	listUC *teamusecase.ListClaimsUseCase,
+	approveUC *teamusecase.ApproveClaimUseCase,
+	rejectUC *teamusecase.RejectClaimUseCase,
+	validator *validator.Validator,
+) *ClaimHandler {
+	return &ClaimHandler{
+		submitUC: submitUC, verifyUC: verifyUC, getUC: getUC, listMineUC: listMineUC,
+		listUC: listUC, approveUC: approveUC, rejectUC: rejectUC, validator: validator,
+	}
+}
+
+func (h *ClaimHandler) Submit(c *gin.Context) {
+	var input dto.CreateStartupClaimInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.submitUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *ClaimHandler) Verify(c *gin.Context) {
+	var input dto.VerifyStartupClaimInput
+	// The body is optional: website claims are verified without a code.
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			response.BadRequest(c, err.Error())
+			return
+		}
+	}
+	result, err := h.verifyUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *ClaimHandler) Get(c *gin.Context) {
+	result, err := h.getUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *ClaimHandler) ListMine(c *gin.Context) {
+	claims, err := h.listMineUC.Execute(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"claims": claims})
+}
+
+func (h *ClaimHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	claims, total, err := h.listUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Query("status"), page, pageSize)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"claims": claims, "total": total, "page": page, "page_size": pageSize})
+}
+
+func (h *ClaimHandler) Approve(c *gin.Context) {
+	result, err := h.approveUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id"))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *ClaimHandler) Reject(c *gin.Context) {
+	var input dto.RejectStartupClaimInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.rejectUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
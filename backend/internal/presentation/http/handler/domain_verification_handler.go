@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	startupusecase "github.com/startup-job-board/backend/internal/application/usecase/startup"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+type DomainVerificationHandler struct {
+	startUseCase *startupusecase.StartDomainVerificationUseCase
+	getUseCase   *startupusecase.GetDomainVerificationUseCase
+	checkUseCase *startupusecase.CheckDomainVerificationUseCase
+	validator    *validator.Validator
+}
+
+func NewDomainVerificationHandler(
+	startUseCase *startupusecase.StartDomainVerificationUseCase,
+	getUseCase *startupusecase.GetDomainVerificationUseCase,
+	checkUseCase *startupusecase.CheckDomainVerificationUseCase,
+	validator *validator.Validator,
+) *DomainVerificationHandler {
+	return &DomainVerificationHandler{
+		startUseCase: startUseCase,
+		getUseCase:   getUseCase,
+		checkUseCase: checkUseCase,
+		validator:    validator,
+	}
+}
+
+func (h *DomainVerificationHandler) Get(c *gin.Context) {
+	result, err := h.getUseCase.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *DomainVerificationHandler) Start(c *gin.Context) {
+	var input dto.StartDomainVerificationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.startUseCase.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *DomainVerificationHandler) Check(c *gin.Context) {
+	var input dto.CheckDomainVerificationInput
+	// Body is optional for DNS / file checks.
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			response.BadRequest(c, err.Error())
+			return
+		}
+	}
+
+	result, err := h.checkUseCase.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	adminusecase "github.com/startup-job-board/backend/internal/application/usecase/admin"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+// EmailTemplateHandler lets platform admins see the email templates and
+// preview them rendered.
+type EmailTemplateHandler struct {
+	listUC    *adminusecase.ListEmailTemplatesUseCase
+	previewUC *adminusecase.PreviewEmailUseCase
+	validator *validator.Validator
+}
+
+func NewEmailTemplateHandler(
+	listUC *adminusecase.ListEmailTemplatesUseCase,
+	previewUC *adminusecase.PreviewEmailUseCase,
+	validator *validator.Validator,
+) *EmailTemplateHandler {
+	return &EmailTemplateHandler{listUC: listUC, previewUC: previewUC, validator: validator}
+}
+
+func (h *EmailTemplateHandler) List(c *gin.Context) {
+	templates, err := h.listUC.Execute(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"templates": templates})
+}
+
+func (h *EmailTemplateHandler) Preview(c *gin.Context) {
+	var input dto.PreviewEmailInput
+	// An empty body previews the sample data in the default locale.
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			response.BadRequest(c, err.Error())
+			return
+		}
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.previewUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("name"), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	fileusecase "github.com/startup-job-board/backend/internal/application/usecase/file"
@@ -11,11 +13,22 @@ import (
 
 type FileHandler struct {
 	uploadUseCase *fileusecase.UploadFileUseCase
+	listUC        *fileusecase.ListFilesUseCase
+	deleteUC      *fileusecase.DeleteFileUseCase
+	strayUC       *fileusecase.ReportStrayObjectsUseCase
 }
 
-func NewFileHandler(uploadUseCase *fileusecase.UploadFileUseCase) *FileHandler {
+func NewFileHandler(
+	uploadUseCase *fileusecase.UploadFileUseCase,
+	listUC *fileusecase.ListFilesUseCase,
+	deleteUC *fileusecase.DeleteFileUseCase,
+	strayUC *fileusecase.ReportStrayObjectsUseCase,
+) *FileHandler {
 	return &FileHandler{
 		uploadUseCase: uploadUseCase,
+		listUC:        listUC,
+		deleteUC:      deleteUC,
+		strayUC:       strayUC,
 	}
 }
 
@@ -36,7 +49,9 @@ func (h *FileHandler) Upload(c *gin.Context) {
 
 	// Read file into byte slice
 	fileData := make([]byte, file.Size)
-	if _, err := src.Read(fileData); err != nil {
+	// ReadFull: a single Read may return fewer bytes, and a truncated image
+	// would now fail decoding.
+	if _, err := io.ReadFull(src, fileData); err != nil {
 		response.BadRequest(c, "failed to read file")
 		return
 	}
@@ -51,5 +66,33 @@ func (h *FileHandler) Upload(c *gin.Context) {
 	response.Success(c, result)
 }
 
+// List returns the caller's files, or with ?team_id= those of the team's
+// members (team admins only).
+func (h *FileHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	files, total, err := h.listUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Query("team_id"), page, pageSize)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"files": files, "total": total, "page": page, "page_size": pageSize})
+}
 
+func (h *FileHandler) Delete(c *gin.Context) {
+	if err := h.deleteUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c)); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"deleted": true})
+}
 
+// StrayObjects reports stored objects with no file record (platform admins).
+func (h *FileHandler) StrayObjects(c *gin.Context) {
+	result, err := h.strayUC.Execute(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
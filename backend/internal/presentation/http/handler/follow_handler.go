@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	followusecase "github.com/startup-job-board/backend/internal/application/usecase/follow"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+type FollowHandler struct {
+	followUC        *followusecase.FollowStartupUseCase
+	unfollowUC      *followusecase.UnfollowStartupUseCase
+	listFollowsUC   *followusecase.ListFollowsUseCase
+	followerCountUC *followusecase.FollowerCountUseCase
+	unsubscribeUC   *followusecase.UnsubscribeFollowsUseCase
+	validator       *validator.Validator
+}
+
+func NewFollowHandler(
+	followUC *followusecase.FollowStartupUseCase,
+	unfollowUC *followusecase.UnfollowStartupUseCase,
+	listFollowsUC *followusecase.ListFollowsUseCase,
+	followerCountUC *followusecase.FollowerCountUseCase,
+	unsubscribeUC *followusecase.UnsubscribeFollowsUseCase,
+	validator *validator.Validator,
+) *FollowHandler {
+	return &FollowHandler{
+		followUC: followUC, unfollowUC: unfollowUC,
+		listFollowsUC: listFollowsUC, followerCountUC: followerCountUC,
+		unsubscribeUC: unsubscribeUC, validator: validator,
+	}
+}
+
+func (h *FollowHandler) Follow(c *gin.Context) {
+	if err := h.followUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id")); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"following": true})
+}
+
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	if err := h.unfollowUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id")); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"following": false})
+}
+
+func (h *FollowHandler) ListMine(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	page, pageSize = utils.ClampPagination(page, pageSize, utils.MaxPageSizeAuth)
+	follows, total, err := h.listFollowsUC.Execute(c.Request.Context(), middleware.GetUserID(c), page, pageSize)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"follows": follows, "total": total, "page": page, "page_size": pageSize})
+}
+
+func (h *FollowHandler) FollowerCount(c *gin.Context) {
+	result, err := h.followerCountUC.Execute(c.Request.Context(), middleware.GetUserID(c), c.Param("id"))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+// Unsubscribe is the public target of the link in follow digests.
+func (h *FollowHandler) Unsubscribe(c *gin.Context) {
+	var input dto.UnsubscribeFollowsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.unsubscribeUC.Execute(c.Request.Context(), input.Token)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
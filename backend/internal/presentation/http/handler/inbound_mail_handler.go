@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+// InboundMailHandler receives messages from the mail provider's inbound
+// webhook (or a local SMTP bridge) for apply+<token> relay addresses.
+type InboundMailHandler struct {
+	forwardUseCase *jobusecase.ForwardApplicationUseCase
+	secret         string
+	validator      *validator.Validator
+}
+
+func NewInboundMailHandler(
+	forwardUseCase *jobusecase.ForwardApplicationUseCase,
+	secret string,
+	validator *validator.Validator,
+) *InboundMailHandler {
+	return &InboundMailHandler{
+		forwardUseCase: forwardUseCase,
+		secret:         secret,
+		validator:      validator,
+	}
+}
+
+func (h *InboundMailHandler) Apply(c *gin.Context) {
+	// Empty secret disables the endpoint rather than leaving it open.
+	provided := c.GetHeader("X-Inbound-Secret")
+	if h.secret == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(h.secret)) != 1 {
+		response.Unauthorized(c)
+		return
+	}
+
+	var input dto.InboundApplicationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.forwardUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
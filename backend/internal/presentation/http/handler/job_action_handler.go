@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	jobusecase "github.com/startup-job-board/backend/internal/application/usecase/job"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+// JobActionHandler serves the login-free one-click links from reminder emails.
+// GET only previews; the change happens on POST so mail link scanners that
+// prefetch URLs can't close or extend a job.
+type JobActionHandler struct {
+	applyUseCase *jobusecase.ApplyJobActionUseCase
+	validator    *validator.Validator
+}
+
+func NewJobActionHandler(applyUseCase *jobusecase.ApplyJobActionUseCase, validator *validator.Validator) *JobActionHandler {
+	return &JobActionHandler{applyUseCase: applyUseCase, validator: validator}
+}
+
+func (h *JobActionHandler) Preview(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		response.BadRequest(c, "token is required")
+		return
+	}
+
+	result, err := h.applyUseCase.Preview(c.Request.Context(), token)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func (h *JobActionHandler) Apply(c *gin.Context) {
+	var input dto.JobActionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.applyUseCase.Execute(c.Request.Context(), input.Token)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
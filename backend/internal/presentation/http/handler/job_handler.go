@@ -22,6 +22,7 @@ type JobHandler struct {
 	updateUseCase *jobusecase.UpdateJobUseCase
 	listUseCase   *jobusecase.ListJobsUseCase
 	deleteUseCase *jobusecase.DeleteJobUseCase
+	relayUseCase  *jobusecase.ApplyRelayAddressUseCase
 	jobRepo       repository.JobRepository
 	startupRepo   repository.StartupRepository
 	validator     *validator.Validator
@@ -32,6 +33,7 @@ func NewJobHandler(
 	updateUseCase *jobusecase.UpdateJobUseCase,
 	listUseCase *jobusecase.ListJobsUseCase,
 	deleteUseCase *jobusecase.DeleteJobUseCase,
+	relayUseCase *jobusecase.ApplyRelayAddressUseCase,
 	jobRepo repository.JobRepository,
 	startupRepo repository.StartupRepository,
 	validator *validator.Validator,
@@ -41,6 +43,7 @@ func NewJobHandler(
 		updateUseCase: updateUseCase,
 		listUseCase:   listUseCase,
 		deleteUseCase: deleteUseCase,
+		relayUseCase:  relayUseCase,
 		jobRepo:       jobRepo,
 		startupRepo:   startupRepo,
 		validator:     validator,
@@ -198,7 +201,8 @@ func (h *JobHandler) Get(c *gin.Context) {
 		startupSlug = startup.Slug
 	}
 
-	// Convert to output DTO
+	// Convert to output DTO. The application email is replaced by the job's
+	// relay address so the real inbox is never scraped from detail pages.
 	output := &dto.JobOutput{
 		ID:               job.ID,
 		StartupID:        job.StartupID,
@@ -215,7 +219,7 @@ func (h *JobHandler) Get(c *gin.Context) {
 		SalaryMax:        job.SalaryMax,
 		Currency:         job.Currency,
 		ApplicationURL:   job.ApplicationURL,
-		ApplicationEmail: job.ApplicationEmail,
+		ApplicationEmail: h.relayUseCase.Execute(c.Request.Context(), job),
 		Status:           string(job.Status),
 		CreatedAt:        job.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:        job.UpdatedAt.Format(time.RFC3339),
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxLocaleLength matches the locale columns.
+const maxLocaleLength = 16
+
+// requestLocale returns the client's preferred language tag from
+// Accept-Language, e.g. "de-AT" for "de-AT,de;q=0.9,en;q=0.8". It returns ""
+// when the header names no usable tag. Browsers list the preferred language
+// first, so the q-values are not compared.
+func requestLocale(c *gin.Context) string {
+	for _, entry := range strings.Split(c.GetHeader("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(entry, ";")
+		tag = strings.TrimSpace(tag)
+		if strings.TrimSpace(params) == "q=0" || !isLanguageTag(tag) {
+			continue
+		}
+		return tag
+	}
+	return ""
+}
+
+func isLanguageTag(tag string) bool {
+	if len(tag) < 2 || len(tag) > maxLocaleLength {
+		return false
+	}
+	for _, r := range tag {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -118,12 +119,19 @@ func (h *StartupHandler) Get(c *gin.Context) {
 
 func (h *StartupHandler) GetBySlug(c *gin.Context) {
 	slug := c.Param("slug")
-	result, err := h.getUseCase.ExecuteBySlug(c.Request.Context(), slug)
+	result, moved, err := h.getUseCase.ExecuteBySlug(c.Request.Context(), slug)
 	if err != nil {
 		response.Error(c, http.StatusNotFound, err)
 		return
 	}
 
+	if moved {
+		// Old slug: point clients at the canonical URL. The body is still the
+		// startup, so callers that follow redirects lose nothing.
+		c.Header("Location", "/api/v1/startups/slug/"+url.PathEscape(result.Slug))
+		c.JSON(http.StatusMovedPermanently, response.SuccessResponse{Success: true, Data: result})
+		return
+	}
 	response.Success(c, result)
 }
 
@@ -151,6 +159,9 @@ func (h *StartupHandler) List(c *gin.Context) {
 	if companySize := c.Query("company_size"); companySize != "" {
 		filter.CompanySize = companySize
 	}
+	if verified, err := strconv.ParseBool(c.Query("verified")); err == nil {
+		filter.Verified = &verified
+	}
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
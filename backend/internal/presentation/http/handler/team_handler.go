@@ -64,6 +64,12 @@ func mapUCError(c *gin.Context, err error) {
 			response.Error(c, http.StatusForbidden, err)
 		case "UNAUTHORIZED":
 			response.Error(c, http.StatusUnauthorized, err)
+		case "CONFLICT":
+			response.Error(c, http.StatusConflict, err)
+		case "RATE_LIMITED":
+			response.Error(c, http.StatusTooManyRequests, err)
+		case "INTERNAL_ERROR":
+			response.Error(c, http.StatusInternalServerError, err)
 		default:
 			response.Error(c, http.StatusBadRequest, err)
 		}
@@ -145,6 +151,7 @@ func (h *TeamHandler) Invite(c *gin.Context) {
 		response.BadRequest(c, err.Error())
 		return
 	}
+	input.Locale = requestLocale(c)
 	if err := h.inviteUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input); err != nil {
 		mapUCError(c, err)
 		return
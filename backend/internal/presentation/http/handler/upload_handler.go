@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	fileusecase "github.com/startup-job-board/backend/internal/application/usecase/file"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+// UploadHandler serves direct-to-storage uploads: the client asks for a
+// presigned policy, uploads to storage itself, then calls complete.
+type UploadHandler struct {
+	presignUC  *fileusecase.PresignUploadUseCase
+	completeUC *fileusecase.CompleteUploadUseCase
+	validator  *validator.Validator
+}
+
+func NewUploadHandler(presignUC *fileusecase.PresignUploadUseCase, completeUC *fileusecase.CompleteUploadUseCase, validator *validator.Validator) *UploadHandler {
+	return &UploadHandler{presignUC: presignUC, completeUC: completeUC, validator: validator}
+}
+
+func (h *UploadHandler) Presign(c *gin.Context) {
+	var input dto.PresignUploadInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	result, err := h.presignUC.Execute(c.Request.Context(), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func (h *UploadHandler) Complete(c *gin.Context) {
+	result, err := h.completeUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/dto"
+	webhookusecase "github.com/startup-job-board/backend/internal/application/usecase/webhook"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/internal/presentation/http/validator"
+)
+
+type WebhookHandler struct {
+	listUC           *webhookusecase.ListEndpointsUseCase
+	createUC         *webhookusecase.CreateEndpointUseCase
+	updateUC         *webhookusecase.UpdateEndpointUseCase
+	deleteUC         *webhookusecase.DeleteEndpointUseCase
+	listDeliveriesUC *webhookusecase.ListDeliveriesUseCase
+	redeliverUC      *webhookusecase.RedeliverUseCase
+	validator        *validator.Validator
+}
+
+func NewWebhookHandler(
+	listUC *webhookusecase.ListEndpointsUseCase,
+	createUC *webhookusecase.CreateEndpointUseCase,
+	updateUC *webhookusecase.UpdateEndpointUseCase,
+	deleteUC *webhookusecase.DeleteEndpointUseCase,
+	listDeliveriesUC *webhookusecase.ListDeliveriesUseCase,
+	redeliverUC *webhookusecase.RedeliverUseCase,
+	validator *validator.Validator,
+) *WebhookHandler {
+	return &WebhookHandler{
+		listUC: listUC, createUC: createUC, updateUC: updateUC, deleteUC: deleteUC,
+		listDeliveriesUC: listDeliveriesUC, redeliverUC: redeliverUC, validator: validator,
+	}
+}
+
+func (h *WebhookHandler) List(c *gin.Context) {
+	endpoints, err := h.listUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, endpoints)
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var input dto.CreateWebhookEndpointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	endpoint, err := h.createUC.Execute(c.Request.Context(), c.Param("id"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, endpoint)
+}
+
+func (h *WebhookHandler) Update(c *gin.Context) {
+	var input dto.UpdateWebhookEndpointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validator.Validate(input); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	endpoint, err := h.updateUC.Execute(c.Request.Context(), c.Param("id"), c.Param("webhookId"), middleware.GetUserID(c), input)
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, endpoint)
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	if err := h.deleteUC.Execute(c.Request.Context(), c.Param("id"), c.Param("webhookId"), middleware.GetUserID(c)); err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"deleted": true})
+}
+
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	deliveries, err := h.listDeliveriesUC.Execute(c.Request.Context(), c.Param("id"), c.Param("webhookId"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, deliveries)
+}
+
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	delivery, err := h.redeliverUC.Execute(c.Request.Context(), c.Param("id"), c.Param("webhookId"), c.Param("deliveryId"), middleware.GetUserID(c))
+	if err != nil {
+		mapUCError(c, err)
+		return
+	}
+	response.Success(c, delivery)
+}
@@ -1,14 +1,26 @@
 package middleware
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/utils"
 )
 
 const StartupIDKey = "startup_id"
+const APITokenScopesKey = "api_token_scopes"
+const APITokenIDKey = "api_token_id"
+const APITokenPlanKey = "api_token_plan"
+
+// lastUsedResolution bounds how often last_used_at is written per token.
+const lastUsedResolution = time.Minute
 
-func APITokenMiddleware(startupRepo repository.StartupRepository) gin.HandlerFunc {
+// APITokenMiddleware authenticates startup_api_tokens.
+func APITokenMiddleware(tokenRepo repository.StartupAPITokenRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -23,18 +35,48 @@ func APITokenMiddleware(startupRepo repository.StartupRepository) gin.HandlerFun
 			token = authHeader[7:]
 		}
 
-		startup, err := startupRepo.FindByAPIToken(c.Request.Context(), token)
+		ctx := c.Request.Context()
+		apiToken, err := tokenRepo.FindByHash(ctx, utils.HashToken(token))
 		if err != nil {
+			response.InternalError(c)
+			c.Abort()
+			return
+		}
+		now := time.Now()
+		if apiToken == nil || !apiToken.IsActive(now) {
 			response.Unauthorized(c)
 			c.Abort()
 			return
 		}
-
-		c.Set(StartupIDKey, startup.ID)
+		if apiToken.LastUsedAt == nil || now.Sub(*apiToken.LastUsedAt) >= lastUsedResolution {
+			_ = tokenRepo.TouchLastUsed(ctx, apiToken.ID, now)
+		}
+		c.Set(StartupIDKey, apiToken.StartupID)
+		c.Set(APITokenIDKey, apiToken.ID)
+		c.Set(APITokenScopesKey, apiToken.Scopes)
+		c.Set(APITokenPlanKey, apiToken.StartupPlan)
+		AddLogFields(c, logger.FieldStartupID, apiToken.StartupID)
 		c.Next()
 	}
 }
 
+// RequireAPITokenScope rejects token requests whose token lacks scope.
+// Must run after APITokenMiddleware.
+func RequireAPITokenScope(scope entity.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes, _ := c.Get(APITokenScopesKey)
+		granted, _ := scopes.([]entity.Scope)
+		for _, s := range granted {
+			if s == scope {
+				c.Next()
+				return
+			}
+		}
+		response.Forbidden(c)
+		c.Abort()
+	}
+}
+
 func GetStartupID(c *gin.Context) string {
 	startupID, exists := c.Get(StartupIDKey)
 	if !exists {
@@ -42,3 +84,13 @@ func GetStartupID(c *gin.Context) string {
 	}
 	return startupID.(string)
 }
+
+// GetAPITokenID identifies the token behind the request.
+func GetAPITokenID(c *gin.Context) string {
+	return c.GetString(APITokenIDKey)
+}
+
+// GetAPITokenPlan is the plan of the startup owning the request's token.
+func GetAPITokenPlan(c *gin.Context) string {
+	return c.GetString(APITokenPlanKey)
+}
@@ -0,0 +1,54 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/domain/repository"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+type scopedTokens struct {
+	repository.StartupAPITokenRepository
+	byHash map[string]*entity.StartupAPIToken
+}
+
+func (r scopedTokens) FindByHash(_ context.Context, hash string) (*entity.StartupAPIToken, error) {
+	return r.byHash[hash], nil
+}
+
+func (r scopedTokens) TouchLastUsed(_ context.Context, id string, at time.Time) error { return nil }
+
+func TestRequireAPITokenScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	revoked := time.Now().Add(-time.Hour)
+	tokens := scopedTokens{byHash: map[string]*entity.StartupAPIToken{
+		utils.HashToken("sb_reader"): {ID: "reader", StartupID: "s1", Scopes: []entity.Scope{entity.ScopeJobsRead}},
+		utils.HashToken("sb_writer"): {ID: "writer", StartupID: "s1", Scopes: []entity.Scope{entity.ScopeJobsRead, entity.ScopeJobsWrite}},
+		utils.HashToken("sb_legacy"): {ID: "legacy", StartupID: "s1", Name: "legacy", Scopes: entity.APITokenScopes(), RevokedAt: &revoked},
+	}}
+	r := gin.New()
+	r.Use(middleware.APITokenMiddleware(tokens))
+	r.GET("/api/v1/token/jobs", middleware.RequireAPITokenScope(entity.ScopeJobsWrite), func(c *gin.Context) {
+		c.String(http.StatusOK, middleware.GetAPITokenID(c))
+	})
+
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_reader"); rec.Code != http.StatusForbidden {
+		t.Fatalf("token without jobs:write: %d", rec.Code)
+	}
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_writer"); rec.Code != http.StatusOK || rec.Body.String() != "writer" {
+		t.Fatalf("token with jobs:write: %d %q", rec.Code, rec.Body.String())
+	}
+	// A migrated legacy token is an ordinary token and can be revoked.
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "sb_legacy"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("revoked legacy token: %d", rec.Code)
+	}
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_unknown"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown token: %d", rec.Code)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/startup-job-board/backend/internal/application/port"
 	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/pkg/logger"
 )
 
 const UserIDKey = "user_id"
@@ -37,6 +38,7 @@ func AuthMiddleware(jwtService port.JWTService) gin.HandlerFunc {
 
 		c.Set(UserIDKey, userID)
 		c.Set(UserRoleKey, role)
+		AddLogFields(c, logger.FieldUserID, userID)
 		c.Next()
 	}
 }
@@ -1,39 +1,76 @@
 package middleware
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	nrgin "github.com/newrelic/go-agent/v3/integrations/nrgin"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
 )
 
-// PublicCacheMiddleware sets short Cache-Control headers on successful public
-// GET responses for jobs/startups endpoints.
-func PublicCacheMiddleware() gin.HandlerFunc {
+// PublicCacheMiddleware sets short Cache-Control headers and strong ETags on
+// successful public GET responses for jobs/startups endpoints, and answers a
+// matching If-None-Match with 304. With a non-nil cache, anonymous responses
+// are replayed from it until a use case invalidates them.
+func PublicCacheMiddleware(cache port.ResponseCache) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Next()
-
-		if c.Request.Method != "GET" {
-			return
-		}
-		if c.Writer.Status() < 200 || c.Writer.Status() >= 300 {
-			return
-		}
-
 		path := c.FullPath()
 		if path == "" {
 			path = c.Request.URL.Path
 		}
-		if !isCacheablePublicPath(path) {
+		if c.Request.Method != http.MethodGet || !isCacheablePublicPath(path) {
+			c.Next()
 			return
 		}
 
 		// Skip caching for authenticated dashboard callers.
 		if GetUserID(c) != "" {
 			c.Header("Cache-Control", "private, no-store")
+			c.Next()
 			return
 		}
-
 		c.Header("Cache-Control", "public, max-age=30, s-maxage=60")
+
+		key := publicCacheKey(c)
+		if cache != nil {
+			if cached, ok := cache.Get(key); ok {
+				recordCacheLookup(c, "Hit")
+				writeCachedResponse(c, cached)
+				c.Abort()
+				return
+			}
+			recordCacheLookup(c, "Miss")
+		}
+
+		// Hold the response back until its ETag is known.
+		w := response.NewBufferedWriter(c.Writer)
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.Status() != http.StatusOK {
+			c.Writer.Header().Del("Cache-Control")
+			c.Writer.WriteHeader(w.Status())
+			c.Writer.WriteHeaderNow()
+			_, _ = c.Writer.Write(w.Body())
+			return
+		}
+
+		sum := sha256.Sum256(w.Body())
+		resp := &port.CachedResponse{
+			Status:      w.Status(),
+			ContentType: w.Header().Get("Content-Type"),
+			ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+			Body:        w.Body(),
+		}
+		if cache != nil {
+			cache.Set(key, cacheScope(path), resp)
+		}
+		writeCachedResponse(c, resp)
 	}
 }
 
@@ -44,3 +81,66 @@ func isCacheablePublicPath(path string) bool {
 	return strings.HasPrefix(path, "/api/v1/jobs/") ||
 		strings.HasPrefix(path, "/api/v1/startups/slug/")
 }
+
+func cacheScope(path string) port.CacheScope {
+	if strings.HasPrefix(path, "/api/v1/startups") {
+		return port.CacheScopeStartups
+	}
+	return port.CacheScopeJobs
+}
+
+// publicCacheKey normalizes the query so parameter order and empty filters
+// don't split the cache. SSR callers see more than anonymous ones and get
+// their own entries.
+func publicCacheKey(c *gin.Context) string {
+	query := c.Request.URL.Query()
+	for name, values := range query {
+		kept := values[:0]
+		for _, v := range values {
+			if v != "" {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) == 0 {
+			delete(query, name)
+		} else {
+			query[name] = kept
+		}
+	}
+	audience := "anon"
+	if IsInternalTrusted(c) {
+		audience = "trusted"
+	}
+	return audience + "|" + c.Request.URL.Path + "?" + query.Encode()
+}
+
+func writeCachedResponse(c *gin.Context, resp *port.CachedResponse) {
+	c.Header("ETag", resp.ETag)
+	if etagMatches(c.GetHeader("If-None-Match"), resp.ETag) {
+		c.Writer.Header().Del("Content-Type")
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	c.Header("Content-Type", resp.ContentType)
+	c.Status(resp.Status)
+	c.Writer.WriteHeaderNow()
+	_, _ = c.Writer.Write(resp.Body)
+}
+
+// etagMatches applies the weak comparison If-None-Match calls for.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func recordCacheLookup(c *gin.Context, result string) {
+	if txn := nrgin.Transaction(c); txn != nil {
+		txn.Application().RecordCustomMetric("Custom/ResponseCache/"+result, 1)
+	}
+}
@@ -0,0 +1,74 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/infrastructure/cache"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+)
+
+func TestPublicCacheRevalidatesAndReplays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	responses := cache.NewMemoryResponseCache(time.Minute, 10)
+	calls := 0
+	r := gin.New()
+	r.Use(middleware.PublicCacheMiddleware(responses))
+	r.GET("/api/v1/jobs", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusOK, gin.H{"calls": calls})
+	})
+
+	first := do(r, "/api/v1/jobs?page=1&search=go")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Header().Get("Cache-Control") == "" {
+		t.Fatalf("first: %d etag %q headers %v", first.Code, etag, first.Header())
+	}
+
+	// Reordered and empty parameters hit the same entry.
+	again := do(r, "/api/v1/jobs?search=go&page=1&city=")
+	if calls != 1 || again.Body.String() != first.Body.String() || again.Header().Get("ETag") != etag {
+		t.Fatalf("replay: calls %d body %q", calls, again.Body.String())
+	}
+
+	notModified := do(r, "/api/v1/jobs?page=1&search=go", "If-None-Match", `W/"other", `+etag)
+	if notModified.Code != http.StatusNotModified || notModified.Body.Len() != 0 {
+		t.Fatalf("revalidate: %d %q", notModified.Code, notModified.Body.String())
+	}
+
+	responses.Invalidate(port.CacheScopeStartups)
+	do(r, "/api/v1/jobs?page=1&search=go")
+	if calls != 1 {
+		t.Fatalf("startup invalidation dropped a jobs entry")
+	}
+	responses.Invalidate(port.CacheScopeJobs)
+	fresh := do(r, "/api/v1/jobs?page=1&search=go", "If-None-Match", etag)
+	if calls != 2 || fresh.Code != http.StatusOK || fresh.Header().Get("ETag") == etag {
+		t.Fatalf("after invalidation: calls %d code %d", calls, fresh.Code)
+	}
+
+	if stats := responses.Stats(); stats.Hits != 3 || stats.Misses != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestPublicCacheSkipsSignedInUsers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	responses := cache.NewMemoryResponseCache(time.Minute, 10)
+	calls := 0
+	r := gin.New()
+	r.Use(func(c *gin.Context) { c.Set(middleware.UserIDKey, "u1") }, middleware.PublicCacheMiddleware(responses))
+	r.GET("/api/v1/jobs", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusOK, gin.H{"calls": calls})
+	})
+
+	do(r, "/api/v1/jobs")
+	rec := do(r, "/api/v1/jobs")
+	if calls != 2 || rec.Header().Get("Cache-Control") != "private, no-store" || rec.Header().Get("ETag") != "" {
+		t.Fatalf("calls %d headers %v", calls, rec.Header())
+	}
+}
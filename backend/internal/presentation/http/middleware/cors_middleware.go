@@ -9,7 +9,8 @@ func CORSMiddleware(allowedOrigins []string) gin.HandlerFunc {
 	config := cors.DefaultConfig()
 	config.AllowOrigins = allowedOrigins
 	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Requested-With"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Requested-With", RequestIDHeader, "If-None-Match"}
+	config.ExposeHeaders = []string{RequestIDHeader, "ETag", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"}
 	config.AllowCredentials = true
 	return cors.New(config)
 }
@@ -1,24 +1,83 @@
 package middleware
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"github.com/startup-job-board/backend/pkg/tracing"
 )
 
-func LoggerMiddleware() gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		return fmt.Sprintf("%s - [%s] \"%s %s %s %d %s \"%s\" %s\"\n",
-			param.ClientIP,
-			param.TimeStamp.Format(time.RFC1123),
-			param.Method,
-			param.Path,
-			param.Request.Proto,
-			param.StatusCode,
-			param.Latency,
-			param.Request.UserAgent(),
-			param.ErrorMessage,
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds caller-supplied IDs that end up in every log line.
+const maxRequestIDLength = 128
+
+// LoggerMiddleware assigns each request an ID, taken from X-Request-ID when
+// the caller sent a usable one, echoes it back and puts it with the route on
+// the request context, along with the trace ID when a span is active. It logs
+// one entry per request when it completes.
+func LoggerMiddleware(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		c.Header(RequestIDHeader, requestID)
+		fields := []interface{}{logger.FieldRequestID, requestID, logger.FieldRoute, c.FullPath()}
+		if traceID := tracing.TraceID(c.Request.Context()); traceID != "" {
+			fields = append(fields, logger.FieldTraceID, traceID)
+		}
+		c.Request = c.Request.WithContext(logger.ContextWith(c.Request.Context(), fields...))
+
+		c.Next()
+
+		status := c.Writer.Status()
+		entry := log.WithContext(c.Request.Context()).With(
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", status,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"bytes", c.Writer.Size(),
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
 		)
-	})
+		if len(c.Errors) > 0 {
+			entry = entry.With("errors", c.Errors.String())
+		}
+		switch {
+		case status >= 500:
+			entry.Error("request completed")
+		case status >= 400:
+			entry.Warn("request completed")
+		default:
+			entry.Info("request completed")
+		}
+	}
+}
+
+// AddLogFields adds key-value pairs to the fields logged for this request.
+func AddLogFields(c *gin.Context, keyvals ...interface{}) {
+	c.Request = c.Request.WithContext(logger.ContextWith(c.Request.Context(), keyvals...))
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-' || r == '_' || r == '.' || r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func GetRequestID(c *gin.Context) string {
+	return logger.RequestIDFromContext(c.Request.Context())
 }
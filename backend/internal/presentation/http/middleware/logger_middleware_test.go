@@ -0,0 +1,37 @@
+package middleware_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+func TestLoggerMiddlewarePropagatesRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	r := gin.New()
+	r.Use(middleware.LoggerMiddleware(logger.New(logger.Options{Output: &buf})))
+	var seen string
+	r.GET("/api/v1/jobs/:id", func(c *gin.Context) {
+		seen = middleware.GetRequestID(c)
+		c.Status(http.StatusOK)
+	})
+
+	rec := do(r, "/api/v1/jobs/42", "X-Request-ID", "crawler-sync-1")
+	if seen != "crawler-sync-1" || rec.Header().Get("X-Request-ID") != "crawler-sync-1" {
+		t.Fatalf("propagated id: handler %q, header %q", seen, rec.Header().Get("X-Request-ID"))
+	}
+	if out := buf.String(); !strings.Contains(out, `"request_id":"crawler-sync-1"`) || !strings.Contains(out, `"route":"/api/v1/jobs/:id"`) {
+		t.Fatalf("log entry: %s", out)
+	}
+
+	rec = do(r, "/api/v1/jobs/42", "X-Request-ID", "bad id\nwith newline")
+	if id := rec.Header().Get("X-Request-ID"); id == "" || strings.Contains(id, " ") {
+		t.Fatalf("unusable id was not replaced: %q", id)
+	}
+}
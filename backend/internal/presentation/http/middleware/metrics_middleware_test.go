@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+)
+
+type recordingMetrics struct {
+	routes   []string
+	rejected []string
+}
+
+func (m *recordingMetrics) ObserveHTTPRequest(method, route string, status int, _ time.Duration) {
+	m.routes = append(m.routes, method+" "+route+" "+http.StatusText(status))
+}
+func (m *recordingMetrics) RateLimitRejected(bucket string)  { m.rejected = append(m.rejected, bucket) }
+func (m *recordingMetrics) StripeWebhookHandled(_, _ string) {}
+func (m *recordingMetrics) EmailSent(_ string, _ error)      {}
+
+func TestMetricsLabelByRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := &recordingMetrics{}
+	cfg := settings(t)
+	cfg.Metrics = m
+	r := gin.New()
+	r.Use(middleware.MetricsMiddleware(m), middleware.RateLimitMiddleware(cfg))
+	r.GET("/api/v1/jobs/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do(r, "/api/v1/jobs/a")
+	do(r, "/api/v1/jobs/b")
+	do(r, "/nowhere")
+
+	want := []string{"GET /api/v1/jobs/:id OK", "GET /api/v1/jobs/:id OK", "GET  Not Found"}
+	if len(m.routes) != len(want) {
+		t.Fatalf("routes = %q", m.routes)
+	}
+	for i := range want {
+		if m.routes[i] != want[i] {
+			t.Fatalf("routes = %q, want %q", m.routes, want)
+		}
+	}
+
+	fresh := settings(t)
+	fresh.DetailLimit, fresh.Metrics = 1, m
+	limited := gin.New()
+	limited.Use(middleware.RateLimitMiddleware(fresh))
+	limited.GET("/api/v1/jobs/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	do(limited, "/api/v1/jobs/a")
+	if rec := do(limited, "/api/v1/jobs/a"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("second detail request: %d", rec.Code)
+	}
+	if len(m.rejected) != 1 || m.rejected[0] != "detail" {
+		t.Fatalf("rejected = %q", m.rejected)
+	}
+}
+
+func TestMetricsAuthRequiresBearerToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/metrics", middleware.MetricsAuthMiddleware("s3cret"), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	if rec := do(r, "/metrics"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("no token: %d", rec.Code)
+	}
+	if rec := do(r, "/metrics", "Authorization", "Bearer wrong"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("wrong token: %d", rec.Code)
+	}
+	if rec := do(r, "/metrics", "Authorization", "Bearer s3cret"); rec.Code != http.StatusOK {
+		t.Fatalf("valid token: %d", rec.Code)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/pkg/logger"
 )
 
 // OptionalAuthMiddleware attaches user identity when a valid Bearer token is
@@ -31,6 +32,7 @@ func OptionalAuthMiddleware(jwtService port.JWTService) gin.HandlerFunc {
 
 		c.Set(UserIDKey, userID)
 		c.Set(UserRoleKey, role)
+		AddLogFields(c, logger.FieldUserID, userID)
 		c.Next()
 	}
 }
@@ -1,106 +1,39 @@
 package middleware
 
 import (
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/presentation/http/response"
 	"github.com/startup-job-board/backend/pkg/errors"
+	"github.com/startup-job-board/backend/pkg/logger"
 )
 
-// RateLimitSettings configures per-IP sliding-window limits.
+// RateLimitSettings configures sliding-window limits. Anonymous clients are
+// keyed by IP, signed-in users by user ID and API clients by token.
 type RateLimitSettings struct {
-	Enabled      bool
-	Window       time.Duration
-	DefaultLimit int // authenticated / general
-	ListLimit    int // public list scrape targets
-	DetailLimit  int // public detail scrape targets
-	TrustedLimit int // SSR internal key
+	Enabled       bool
+	Window        time.Duration
+	DefaultLimit  int // authenticated / general
+	ListLimit     int // public list scrape targets
+	DetailLimit   int // public detail scrape targets
+	TrustedLimit  int // SSR internal key
+	TokenLimit    int // per API token, free plan
+	TokenProLimit int // per API token, Pro plan
+	Store         port.RateLimitStore
+	// Metrics, when set, counts rejections per bucket (the key's prefix).
+	Metrics port.Metrics
+	// Logger reports store failures; requests are let through when the store is down.
+	Logger logger.Logger
 }
 
-type visitorBucket struct {
-	mu    sync.Mutex
-	times []time.Time
-}
-
-type rateLimiterStore struct {
-	mu       sync.Mutex
-	visitors map[string]*visitorBucket
-	window   time.Duration
-}
-
-func newRateLimiterStore(window time.Duration) *rateLimiterStore {
-	s := &rateLimiterStore{
-		visitors: make(map[string]*visitorBucket),
-		window:   window,
-	}
-	go s.cleanupLoop()
-	return s
-}
-
-func (s *rateLimiterStore) cleanupLoop() {
-	ticker := time.NewTicker(s.window)
-	defer ticker.Stop()
-	for range ticker.C {
-		s.mu.Lock()
-		cutoff := time.Now().Add(-s.window * 2)
-		for key, v := range s.visitors {
-			v.mu.Lock()
-			if len(v.times) == 0 || v.times[len(v.times)-1].Before(cutoff) {
-				delete(s.visitors, key)
-			}
-			v.mu.Unlock()
-		}
-		s.mu.Unlock()
-	}
-}
-
-func (s *rateLimiterStore) allow(key string, limit int) (bool, time.Duration) {
-	now := time.Now()
-	windowStart := now.Add(-s.window)
-
-	s.mu.Lock()
-	v, ok := s.visitors[key]
-	if !ok {
-		v = &visitorBucket{}
-		s.visitors[key] = v
-	}
-	s.mu.Unlock()
-
-	v.mu.Lock()
-	defer v.mu.Unlock()
-
-	// Drop timestamps outside the window.
-	kept := v.times[:0]
-	for _, t := range v.times {
-		if t.After(windowStart) {
-			kept = append(kept, t)
-		}
-	}
-	v.times = kept
-
-	if len(v.times) >= limit {
-		retryAfter := s.window - now.Sub(v.times[0])
-		if retryAfter < time.Second {
-			retryAfter = time.Second
-		}
-		return false, retryAfter
-	}
-
-	v.times = append(v.times, now)
-	return true, 0
-}
-
-// RateLimitMiddleware applies stricter limits to public jobs/startups scrape targets.
-func RateLimitMiddleware(cfg RateLimitSettings) gin.HandlerFunc {
-	if !cfg.Enabled {
-		return func(c *gin.Context) { c.Next() }
-	}
-
+func (cfg RateLimitSettings) withDefaults() RateLimitSettings {
 	if cfg.Window <= 0 {
 		cfg.Window = time.Minute
 	}
@@ -116,8 +49,21 @@ func RateLimitMiddleware(cfg RateLimitSettings) gin.HandlerFunc {
 	if cfg.TrustedLimit <= 0 {
 		cfg.TrustedLimit = 300
 	}
+	if cfg.TokenLimit <= 0 {
+		cfg.TokenLimit = 120
+	}
+	if cfg.TokenProLimit <= 0 {
+		cfg.TokenProLimit = 600
+	}
+	return cfg
+}
 
-	store := newRateLimiterStore(cfg.Window)
+// RateLimitMiddleware applies stricter limits to public jobs/startups scrape targets.
+func RateLimitMiddleware(cfg RateLimitSettings) gin.HandlerFunc {
+	if !cfg.Enabled {
+		return func(c *gin.Context) { c.Next() }
+	}
+	cfg = cfg.withDefaults()
 
 	return func(c *gin.Context) {
 		path := c.FullPath()
@@ -125,45 +71,92 @@ func RateLimitMiddleware(cfg RateLimitSettings) gin.HandlerFunc {
 			path = c.Request.URL.Path
 		}
 
-		// Exempt health and Stripe webhooks.
-		if strings.HasSuffix(path, "/health") || strings.HasSuffix(path, "/billing/webhook") {
+		// Exempt probes, metrics scrapes and provider webhooks (inbound mail is limited per sender).
+		if strings.HasSuffix(path, "/health") || path == "/livez" || path == "/readyz" || path == "/metrics" ||
+			strings.HasSuffix(path, "/billing/webhook") || strings.HasSuffix(path, "/inbound/apply") {
 			c.Next()
 			return
 		}
 
 		limit := cfg.DefaultLimit
-		bucket := "default"
+		key := "default:" + c.ClientIP()
 
 		if IsInternalTrusted(c) {
 			limit = cfg.TrustedLimit
-			bucket = "trusted"
-		} else if GetUserID(c) != "" {
-			limit = cfg.DefaultLimit
-			bucket = "auth"
+			key = "trusted:" + c.ClientIP()
+		} else if userID := GetUserID(c); userID != "" {
+			key = "user:" + userID
+		} else if strings.HasPrefix(path, "/api/v1/token/") {
+			// Token clients get their quota from APITokenRateLimitMiddleware;
+			// this per-IP ceiling only bounds unauthenticated guessing.
+			limit = max(cfg.TokenProLimit, cfg.DefaultLimit)
+			key = "token-ip:" + c.ClientIP()
 		} else if isPublicListPath(path) {
 			limit = cfg.ListLimit
-			bucket = "list"
+			key = "list:" + c.ClientIP()
 		} else if isPublicDetailPath(path) {
 			limit = cfg.DetailLimit
-			bucket = "detail"
+			key = "detail:" + c.ClientIP()
 		}
 
-		ip := c.ClientIP()
-		key := bucket + ":" + ip
-		allowed, retryAfter := store.allow(key, limit)
-		if !allowed {
-			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
-			c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
-			response.Error(c, http.StatusTooManyRequests, errors.ErrRateLimited)
-			c.Abort()
+		if !take(c, cfg, key, limit) {
 			return
 		}
+		c.Next()
+	}
+}
+
+// APITokenRateLimitMiddleware limits each API token on its own, with a larger
+// quota for startups on the Pro plan. Must run after APITokenMiddleware,
+// which records the plan alongside the token.
+func APITokenRateLimitMiddleware(cfg RateLimitSettings) gin.HandlerFunc {
+	if !cfg.Enabled {
+		return func(c *gin.Context) { c.Next() }
+	}
+	cfg = cfg.withDefaults()
+
+	return func(c *gin.Context) {
+		limit := cfg.TokenLimit
+		if GetAPITokenPlan(c) == string(entity.StartupPlanPro) {
+			limit = cfg.TokenProLimit
+		}
 
-		c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
+		if !take(c, cfg, "token:"+GetAPITokenID(c), limit) {
+			return
+		}
 		c.Next()
 	}
 }
 
+// take records the request against key and answers 429 when over limit.
+// It reports whether the request may proceed.
+func take(c *gin.Context, cfg RateLimitSettings, key string, limit int) bool {
+	result, err := cfg.Store.Take(c.Request.Context(), key, limit, cfg.Window)
+	if err != nil {
+		if cfg.Logger != nil {
+			cfg.Logger.WithContext(c.Request.Context()).Warn("Rate limit store unavailable, allowing request: %v", err)
+		}
+		return true
+	}
+
+	reset := strconv.Itoa(int(math.Ceil(max(result.Reset, time.Second).Seconds())))
+	c.Header("RateLimit-Limit", strconv.Itoa(limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("RateLimit-Reset", reset)
+	c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
+	if !result.Allowed {
+		c.Header("Retry-After", reset)
+		if cfg.Metrics != nil {
+			bucket, _, _ := strings.Cut(key, ":")
+			cfg.Metrics.RateLimitRejected(bucket)
+		}
+		response.Error(c, http.StatusTooManyRequests, errors.ErrRateLimited)
+		c.Abort()
+		return false
+	}
+	return true
+}
+
 func isPublicListPath(path string) bool {
 	return path == "/api/v1/jobs" || path == "/api/v1/startups"
 }
@@ -172,4 +165,3 @@ func isPublicDetailPath(path string) bool {
 	return strings.HasPrefix(path, "/api/v1/jobs/") ||
 		strings.HasPrefix(path, "/api/v1/startups/slug/")
 }
-
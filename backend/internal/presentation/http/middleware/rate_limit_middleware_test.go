@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/domain/entity"
+	"github.com/startup-job-board/backend/internal/infrastructure/ratelimit"
+	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+func settings(t *testing.T) middleware.RateLimitSettings {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return middleware.RateLimitSettings{
+		Enabled: true, Window: time.Minute, DefaultLimit: 2, ListLimit: 1,
+		TokenLimit: 1, TokenProLimit: 3, Store: ratelimit.NewMemoryStore(ctx),
+	}
+}
+
+func do(r *gin.Engine, path string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = "203.0.113.7:1234"
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimitKeysUsersApartFromTheirIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if u := c.GetHeader("X-Test-User"); u != "" {
+			c.Set(middleware.UserIDKey, u)
+		}
+	}, middleware.RateLimitMiddleware(settings(t)))
+	r.GET("/api/v1/jobs", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	if rec := do(r, "/api/v1/jobs"); rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Fatalf("first anonymous: %d remaining %q", rec.Code, rec.Header().Get("RateLimit-Remaining"))
+	}
+	rec := do(r, "/api/v1/jobs")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") == "" || rec.Header().Get("RateLimit-Reset") == "" {
+		t.Fatalf("second anonymous: %d headers %v", rec.Code, rec.Header())
+	}
+
+	// Same IP, but each signed-in user has a budget of their own.
+	for _, user := range []string{"u1", "u1", "u2"} {
+		if rec := do(r, "/api/v1/jobs", "X-Test-User", user); rec.Code != http.StatusOK {
+			t.Fatalf("user %s: got %d", user, rec.Code)
+		}
+	}
+	if rec := do(r, "/api/v1/jobs", "X-Test-User", "u1"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("u1 over limit: got %d", rec.Code)
+	}
+}
+
+func TestAPITokenRateLimitFollowsPlan(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	// The plan comes with the token lookup; no startup is loaded per request.
+	tokens := scopedTokens{byHash: map[string]*entity.StartupAPIToken{
+		utils.HashToken("sb_free"):   {ID: "t-free", StartupID: "free", StartupPlan: string(entity.StartupPlanFree)},
+		utils.HashToken("sb_free_2"): {ID: "t-free-2", StartupID: "free", StartupPlan: string(entity.StartupPlanFree)},
+		utils.HashToken("sb_pro"):    {ID: "t-pro", StartupID: "pro", StartupPlan: string(entity.StartupPlanPro)},
+	}}
+	r := gin.New()
+	r.Use(middleware.APITokenMiddleware(tokens), middleware.APITokenRateLimitMiddleware(settings(t)))
+	r.GET("/api/v1/token/jobs", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_free"); rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Limit") != "1" {
+		t.Fatalf("free: %d limit %q", rec.Code, rec.Header().Get("RateLimit-Limit"))
+	}
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_free"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("free over quota: got %d", rec.Code)
+	}
+
+	// A second token of the same startup is counted separately.
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_free_2"); rec.Code != http.StatusOK {
+		t.Fatalf("second token: got %d", rec.Code)
+	}
+
+	for i := 0; i < 3; i++ {
+		if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_pro"); rec.Code != http.StatusOK {
+			t.Fatalf("pro request %d: got %d", i, rec.Code)
+		}
+	}
+	if rec := do(r, "/api/v1/token/jobs", "Authorization", "Bearer sb_pro"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("pro over quota: got %d", rec.Code)
+	}
+}
@@ -0,0 +1,72 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+// Middleware validates JSON request bodies and responses against the
+// document. Requests that don't match get a 400. Responses that don't match
+// are logged, and with strict set replaced by a 500 so tests fail loudly.
+// It buffers every response, so it is meant for development and test only.
+func (s *Spec) Middleware(strict bool, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op := s.ops[c.Request.Method+" "+c.FullPath()]
+		if op == nil || op.raw {
+			c.Next()
+			return
+		}
+
+		if op.request != nil && c.Request.Body != nil {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				response.BadRequest(c, "failed to read request body")
+				c.Abort()
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+			if problems := s.Check(op.request, body); len(problems) > 0 {
+				response.BadRequest(c, "request does not match the API schema: "+strings.Join(problems, "; "))
+				c.Abort()
+				return
+			}
+		}
+
+		// Hold the response back until it has been validated.
+		w := response.NewBufferedWriter(c.Writer)
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		status, body := w.Status(), w.Body()
+		if schema := op.responseSchema(status, s); schema != nil && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+			if problems := s.Check(schema, body); len(problems) > 0 {
+				log.WithContext(c.Request.Context()).Error("Response to %s %s does not match the API schema: %s", c.Request.Method, c.FullPath(), strings.Join(problems, "; "))
+				if strict {
+					status = http.StatusInternalServerError
+					body, _ = json.Marshal(response.ErrorResponse{Error: "response does not match the API schema: " + strings.Join(problems, "; ")})
+				}
+			}
+		}
+		c.Writer.WriteHeader(status)
+		c.Writer.WriteHeaderNow()
+		_, _ = c.Writer.Write(body)
+	}
+}
+
+func (op *compiledOp) responseSchema(status int, s *Spec) *Schema {
+	switch {
+	case status >= 400:
+		return s.errorSchema
+	case status >= 200 && status != http.StatusNoContent && status != http.StatusNotModified:
+		return op.response
+	}
+	return nil
+}
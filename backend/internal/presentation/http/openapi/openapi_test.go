@@ -0,0 +1,112 @@
+package openapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/openapi"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+type createThing struct {
+	Name  string   `json:"name" validate:"required,min=2,max=5"`
+	Kind  string   `json:"kind" validate:"omitempty,oneof=a b"`
+	Count *int     `json:"count" validate:"omitempty,min=1"`
+	Tags  []string `json:"tags" validate:"omitempty,dive,oneof=x y"`
+	Email *string  `json:"email" validate:"omitempty,email"`
+}
+
+type thing struct {
+	ID   string `json:"id"`
+	Size int    `json:"size"`
+}
+
+type recordingLogger struct{ errors []string }
+
+func (l *recordingLogger) Debug(string, ...interface{}) {}
+func (l *recordingLogger) Info(string, ...interface{})  {}
+func (l *recordingLogger) Warn(string, ...interface{})  {}
+func (l *recordingLogger) Error(msg string, _ ...interface{}) {
+	l.errors = append(l.errors, msg)
+}
+func (l *recordingLogger) With(...interface{}) logger.Logger         { return l }
+func (l *recordingLogger) WithContext(context.Context) logger.Logger { return l }
+
+var _ logger.Logger = (*recordingLogger)(nil)
+
+func newEngine(t *testing.T, strict bool, log logger.Logger, reply any) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	spec := openapi.New(openapi.Info{Title: "test", Version: "1"}, map[string]openapi.Route{
+		"POST /things": {Summary: "create", Request: createThing{}, Response: thing{}},
+	})
+	r.Use(spec.Middleware(strict, log))
+	r.POST("/things", func(c *gin.Context) { response.Success(c, reply) })
+	if err := spec.Build(r.Routes()); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func post(r http.Handler, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/things", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddlewareValidatesRequests(t *testing.T) {
+	r := newEngine(t, true, &recordingLogger{}, thing{ID: "1", Size: 2})
+
+	valid := []string{
+		`{"name":"ab"}`,
+		`{"name":"abcde","kind":"","count":null,"tags":["x","y"],"email":"a@b.co"}`,
+		`{"name":"ab","unknown":1}`,
+	}
+	for _, body := range valid {
+		if rec := post(r, body); rec.Code != http.StatusOK {
+			t.Errorf("%s: got %d %s", body, rec.Code, rec.Body.String())
+		}
+	}
+
+	invalid := map[string]string{
+		`{}`:                           "$.name: is required",
+		`{"name":"a"}`:                 "at least 2 characters",
+		`{"name":"abcdef"}`:            "at most 5 characters",
+		`{"name":"ab","kind":"c"}`:     "must be one of",
+		`{"name":"ab","count":0}`:      "at least 1",
+		`{"name":"ab","count":1.5}`:    "must be an integer",
+		`{"name":"ab","tags":["z"]}`:   "$.tags[0]",
+		`{"name":"ab","email":"nope"}`: "email address",
+		`{"name":7}`:                   "must be a string",
+		`not json`:                     "not valid JSON",
+	}
+	for body, want := range invalid {
+		rec := post(r, body)
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("%s: got %d %s, want 400 mentioning %q", body, rec.Code, rec.Body.String(), want)
+		}
+	}
+}
+
+func TestMiddlewareChecksResponses(t *testing.T) {
+	bad := map[string]any{"id": 5, "size": "big"}
+
+	log := &recordingLogger{}
+	rec := post(newEngine(t, false, log, bad), `{"name":"ab"}`)
+	if rec.Code != http.StatusOK || len(log.errors) != 1 {
+		t.Fatalf("lenient: got %d, %d logged errors", rec.Code, len(log.errors))
+	}
+
+	rec = post(newEngine(t, true, &recordingLogger{}, bad), `{"name":"ab"}`)
+	if rec.Code != http.StatusInternalServerError || !strings.Contains(rec.Body.String(), "$.data.size") {
+		t.Fatalf("strict: got %d %s", rec.Code, rec.Body.String())
+	}
+}
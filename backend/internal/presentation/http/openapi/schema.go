@@ -0,0 +1,288 @@
+// Package openapi builds an OpenAPI 3.1 document from the Gin routes and the
+// request/response DTOs, and validates traffic against it.
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is the subset of JSON Schema (2020-12, as used by OpenAPI 3.1) that
+// the generator emits and the validator understands.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"-"`
+	Nullable             bool               `json:"-"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+// MarshalJSON writes Type, plus "null" when Nullable, as 3.1 expects.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type plain Schema
+	out := struct {
+		Type any `json:"type,omitempty"`
+		*plain
+	}{plain: (*plain)(s)}
+	switch {
+	case s.Type != "" && s.Nullable:
+		out.Type = []string{s.Type, "null"}
+	case s.Type != "":
+		out.Type = s.Type
+	}
+	return json.Marshal(out)
+}
+
+// Fields describes an ad-hoc object, such as a gin.H response, by example:
+// each value's Go type becomes the property's schema.
+type Fields map[string]any
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	fieldsType    = reflect.TypeOf(Fields{})
+	fileFieldType = reflect.TypeOf(FileField{})
+)
+
+// generator turns Go types into schemas, collecting named structs as
+// reusable components.
+type generator struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newGenerator() *generator {
+	return &generator{components: map[string]*Schema{}, names: map[reflect.Type]string{}}
+}
+
+// schemaOf returns the schema for an example value (nil means any).
+func (g *generator) schemaOf(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	if f, ok := v.(Fields); ok {
+		return g.fields(f)
+	}
+	return g.schema(reflect.TypeOf(v))
+}
+
+func (g *generator) fields(f Fields) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for name, v := range f {
+		s.Properties[name] = g.schemaOf(v)
+		s.Required = append(s.Required, name)
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+func (g *generator) schema(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Pointer {
+		inner := g.schema(t.Elem())
+		if inner.Ref != "" {
+			return &Schema{AnyOf: []*Schema{inner, {Type: "null"}}}
+		}
+		inner.Nullable = inner.Type != ""
+		return inner
+	}
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if t == fieldsType {
+		return &Schema{Type: "object"}
+	}
+	if t == fileFieldType {
+		return &Schema{Type: "string", Format: "binary"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		// encoding/json writes a nil slice as null.
+		return &Schema{Type: "array", Items: g.schema(t.Elem()), Nullable: t.Kind() == reflect.Slice}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + g.component(t)}
+	}
+	return &Schema{} // interface{} and anything else: any value
+}
+
+func (g *generator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if _, taken := g.components[name]; taken {
+		pkg := t.PkgPath()
+		name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+	}
+	g.names[t] = name
+	g.components[name] = &Schema{} // placeholder for recursive types
+	*g.components[name] = *g.object(t)
+	return name
+}
+
+func (g *generator) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.addFields(s, t)
+	sort.Strings(s.Required)
+	return s
+}
+
+func (g *generator) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			g.addFields(s, f.Type)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		prop := g.schema(f.Type)
+		if applyRules(prop, f.Type, f.Tag.Get("validate")) {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = prop
+	}
+}
+
+// applyRules maps go-playground/validator tags onto the schema and reports
+// whether the field is required. Rules after "dive" apply to items.
+func applyRules(s *Schema, t reflect.Type, tag string) (required bool) {
+	if tag == "" || tag == "-" {
+		return false
+	}
+	rules := strings.Split(tag, ",")
+	for i, r := range rules {
+		if r == "dive" {
+			if s.Items != nil {
+				applyRules(s.Items, t.Elem(), strings.Join(rules[i+1:], ","))
+			}
+			rules = rules[:i]
+			break
+		}
+	}
+	omitEmpty := false
+	for _, r := range rules {
+		if r == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	// go-playground skips every rule for a zero value under omitempty, so
+	// an empty string must stay valid. Pointers are skipped only when nil.
+	emptyAllowed := omitEmpty && t.Kind() == reflect.String
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for _, r := range rules {
+		name, param, _ := strings.Cut(r, "=")
+		switch name {
+		case "required":
+			required = true
+		case "email":
+			if !emptyAllowed {
+				s.Format = "email"
+			}
+		case "url":
+			if !emptyAllowed {
+				s.Format = "uri"
+			}
+		case "uuid", "uuid4":
+			if !emptyAllowed {
+				s.Format = "uuid"
+			}
+		case "oneof":
+			for _, v := range strings.Fields(param) {
+				s.Enum = append(s.Enum, enumValue(t, v))
+			}
+			if emptyAllowed {
+				s.Enum = append(s.Enum, "")
+			}
+		case "min", "gte":
+			setBound(s, t, param, true, emptyAllowed)
+		case "max", "lte":
+			setBound(s, t, param, false, emptyAllowed)
+		case "len":
+			setBound(s, t, param, true, emptyAllowed)
+			setBound(s, t, param, false, emptyAllowed)
+		}
+	}
+	return required
+}
+
+func setBound(s *Schema, t reflect.Type, param string, lower, emptyAllowed bool) {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		i := int(n)
+		if t.Kind() == reflect.String {
+			if lower && !emptyAllowed {
+				s.MinLength = &i
+			} else if !lower {
+				s.MaxLength = &i
+			}
+		} else if lower {
+			s.MinItems = &i
+		} else {
+			s.MaxItems = &i
+		}
+	default:
+		if lower {
+			s.Minimum = &n
+		} else {
+			s.Maximum = &n
+		}
+	}
+}
+
+func enumValue(t reflect.Type, v string) any {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	}
+	return v
+}
@@ -0,0 +1,252 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/response"
+	"github.com/startup-job-board/backend/pkg/utils"
+)
+
+// Route documents one endpoint. Request and Response are example values
+// (usually zero DTOs) whose types are reflected into schemas; Response is
+// what the handler passes to response.Success.
+type Route struct {
+	Summary   string
+	Public    bool // no Authorization header needed
+	Request   any
+	Response  any
+	Query     []Param
+	Paginated bool // response.SuccessWithMeta
+	Status    int  // success status when not 200, e.g. response.Accepted
+	// Raw routes don't answer with the JSON envelope (redirects, provider
+	// webhooks, file downloads) and are not validated.
+	Raw bool
+}
+
+type Param struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// QueryParam documents an optional query parameter typed like example.
+func QueryParam(name string, example any) Param {
+	return Param{Name: name, In: "query", Schema: newGenerator().schemaOf(example)}
+}
+
+// Multipart marks a form upload request; values describe the fields, with
+// FileField for file parts.
+type Multipart Fields
+
+type FileField struct{}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Param               `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// compiledOp is what the middleware needs for one route.
+type compiledOp struct {
+	raw      bool
+	request  *Schema // nil unless the body is JSON
+	response *Schema
+}
+
+// Spec owns the document for one router.
+type Spec struct {
+	info        Info
+	routes      map[string]Route
+	doc         *Document
+	raw         []byte
+	ops         map[string]*compiledOp
+	errorSchema *Schema
+}
+
+// New prepares a spec; routes is keyed by "METHOD /gin/path/:param".
+func New(info Info, routes map[string]Route) *Spec {
+	return &Spec{info: info, routes: routes, ops: map[string]*compiledOp{}}
+}
+
+// Build generates the document from the registered routes. Call it once,
+// after every route is added and before serving.
+func (s *Spec) Build(registered gin.RoutesInfo) error {
+	g := newGenerator()
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info:    s.info,
+		Paths:   map[string]map[string]*Operation{},
+		Components: Components{
+			Schemas: g.components,
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT", Description: "User access token from /auth/login."},
+				"apiToken":   {Type: "http", Scheme: "bearer", Description: "Startup API token, for /token routes only."},
+			},
+		},
+	}
+	errorRef := g.schema(reflect.TypeOf(response.ErrorResponse{}))
+
+	sort.Slice(registered, func(i, j int) bool {
+		return registered[i].Path+registered[i].Method < registered[j].Path+registered[j].Method
+	})
+	for _, ri := range registered {
+		key := ri.Method + " " + ri.Path
+		route := s.routes[key]
+		path, params := openAPIPath(ri.Path)
+		op := &Operation{
+			Summary:    route.Summary,
+			Tags:       []string{tagFor(ri.Path)},
+			Parameters: append(params, route.Query...),
+			Responses:  map[string]*Response{},
+			Security:   []map[string][]string{},
+		}
+		switch {
+		case strings.HasPrefix(ri.Path, "/api/v1/token/"):
+			op.Security = append(op.Security, map[string][]string{"apiToken": {}})
+		case !route.Public:
+			op.Security = append(op.Security, map[string][]string{"bearerAuth": {}})
+		}
+
+		compiled := &compiledOp{raw: route.Raw}
+		switch req := route.Request.(type) {
+		case nil:
+		case Multipart:
+			op.RequestBody = &RequestBody{Required: true, Content: map[string]*MediaType{
+				"multipart/form-data": {Schema: g.fields(Fields(req))},
+			}}
+		default:
+			compiled.request = g.schemaOf(req)
+			op.RequestBody = &RequestBody{Required: true, Content: map[string]*MediaType{
+				"application/json": {Schema: compiled.request},
+			}}
+		}
+
+		if route.Raw {
+			op.Responses["200"] = &Response{Description: "Not a JSON envelope; see the summary."}
+		} else {
+			compiled.response = envelope(g, g.schemaOf(route.Response), route.Paginated)
+			status := http.StatusOK
+			if route.Status != 0 {
+				status = route.Status
+			}
+			op.Responses[strconv.Itoa(status)] = &Response{Description: "Success", Content: map[string]*MediaType{
+				"application/json": {Schema: compiled.response},
+			}}
+			op.Responses["default"] = &Response{Description: "Error", Content: map[string]*MediaType{
+				"application/json": {Schema: errorRef},
+			}}
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]*Operation{}
+		}
+		doc.Paths[path][strings.ToLower(ri.Method)] = op
+		s.ops[key] = compiled
+	}
+
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode OpenAPI document: %w", err)
+	}
+	s.doc, s.raw, s.errorSchema = doc, raw, errorRef
+	return nil
+}
+
+// Document returns the built document, or nil before Build.
+func (s *Spec) Document() *Document {
+	return s.doc
+}
+
+// Serve writes the document as JSON.
+func (s *Spec) Serve(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", s.raw)
+}
+
+// envelope wraps data the way response.Success and SuccessWithMeta do.
+func envelope(g *generator, data *Schema, paginated bool) *Schema {
+	s := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"success": {Type: "boolean", Enum: []any{true}},
+			"data":    data,
+		},
+		Required: []string{"data", "success"},
+	}
+	if paginated {
+		s.Properties["meta"] = g.schema(reflect.TypeOf(utils.PaginationMeta{}))
+		s.Required = append(s.Required, "meta")
+	}
+	return s
+}
+
+// openAPIPath turns /teams/:id/files/*key into /teams/{id}/files/{key} and
+// lists the path parameters.
+func openAPIPath(ginPath string) (string, []Param) {
+	segments := strings.Split(ginPath, "/")
+	var params []Param
+	for i, seg := range segments {
+		if seg != "" && (seg[0] == ':' || seg[0] == '*') {
+			name := seg[1:]
+			segments[i] = "{" + name + "}"
+			params = append(params, Param{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// tagFor groups operations by the first path segment after /api/v1.
+func tagFor(ginPath string) string {
+	rest := strings.TrimPrefix(strings.TrimPrefix(ginPath, "/api/v1"), "/")
+	tag, _, _ := strings.Cut(rest, "/")
+	return tag
+}
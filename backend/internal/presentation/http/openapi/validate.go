@@ -0,0 +1,186 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxProblems caps how many mismatches one check reports.
+const maxProblems = 10
+
+// Check validates a JSON document against schema and returns the problems
+// found, each prefixed with its JSON path. An empty result means it matches.
+func (s *Spec) Check(schema *Schema, body []byte) []string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return []string{"body is not valid JSON"}
+	}
+	c := checker{schemas: s.doc.Components.Schemas}
+	c.check(schema, v, "$")
+	return c.problems
+}
+
+type checker struct {
+	schemas  map[string]*Schema
+	problems []string
+}
+
+func (c *checker) fail(path, format string, args ...any) {
+	if len(c.problems) < maxProblems {
+		c.problems = append(c.problems, path+": "+fmt.Sprintf(format, args...))
+	}
+}
+
+func (c *checker) check(s *Schema, v any, path string) {
+	if s.Ref != "" {
+		s = c.schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+	}
+	if len(s.AnyOf) > 0 {
+		for _, alt := range s.AnyOf {
+			sub := checker{schemas: c.schemas}
+			if sub.check(alt, v, path); len(sub.problems) == 0 {
+				return
+			}
+		}
+		c.fail(path, "matches none of the allowed schemas")
+		return
+	}
+	if s.Type == "" {
+		return // any value
+	}
+	if v == nil {
+		if !s.Nullable {
+			c.fail(path, "must not be null")
+		}
+		return
+	}
+
+	switch s.Type {
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			c.fail(path, "must be a string")
+			return
+		}
+		n := utf8.RuneCountInString(str)
+		if s.MinLength != nil && n < *s.MinLength {
+			c.fail(path, "must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			c.fail(path, "must be at most %d characters", *s.MaxLength)
+		}
+		if msg := checkFormat(s.Format, str); msg != "" {
+			c.fail(path, "%s", msg)
+		}
+	case "integer", "number":
+		num, ok := v.(json.Number)
+		f, err := num.Float64()
+		if !ok || err != nil || (s.Type == "integer" && f != math.Trunc(f)) {
+			c.fail(path, "must be %s", map[string]string{"integer": "an integer", "number": "a number"}[s.Type])
+			return
+		}
+		if s.Minimum != nil && f < *s.Minimum {
+			c.fail(path, "must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && f > *s.Maximum {
+			c.fail(path, "must be at most %v", *s.Maximum)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			c.fail(path, "must be a boolean")
+			return
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			c.fail(path, "must be an array")
+			return
+		}
+		if s.MinItems != nil && len(items) < *s.MinItems {
+			c.fail(path, "must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(items) > *s.MaxItems {
+			c.fail(path, "must have at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range items {
+				c.check(s.Items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			c.fail(path, "must be an object")
+			return
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				c.fail(path+"."+name, "is required")
+			}
+		}
+		for name, value := range obj {
+			if prop, ok := s.Properties[name]; ok {
+				c.check(prop, value, path+"."+name)
+			} else if s.AdditionalProperties != nil {
+				c.check(s.AdditionalProperties, value, path+"."+name)
+			}
+		}
+	}
+
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		c.fail(path, "must be one of %v", s.Enum)
+	}
+}
+
+func inEnum(enum []any, v any) bool {
+	for _, e := range enum {
+		switch ev := e.(type) {
+		case string:
+			if s, ok := v.(string); ok && s == ev {
+				return true
+			}
+		case bool:
+			if b, ok := v.(bool); ok && b == ev {
+				return true
+			}
+		case int64:
+			if n, ok := v.(json.Number); ok && n.String() == fmt.Sprint(ev) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func checkFormat(format, s string) string {
+	switch format {
+	case "email":
+		if _, err := mail.ParseAddress(s); err != nil {
+			return "must be an email address"
+		}
+	case "uri":
+		if u, err := url.Parse(s); err != nil || u.Scheme == "" {
+			return "must be an absolute URL"
+		}
+	case "uuid":
+		if _, err := uuid.Parse(s); err != nil {
+			return "must be a UUID"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return "must be an RFC 3339 timestamp"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package response
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BufferedWriter holds a response back so a middleware can inspect it before
+// anything reaches the client. Swap it in for c.Writer around c.Next, then
+// restore ResponseWriter and write out Status and Body.
+type BufferedWriter struct {
+	gin.ResponseWriter
+	buf    bytes.Buffer
+	status int
+	wrote  bool
+}
+
+func NewBufferedWriter(w gin.ResponseWriter) *BufferedWriter {
+	return &BufferedWriter{ResponseWriter: w, status: http.StatusOK}
+}
+
+// Body is everything the handler wrote so far.
+func (w *BufferedWriter) Body() []byte { return w.buf.Bytes() }
+
+func (w *BufferedWriter) WriteHeader(code int) {
+	w.status, w.wrote = code, true
+}
+
+func (w *BufferedWriter) WriteHeaderNow() {
+	w.wrote = true
+}
+
+func (w *BufferedWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	return w.buf.Write(b)
+}
+
+func (w *BufferedWriter) WriteString(s string) (int, error) {
+	w.wrote = true
+	return w.buf.WriteString(s)
+}
+
+func (w *BufferedWriter) Status() int   { return w.status }
+func (w *BufferedWriter) Size() int     { return w.buf.Len() }
+func (w *BufferedWriter) Written() bool { return w.wrote }
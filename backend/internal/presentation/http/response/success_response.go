@@ -18,6 +18,14 @@ func Success(c *gin.Context, data interface{}) {
 	})
 }
 
+// Accepted answers 202 for work that continues after the response.
+func Accepted(c *gin.Context, data interface{}) {
+	c.JSON(202, SuccessResponse{
+		Success: true,
+		Data:    data,
+	})
+}
+
 func SuccessWithMeta(c *gin.Context, data interface{}, meta *utils.PaginationMeta) {
 	c.JSON(200, SuccessResponse{
 		Success: true,
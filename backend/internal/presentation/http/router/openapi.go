@@ -0,0 +1,160 @@
+package router
+
+import (
+	"github.com/startup-job-board/backend/internal/application/dto"
+	"github.com/startup-job-board/backend/internal/presentation/http/openapi"
+)
+
+var (
+	pageParams = []openapi.Param{openapi.QueryParam("page", 0), openapi.QueryParam("page_size", 0)}
+	sortParams = []openapi.Param{openapi.QueryParam("order_by", ""), openapi.QueryParam("order_dir", "")}
+)
+
+func withParams(groups ...[]openapi.Param) []openapi.Param {
+	var out []openapi.Param
+	for _, g := range groups {
+		out = append(out, g...)
+	}
+	return out
+}
+
+func queryParams(names ...string) []openapi.Param {
+	out := make([]openapi.Param, len(names))
+	for i, n := range names {
+		out[i] = openapi.QueryParam(n, "")
+	}
+	return out
+}
+
+// apiRoutes documents every route in NewRouter for the OpenAPI document,
+// keyed by method and Gin path. Update it with the routes:
+// TestOpenAPIDocumentsEveryRoute fails on a route without an entry.
+var apiRoutes = map[string]openapi.Route{
+	"GET /api/v1/health":       {Summary: "Liveness check; answers {\"status\":\"ok\"}", Public: true, Raw: true},
+	"GET /livez":               {Summary: "Liveness probe; answers 200 while the process serves HTTP", Public: true, Raw: true},
+	"GET /readyz":              {Summary: "Readiness probe; per-component status, 503 when a critical check fails or during shutdown", Public: true, Raw: true},
+	"GET /metrics":             {Summary: "Prometheus metrics; bearer METRICS_TOKEN when configured", Public: true, Raw: true},
+	"GET /api/v1/openapi.json": {Summary: "This OpenAPI document", Public: true, Raw: true},
+	"GET /api/v1/files/*key":   {Summary: "Download a locally stored file; private keys need a signed link", Public: true, Raw: true},
+	"HEAD /api/v1/files/*key":  {Summary: "File metadata for a locally stored file", Public: true, Raw: true},
+
+	// Auth
+	"POST /api/v1/auth/register":                {Summary: "Create an account", Public: true, Request: dto.RegisterInput{}, Response: dto.AuthOutput{}},
+	"POST /api/v1/auth/login":                   {Summary: "Log in with email and password", Public: true, Request: dto.LoginInput{}, Response: dto.AuthOutput{}},
+	"POST /api/v1/auth/refresh":                 {Summary: "Exchange a refresh token for new tokens", Public: true, Request: dto.RefreshTokenInput{}, Response: dto.AuthOutput{}},
+	"GET /api/v1/auth/oauth/:provider":          {Summary: "Redirect to the OAuth provider", Public: true, Raw: true},
+	"GET /api/v1/auth/oauth/:provider/callback": {Summary: "OAuth callback; redirects to the frontend with a one-time code", Public: true, Raw: true, Query: queryParams("code", "state")},
+	"POST /api/v1/auth/oauth/exchange":          {Summary: "Exchange the one-time OAuth code for tokens", Public: true, Request: dto.ExchangeOAuthCodeInput{}, Response: dto.AuthOutput{}},
+	"POST /api/v1/auth/verify-email":            {Summary: "Verify an email address with the emailed token", Public: true, Request: dto.VerifyEmailInput{}, Response: dto.UserOutput{}},
+	"POST /api/v1/auth/verify-email/resend":     {Summary: "Send another verification email (throttled)", Response: openapi.Fields{"message": ""}},
+	"POST /api/v1/auth/password/forgot":         {Summary: "Email a password reset link; always 202", Public: true, Status: 202, Request: dto.ForgotPasswordInput{}, Response: openapi.Fields{"message": ""}},
+	"POST /api/v1/auth/password/reset":          {Summary: "Set a new password with a reset token; revokes refresh tokens", Public: true, Request: dto.ResetPasswordInput{}, Response: openapi.Fields{"message": ""}},
+	"POST /api/v1/auth/logout":                  {Summary: "Revoke the refresh token", Response: openapi.Fields{"message": ""}},
+	"GET /api/v1/me":                            {Summary: "Current user with team memberships", Response: dto.MeOutput{}},
+	"PUT /api/v1/me/password":                   {Summary: "Change password; revokes other sessions' refresh tokens and returns new tokens", Request: dto.ChangePasswordInput{}, Response: dto.AuthOutput{}},
+
+	// Startups
+	"GET /api/v1/startups": {
+		Summary: "List startups", Public: true, Paginated: true, Response: []dto.StartupOutput{},
+		Query: withParams(queryParams("industry", "status", "search", "location", "company_size"),
+			[]openapi.Param{openapi.QueryParam("verified", false)}, pageParams, sortParams),
+	},
+	"GET /api/v1/startups/slug/:slug":              {Summary: "Get a startup by slug; 301 with the same body for a retired slug", Public: true, Response: dto.StartupOutput{}},
+	"POST /api/v1/startups":                        {Summary: "Create a startup with a new team", Request: dto.CreateStartupInput{}, Response: dto.StartupOutput{}},
+	"PUT /api/v1/startups/:id":                     {Summary: "Update a startup", Request: dto.UpdateStartupInput{}, Response: dto.StartupOutput{}},
+	"GET /api/v1/startups/:id":                     {Summary: "Get a startup", Response: dto.StartupOutput{}},
+	"GET /api/v1/startups/:id/verification":        {Summary: "Domain verification status", Response: dto.DomainVerificationOutput{}},
+	"POST /api/v1/startups/:id/verification":       {Summary: "Start domain verification", Request: dto.StartDomainVerificationInput{}, Response: dto.DomainVerificationOutput{}},
+	"POST /api/v1/startups/:id/verification/check": {Summary: "Check a domain verification proof", Request: dto.CheckDomainVerificationInput{}, Response: dto.DomainVerificationOutput{}},
+	"POST /api/v1/startups/:id/follow":             {Summary: "Follow a startup", Response: openapi.Fields{"following": true}},
+	"DELETE /api/v1/startups/:id/follow":           {Summary: "Unfollow a startup", Response: openapi.Fields{"following": false}},
+	"GET /api/v1/startups/:id/followers":           {Summary: "Follower count", Response: dto.FollowerCountOutput{}},
+	"GET /api/v1/me/follows":                       {Summary: "Startups the caller follows", Query: pageParams, Response: openapi.Fields{"follows": []dto.FollowOutput{}, "total": int64(0), "page": 0, "page_size": 0}},
+	"POST /api/v1/follows/unsubscribe":             {Summary: "Unfollow all startups from a digest link", Public: true, Request: dto.UnsubscribeFollowsInput{}, Response: dto.UnsubscribeFollowsOutput{}},
+
+	// Claims
+	"POST /api/v1/startups/:id/claims": {Summary: "Claim an orphan startup", Request: dto.CreateStartupClaimInput{}, Response: dto.StartupClaimOutput{}},
+	"GET /api/v1/me/claims":            {Summary: "The caller's claims", Response: openapi.Fields{"claims": []dto.StartupClaimOutput{}}},
+	"GET /api/v1/claims/:id":           {Summary: "Get a claim", Response: dto.StartupClaimOutput{}},
+	"POST /api/v1/claims/:id/verify":   {Summary: "Verify a claim with its code or proof", Request: dto.VerifyStartupClaimInput{}, Response: dto.StartupClaimOutput{}},
+
+	// Jobs
+	"GET /api/v1/jobs": {
+		Summary: "List jobs", Public: true, Paginated: true, Response: []dto.JobOutput{},
+		Query: withParams(queryParams("startup_id", "job_type", "location_type", "status", "search", "country", "city", "currency"),
+			[]openapi.Param{openapi.QueryParam("salary_min", 0), openapi.QueryParam("salary_max", 0)}, pageParams, sortParams),
+	},
+	"GET /api/v1/jobs/:id":       {Summary: "Get a job", Public: true, Response: dto.JobOutput{}},
+	"POST /api/v1/jobs":          {Summary: "Create a job", Request: dto.CreateJobInput{}, Response: dto.JobOutput{}},
+	"PUT /api/v1/jobs/:id":       {Summary: "Update a job", Request: dto.UpdateJobInput{}, Response: dto.JobOutput{}},
+	"DELETE /api/v1/jobs/:id":    {Summary: "Delete a job", Response: openapi.Fields{"message": ""}},
+	"GET /api/v1/job-actions":    {Summary: "Preview a signed one-click job action", Public: true, Query: queryParams("token"), Response: dto.JobActionOutput{}},
+	"POST /api/v1/job-actions":   {Summary: "Apply a signed one-click job action", Public: true, Request: dto.JobActionInput{}, Response: dto.JobActionOutput{}},
+	"POST /api/v1/inbound/apply": {Summary: "Forward an application sent to a masked apply address", Public: true, Request: dto.InboundApplicationInput{}, Response: dto.InboundApplicationOutput{}},
+	"POST /api/v1/contact":       {Summary: "Send a contact message", Public: true, Request: dto.CreateContactInput{}, Response: dto.ContactOutput{}},
+
+	// Files
+	"POST /api/v1/upload":               {Summary: "Upload an image (max 2MB); it is re-encoded and resized", Request: openapi.Multipart{"file": openapi.FileField{}}, Response: dto.FileOutput{}},
+	"GET /api/v1/files":                 {Summary: "The caller's files, or with team_id those of the team's members", Query: withParams(queryParams("team_id"), pageParams), Response: openapi.Fields{"files": []dto.FileOutput{}, "total": int64(0), "page": 0, "page_size": 0}},
+	"DELETE /api/v1/files/:id":          {Summary: "Delete a file that nothing references", Response: openapi.Fields{"deleted": true}},
+	"POST /api/v1/uploads/presign":      {Summary: "Get a presigned form POST for uploading directly to storage", Request: dto.PresignUploadInput{}, Response: dto.PresignUploadOutput{}},
+	"POST /api/v1/uploads/:id/complete": {Summary: "Verify a direct upload and record the file", Response: dto.FileOutput{}},
+
+	// Billing
+	"POST /api/v1/billing/checkout": {Summary: "Start a Stripe checkout", Request: dto.CreateCheckoutInput{}, Response: dto.CheckoutOutput{}},
+	"GET /api/v1/billing/status":    {Summary: "Plans and boosts of the caller's startups", Response: dto.BillingStatusOutput{}},
+	"POST /api/v1/billing/webhook":  {Summary: "Stripe webhook; signed by Stripe", Public: true, Raw: true},
+
+	// Teams
+	"POST /api/v1/teams":                           {Summary: "Create a team", Request: dto.CreateTeamInput{}, Response: dto.TeamOutput{}},
+	"GET /api/v1/teams":                            {Summary: "The caller's teams", Response: []dto.TeamOutput{}},
+	"GET /api/v1/teams/:id":                        {Summary: "Get a team", Response: dto.TeamOutput{}},
+	"PATCH /api/v1/teams/:id":                      {Summary: "Rename a team", Request: dto.UpdateTeamInput{}, Response: dto.TeamOutput{}},
+	"GET /api/v1/teams/:id/members":                {Summary: "List team members", Response: []dto.TeamMemberOutput{}},
+	"POST /api/v1/teams/:id/invitations":           {Summary: "Invite someone by email", Request: dto.InviteTeamMemberInput{}, Response: openapi.Fields{"status": ""}},
+	"PATCH /api/v1/teams/:id/members/:userId":      {Summary: "Change a member's role or status", Request: dto.UpdateTeamMemberInput{}, Response: openapi.Fields{"status": ""}},
+	"DELETE /api/v1/teams/:id/members/:userId":     {Summary: "Remove a member", Response: openapi.Fields{"status": ""}},
+	"GET /api/v1/teams/:id/roles":                  {Summary: "Roles available to the team", Response: []dto.RoleOutput{}},
+	"GET /api/v1/teams/:id/startups":               {Summary: "Startups owned by the team", Response: []dto.StartupOutput{}},
+	"POST /api/v1/teams/:id/startups/:startupId":   {Summary: "Link a startup to the team", Response: openapi.Fields{"status": ""}},
+	"DELETE /api/v1/teams/:id/startups/:startupId": {Summary: "Unlink a startup from the team", Response: openapi.Fields{"status": ""}},
+	"POST /api/v1/invitations/accept":              {Summary: "Accept a team invitation", Request: dto.AcceptTeamInvitationInput{}, Response: openapi.Fields{"status": ""}},
+
+	"GET /api/v1/teams/:id/startups/:startupId/tokens":                  {Summary: "List a startup's API tokens", Response: []dto.APITokenOutput{}},
+	"POST /api/v1/teams/:id/startups/:startupId/tokens":                 {Summary: "Create an API token; the secret is shown once", Request: dto.CreateAPITokenInput{}, Response: dto.APITokenOutput{}},
+	"POST /api/v1/teams/:id/startups/:startupId/tokens/:tokenId/rotate": {Summary: "Rotate an API token", Response: dto.APITokenOutput{}},
+	"DELETE /api/v1/teams/:id/startups/:startupId/tokens/:tokenId":      {Summary: "Revoke an API token", Response: openapi.Fields{"revoked": true}},
+
+	"GET /api/v1/teams/:id/webhooks":                                              {Summary: "List webhook endpoints", Response: []dto.WebhookEndpointOutput{}},
+	"POST /api/v1/teams/:id/webhooks":                                             {Summary: "Create a webhook endpoint; the secret is shown once", Request: dto.CreateWebhookEndpointInput{}, Response: dto.WebhookEndpointOutput{}},
+	"PATCH /api/v1/teams/:id/webhooks/:webhookId":                                 {Summary: "Update a webhook endpoint", Request: dto.UpdateWebhookEndpointInput{}, Response: dto.WebhookEndpointOutput{}},
+	"DELETE /api/v1/teams/:id/webhooks/:webhookId":                                {Summary: "Delete a webhook endpoint", Response: openapi.Fields{"deleted": true}},
+	"GET /api/v1/teams/:id/webhooks/:webhookId/deliveries":                        {Summary: "Recent deliveries of an endpoint", Response: []dto.WebhookDeliveryOutput{}},
+	"POST /api/v1/teams/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver": {Summary: "Queue a delivery again", Response: dto.WebhookDeliveryOutput{}},
+
+	// Platform admin
+	"GET /api/v1/admin/users":                          {Summary: "List users", Query: withParams(pageParams, queryParams("search")), Response: openapi.Fields{"users": []dto.UserOutput{}, "total": int64(0), "page": 0, "page_size": 0}},
+	"PATCH /api/v1/admin/users/:id":                    {Summary: "Change a user's role or status", Request: dto.AdminUpdateUserInput{}, Response: dto.UserOutput{}},
+	"GET /api/v1/admin/teams":                          {Summary: "List teams", Query: pageParams, Response: openapi.Fields{"teams": []dto.TeamOutput{}, "total": int64(0)}},
+	"POST /api/v1/admin/startups":                      {Summary: "Create an orphan startup", Request: dto.CreateStartupInput{}, Response: dto.StartupOutput{}},
+	"PUT /api/v1/admin/startups/:id/team":              {Summary: "Link or unlink a startup's team", Request: dto.AdminLinkStartupTeamInput{}, Response: openapi.Fields{"status": ""}},
+	"GET /api/v1/admin/claims":                         {Summary: "List startup claims", Query: withParams(queryParams("status"), pageParams), Response: openapi.Fields{"claims": []dto.StartupClaimOutput{}, "total": int64(0), "page": 0, "page_size": 0}},
+	"POST /api/v1/admin/claims/:id/approve":            {Summary: "Approve a claim", Response: dto.StartupClaimOutput{}},
+	"POST /api/v1/admin/claims/:id/reject":             {Summary: "Reject a claim", Request: dto.RejectStartupClaimInput{}, Response: dto.StartupClaimOutput{}},
+	"GET /api/v1/admin/files/stray":                    {Summary: "Stored objects with no file record", Response: dto.StrayObjectsOutput{}},
+	"GET /api/v1/admin/background-jobs":                {Summary: "List background jobs, newest first", Query: withParams(queryParams("queue", "kind", "status"), pageParams), Response: openapi.Fields{"jobs": []dto.BackgroundJobOutput{}, "total": int64(0), "page": 0, "page_size": 0}},
+	"POST /api/v1/admin/background-jobs/:id/retry":     {Summary: "Queue a dead or cancelled job again", Response: dto.BackgroundJobOutput{}},
+	"POST /api/v1/admin/background-jobs/:id/cancel":    {Summary: "Cancel a queued or running job", Response: dto.BackgroundJobOutput{}},
+	"GET /api/v1/admin/email-templates":                {Summary: "List email templates with their locales and sample data", Response: openapi.Fields{"templates": []dto.EmailTemplateOutput{}}},
+	"POST /api/v1/admin/email-templates/:name/preview": {Summary: "Render an email template without sending it; the body is optional", Request: dto.PreviewEmailInput{}, Response: dto.EmailPreviewOutput{}},
+
+	// Startup API token routes
+	"GET /api/v1/token/startup":     {Summary: "The startup the token belongs to; answers {\"startup_id\":...}", Raw: true},
+	"POST /api/v1/token/jobs":       {Summary: "Create a job for the token's startup", Request: dto.CreateJobInput{}, Response: dto.JobOutput{}},
+	"PUT /api/v1/token/jobs/:id":    {Summary: "Update a job of the token's startup", Request: dto.UpdateJobInput{}, Response: dto.JobOutput{}},
+	"DELETE /api/v1/token/jobs/:id": {Summary: "Delete a job of the token's startup", Response: openapi.Fields{"message": ""}},
+	"GET /api/v1/token/jobs": {
+		Summary: "List the token's startup's jobs", Paginated: true, Response: []dto.JobOutput{},
+		Query: withParams(queryParams("job_type", "location_type", "status", "search"), pageParams, sortParams),
+	},
+}
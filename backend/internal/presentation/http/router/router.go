@@ -1,38 +1,72 @@
 package router
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	nrgin "github.com/newrelic/go-agent/v3/integrations/nrgin"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/startup-job-board/backend/internal/application/port"
+	"github.com/startup-job-board/backend/internal/domain/entity"
 	"github.com/startup-job-board/backend/internal/domain/repository"
 	"github.com/startup-job-board/backend/internal/domain/service"
 	"github.com/startup-job-board/backend/internal/infrastructure/config"
+	"github.com/startup-job-board/backend/internal/infrastructure/ratelimit"
 	"github.com/startup-job-board/backend/internal/presentation/http/handler"
 	"github.com/startup-job-board/backend/internal/presentation/http/middleware"
+	"github.com/startup-job-board/backend/internal/presentation/http/openapi"
+	"github.com/startup-job-board/backend/pkg/logger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type RouterDeps struct {
-	AuthHandler    *handler.AuthHandler
-	StartupHandler *handler.StartupHandler
-	JobHandler     *handler.JobHandler
-	FileHandler    *handler.FileHandler
-	ContactHandler *handler.ContactHandler
-	BillingHandler *handler.BillingHandler
-	TeamHandler    *handler.TeamHandler
-	AdminHandler   *handler.AdminHandler
+	// Context ends with the server; it stops the fallback rate limit store.
+	Context              context.Context
+	AuthHandler          *handler.AuthHandler
+	StartupHandler       *handler.StartupHandler
+	JobHandler           *handler.JobHandler
+	FileHandler          *handler.FileHandler
+	UploadHandler        *handler.UploadHandler
+	ContactHandler       *handler.ContactHandler
+	BillingHandler       *handler.BillingHandler
+	TeamHandler          *handler.TeamHandler
+	AdminHandler         *handler.AdminHandler
+	InboundHandler       *handler.InboundMailHandler
+	JobActionHandler     *handler.JobActionHandler
+	VerificationHandler  *handler.DomainVerificationHandler
+	FollowHandler        *handler.FollowHandler
+	APITokenHandler      *handler.APITokenHandler
+	WebhookHandler       *handler.WebhookHandler
+	HealthHandler        *handler.HealthHandler
+	BackgroundJobHandler *handler.BackgroundJobHandler
+	EmailTemplateHandler *handler.EmailTemplateHandler
+	// LocalFiles serves uploads when the local storage backend is active.
+	LocalFiles     http.Handler
+	ClaimHandler   *handler.ClaimHandler
 	JWTService     port.JWTService
 	AuthService    *service.AuthorizationService
 	StartupRepo    repository.StartupRepository
+	APITokenRepo   repository.StartupAPITokenRepository
 	AllowedOrigins []string
 	RateLimit      config.RateLimitConfig
+	RateLimitStore port.RateLimitStore // nil uses a per-process memory store
+	ResponseCache  port.ResponseCache  // nil only adds ETags
 	InternalKey    string
 	TrustedProxies []string
 	NewRelicApp    *newrelic.Application
+	OpenAPI        config.OpenAPIConfig
+	Metrics        port.Metrics // nil disables request metrics and /metrics
+	ServiceName    string       // names the server spans' tracer
+	MetricsHandler http.Handler
+	MetricsToken   string
+	Logger         logger.Logger
 }
 
 func NewRouter(deps RouterDeps) *gin.Engine {
-	r := gin.Default()
+	// gin.Default's logger would duplicate LoggerMiddleware's structured entries.
+	r := gin.New()
+	r.Use(gin.Recovery())
 
 	// When TrustedProxies is empty, Gin does not trust X-Forwarded-For / X-Real-IP.
 	if len(deps.TrustedProxies) > 0 {
@@ -44,26 +78,58 @@ func NewRouter(deps RouterDeps) *gin.Engine {
 	if deps.NewRelicApp != nil {
 		r.Use(nrgin.Middleware(deps.NewRelicApp))
 	}
+	// Spans go to the global tracer provider, a no-op unless tracing is set up.
+	r.Use(otelgin.Middleware(deps.ServiceName, otelgin.WithGinFilter(func(c *gin.Context) bool {
+		return !isProbePath(c.FullPath())
+	})))
+	r.Use(middleware.LoggerMiddleware(deps.Logger))
+	if deps.Metrics != nil {
+		r.Use(middleware.MetricsMiddleware(deps.Metrics))
+	}
 	r.Use(middleware.SecurityHeadersMiddleware())
 	r.Use(middleware.CORSMiddleware(deps.AllowedOrigins))
-	r.Use(middleware.LoggerMiddleware())
 	r.Use(middleware.InternalKeyMiddleware(deps.InternalKey))
 	r.Use(middleware.OptionalAuthMiddleware(deps.JWTService))
-	r.Use(middleware.RateLimitMiddleware(middleware.RateLimitSettings{
-		Enabled:      deps.RateLimit.Enabled,
-		Window:       deps.RateLimit.Window,
-		DefaultLimit: deps.RateLimit.Requests,
-		ListLimit:    deps.RateLimit.ListLimit,
-		DetailLimit:  deps.RateLimit.DetailLimit,
-		TrustedLimit: deps.RateLimit.TrustedLimit,
-	}))
+	rateLimits := middleware.RateLimitSettings{
+		Enabled:       deps.RateLimit.Enabled,
+		Window:        deps.RateLimit.Window,
+		DefaultLimit:  deps.RateLimit.Requests,
+		ListLimit:     deps.RateLimit.ListLimit,
+		DetailLimit:   deps.RateLimit.DetailLimit,
+		TrustedLimit:  deps.RateLimit.TrustedLimit,
+		TokenLimit:    deps.RateLimit.TokenLimit,
+		TokenProLimit: deps.RateLimit.TokenProLimit,
+		Store:         deps.RateLimitStore,
+		Metrics:       deps.Metrics,
+		Logger:        deps.Logger,
+	}
+	if rateLimits.Enabled && rateLimits.Store == nil {
+		rateLimits.Store = ratelimit.NewMemoryStore(deps.Context)
+	}
+	r.Use(middleware.RateLimitMiddleware(rateLimits))
+
+	spec := openapi.New(openapi.Info{Title: "Startup Job Board API", Version: "1.0.0"}, apiRoutes)
+	if deps.OpenAPI.Validate {
+		r.Use(spec.Middleware(deps.OpenAPI.Strict, deps.Logger))
+	}
+	r.GET("/api/v1/openapi.json", spec.Serve)
 
 	r.GET("/api/v1/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
+	r.GET("/livez", deps.HealthHandler.Livez)
+	r.GET("/readyz", deps.HealthHandler.Readyz)
+	if deps.Metrics != nil && deps.MetricsHandler != nil {
+		r.GET("/metrics", middleware.MetricsAuthMiddleware(deps.MetricsToken), gin.WrapH(deps.MetricsHandler))
+	}
+
+	if deps.LocalFiles != nil {
+		r.GET("/api/v1/files/*key", gin.WrapH(deps.LocalFiles))
+		r.HEAD("/api/v1/files/*key", gin.WrapH(deps.LocalFiles))
+	}
 
 	public := r.Group("/api/v1")
-	public.Use(middleware.PublicCacheMiddleware())
+	public.Use(middleware.PublicCacheMiddleware(deps.ResponseCache))
 	{
 		public.POST("/auth/register", deps.AuthHandler.Register)
 		public.POST("/auth/login", deps.AuthHandler.Login)
@@ -71,30 +137,54 @@ func NewRouter(deps RouterDeps) *gin.Engine {
 		public.GET("/auth/oauth/:provider", deps.AuthHandler.OAuthStart)
 		public.GET("/auth/oauth/:provider/callback", deps.AuthHandler.OAuthCallback)
 		public.POST("/auth/oauth/exchange", deps.AuthHandler.ExchangeOAuthCode)
+		public.POST("/auth/verify-email", deps.AuthHandler.VerifyEmail)
+		public.POST("/auth/password/forgot", deps.AuthHandler.ForgotPassword)
+		public.POST("/auth/password/reset", deps.AuthHandler.ResetPassword)
 
 		public.GET("/startups", deps.StartupHandler.List)
 		public.GET("/startups/slug/:slug", deps.StartupHandler.GetBySlug)
 		public.GET("/jobs", deps.JobHandler.List)
 		public.GET("/jobs/:id", deps.JobHandler.Get)
+		public.GET("/job-actions", deps.JobActionHandler.Preview)
+		public.POST("/job-actions", deps.JobActionHandler.Apply)
+		public.POST("/follows/unsubscribe", deps.FollowHandler.Unsubscribe)
 		public.POST("/contact", deps.ContactHandler.Create)
 		public.POST("/billing/webhook", deps.BillingHandler.Webhook)
+		public.POST("/inbound/apply", deps.InboundHandler.Apply)
 	}
 
 	protected := r.Group("/api/v1")
 	protected.Use(middleware.AuthMiddleware(deps.JWTService))
 	{
 		protected.GET("/me", deps.AuthHandler.GetMe)
+		protected.PUT("/me/password", deps.AuthHandler.ChangePassword)
 		protected.POST("/auth/logout", deps.AuthHandler.Logout)
+		protected.POST("/auth/verify-email/resend", deps.AuthHandler.ResendVerificationEmail)
 
 		protected.POST("/startups", deps.StartupHandler.Create)
 		protected.PUT("/startups/:id", deps.StartupHandler.Update)
 		protected.GET("/startups/:id", deps.StartupHandler.Get)
+		protected.GET("/startups/:id/verification", deps.VerificationHandler.Get)
+		protected.POST("/startups/:id/verification", deps.VerificationHandler.Start)
+		protected.POST("/startups/:id/verification/check", deps.VerificationHandler.Check)
+		protected.POST("/startups/:id/follow", deps.FollowHandler.Follow)
+		protected.DELETE("/startups/:id/follow", deps.FollowHandler.Unfollow)
+		protected.GET("/startups/:id/followers", deps.FollowHandler.FollowerCount)
+		protected.GET("/me/follows", deps.FollowHandler.ListMine)
+		protected.POST("/startups/:id/claims", deps.ClaimHandler.Submit)
+		protected.GET("/me/claims", deps.ClaimHandler.ListMine)
+		protected.GET("/claims/:id", deps.ClaimHandler.Get)
+		protected.POST("/claims/:id/verify", deps.ClaimHandler.Verify)
 
 		protected.POST("/jobs", deps.JobHandler.Create)
 		protected.PUT("/jobs/:id", deps.JobHandler.Update)
 		protected.DELETE("/jobs/:id", deps.JobHandler.Delete)
 
 		protected.POST("/upload", deps.FileHandler.Upload)
+		protected.GET("/files", deps.FileHandler.List)
+		protected.DELETE("/files/:id", deps.FileHandler.Delete)
+		protected.POST("/uploads/presign", deps.UploadHandler.Presign)
+		protected.POST("/uploads/:id/complete", deps.UploadHandler.Complete)
 		protected.POST("/billing/checkout", deps.BillingHandler.CreateCheckout)
 		protected.GET("/billing/status", deps.BillingHandler.Status)
 
@@ -111,6 +201,16 @@ func NewRouter(deps RouterDeps) *gin.Engine {
 		protected.GET("/teams/:id/startups", deps.TeamHandler.ListStartups)
 		protected.POST("/teams/:id/startups/:startupId", deps.TeamHandler.LinkStartup)
 		protected.DELETE("/teams/:id/startups/:startupId", deps.TeamHandler.UnlinkStartup)
+		protected.GET("/teams/:id/startups/:startupId/tokens", deps.APITokenHandler.List)
+		protected.POST("/teams/:id/startups/:startupId/tokens", deps.APITokenHandler.Create)
+		protected.POST("/teams/:id/startups/:startupId/tokens/:tokenId/rotate", deps.APITokenHandler.Rotate)
+		protected.DELETE("/teams/:id/startups/:startupId/tokens/:tokenId", deps.APITokenHandler.Revoke)
+		protected.GET("/teams/:id/webhooks", deps.WebhookHandler.List)
+		protected.POST("/teams/:id/webhooks", deps.WebhookHandler.Create)
+		protected.PATCH("/teams/:id/webhooks/:webhookId", deps.WebhookHandler.Update)
+		protected.DELETE("/teams/:id/webhooks/:webhookId", deps.WebhookHandler.Delete)
+		protected.GET("/teams/:id/webhooks/:webhookId/deliveries", deps.WebhookHandler.ListDeliveries)
+		protected.POST("/teams/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver", deps.WebhookHandler.Redeliver)
 		protected.POST("/invitations/accept", deps.TeamHandler.AcceptInvitation)
 
 		// Platform admin
@@ -122,21 +222,47 @@ func NewRouter(deps RouterDeps) *gin.Engine {
 			admin.GET("/teams", deps.AdminHandler.ListTeams)
 			admin.POST("/startups", deps.AdminHandler.CreateStartup)
 			admin.PUT("/startups/:id/team", deps.AdminHandler.LinkStartupTeam)
+			admin.GET("/claims", deps.ClaimHandler.List)
+			admin.POST("/claims/:id/approve", deps.ClaimHandler.Approve)
+			admin.POST("/claims/:id/reject", deps.ClaimHandler.Reject)
+			admin.GET("/files/stray", deps.FileHandler.StrayObjects)
+			admin.GET("/background-jobs", deps.BackgroundJobHandler.List)
+			admin.POST("/background-jobs/:id/retry", deps.BackgroundJobHandler.Retry)
+			admin.POST("/background-jobs/:id/cancel", deps.BackgroundJobHandler.Cancel)
+			admin.GET("/email-templates", deps.EmailTemplateHandler.List)
+			admin.POST("/email-templates/:name/preview", deps.EmailTemplateHandler.Preview)
 		}
 	}
 
 	tokenRoutes := r.Group("/api/v1/token")
-	tokenRoutes.Use(middleware.APITokenMiddleware(deps.StartupRepo))
+	tokenRoutes.Use(
+		middleware.APITokenMiddleware(deps.APITokenRepo),
+		middleware.APITokenRateLimitMiddleware(rateLimits),
+	)
 	{
 		tokenRoutes.GET("/startup", func(c *gin.Context) {
 			startupID := middleware.GetStartupID(c)
 			c.JSON(200, gin.H{"startup_id": startupID})
 		})
-		tokenRoutes.POST("/jobs", deps.JobHandler.Create)
-		tokenRoutes.PUT("/jobs/:id", deps.JobHandler.Update)
-		tokenRoutes.DELETE("/jobs/:id", deps.JobHandler.Delete)
-		tokenRoutes.GET("/jobs", deps.JobHandler.List)
+		tokenRoutes.POST("/jobs", middleware.RequireAPITokenScope(entity.ScopeJobsWrite), deps.JobHandler.Create)
+		tokenRoutes.PUT("/jobs/:id", middleware.RequireAPITokenScope(entity.ScopeJobsWrite), deps.JobHandler.Update)
+		tokenRoutes.DELETE("/jobs/:id", middleware.RequireAPITokenScope(entity.ScopeJobsDelete), deps.JobHandler.Delete)
+		tokenRoutes.GET("/jobs", middleware.RequireAPITokenScope(entity.ScopeJobsRead), deps.JobHandler.List)
 	}
 
+	// The document describes exactly what was registered above.
+	if err := spec.Build(r.Routes()); err != nil {
+		panic(err)
+	}
 	return r
 }
+
+// isProbePath reports scrape and probe routes, which would drown real
+// requests in traces.
+func isProbePath(path string) bool {
+	switch path {
+	case "/metrics", "/livez", "/readyz", "/api/v1/health":
+		return true
+	}
+	return false
+}
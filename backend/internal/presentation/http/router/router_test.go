@@ -0,0 +1,50 @@
+package router_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/startup-job-board/backend/internal/presentation/http/router"
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+func TestOpenAPIDocumentsEveryRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := router.NewRouter(router.RouterDeps{
+		LocalFiles: http.NotFoundHandler(), AllowedOrigins: []string{"http://localhost:3000"},
+		Logger: logger.New(logger.Options{Output: io.Discard}),
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d", rec.Code)
+	}
+	var doc struct {
+		OpenAPI string                                         `json:"openapi"`
+		Paths   map[string]map[string]struct{ Summary string } `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != "3.1.0" {
+		t.Fatalf("openapi = %q", doc.OpenAPI)
+	}
+
+	count := 0
+	for path, ops := range doc.Paths {
+		for method, op := range ops {
+			count++
+			if op.Summary == "" {
+				t.Errorf("%s %s has no entry in apiRoutes", method, path)
+			}
+		}
+	}
+	if count != len(r.Routes()) {
+		t.Errorf("document has %d operations, router %d", count, len(r.Routes()))
+	}
+}
@@ -42,6 +42,15 @@ func NewBadRequestError(message string) *AppError {
 	}
 }
 
+// NewConflictError reports that the resource changed under the caller, e.g.
+// a concurrent request won the race.
+func NewConflictError(message string) *AppError {
+	return &AppError{
+		Code:    "CONFLICT",
+		Message: message,
+	}
+}
+
 func NewForbiddenError(message string) *AppError {
 	return &AppError{
 		Code:    "FORBIDDEN",
@@ -0,0 +1,58 @@
+package logger
+
+import "context"
+
+// Field names shared by every request-scoped entry.
+const (
+	FieldRequestID = "request_id"
+	FieldUserID    = "user_id"
+	FieldStartupID = "startup_id"
+	FieldRoute     = "route"
+	FieldTraceID   = "trace_id"
+)
+
+type fieldsKey struct{}
+
+// ContextWith returns a copy of ctx that also carries the key-value pairs.
+// A key set again replaces the earlier value.
+func ContextWith(ctx context.Context, keyvals ...interface{}) context.Context {
+	prev := FieldsFromContext(ctx)
+	fields := make([]interface{}, 0, len(prev)+len(keyvals))
+	for i := 0; i+1 < len(prev); i += 2 {
+		if !hasKey(keyvals, prev[i]) {
+			fields = append(fields, prev[i], prev[i+1])
+		}
+	}
+	fields = append(fields, keyvals...)
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// FieldsFromContext returns the key-value pairs added with ContextWith.
+func FieldsFromContext(ctx context.Context) []interface{} {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey{}).([]interface{})
+	return fields
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	fields := FieldsFromContext(ctx)
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i] == FieldRequestID {
+			id, _ := fields[i+1].(string)
+			return id
+		}
+	}
+	return ""
+}
+
+func hasKey(keyvals []interface{}, key interface{}) bool {
+	for i := 0; i < len(keyvals); i += 2 {
+		if keyvals[i] == key {
+			return true
+		}
+	}
+	return false
+}
@@ -1,64 +1,117 @@
 package logger
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Logger writes leveled entries. Messages keep printf-style formatting;
+// structured fields are attached with With and WithContext.
 type Logger interface {
 	Info(msg string, args ...interface{})
 	Error(msg string, args ...interface{})
 	Debug(msg string, args ...interface{})
 	Warn(msg string, args ...interface{})
+	// With returns a logger that adds the key-value pairs to every entry.
+	With(keyvals ...interface{}) Logger
+	// WithContext returns a logger that adds the request fields carried by ctx.
+	WithContext(ctx context.Context) Logger
 }
 
-type SimpleLogger struct {
-	infoLogger  *log.Logger
-	errorLogger *log.Logger
-	debugLogger *log.Logger
-	warnLogger  *log.Logger
+// Options configures New.
+type Options struct {
+	Level  string // debug, info, warn or error; defaults to info
+	Format string // json or text; defaults to json
+	Output io.Writer
 }
 
-func NewLogger() Logger {
-	return &SimpleLogger{
-		infoLogger:  log.New(os.Stdout, "[INFO] ", log.LstdFlags|log.Lshortfile),
-		errorLogger: log.New(os.Stderr, "[ERROR] ", log.LstdFlags|log.Lshortfile),
-		debugLogger: log.New(os.Stdout, "[DEBUG] ", log.LstdFlags|log.Lshortfile),
-		warnLogger:  log.New(os.Stdout, "[WARN] ", log.LstdFlags|log.Lshortfile),
-	}
+type SlogLogger struct {
+	l *slog.Logger
 }
 
-func (l *SimpleLogger) Info(msg string, args ...interface{}) {
-	if len(args) > 0 {
-		l.infoLogger.Printf(msg, args...)
+// New builds a logger writing one entry per line to opts.Output (stdout by default).
+func New(opts Options) Logger {
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	handlerOpts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       ParseLevel(opts.Level),
+		ReplaceAttr: shortSource,
+	}
+	var h slog.Handler
+	if strings.EqualFold(opts.Format, "text") {
+		h = slog.NewTextHandler(out, handlerOpts)
 	} else {
-		l.infoLogger.Println(msg)
+		h = slog.NewJSONHandler(out, handlerOpts)
 	}
+	return &SlogLogger{l: slog.New(h)}
 }
 
-func (l *SimpleLogger) Error(msg string, args ...interface{}) {
-	if len(args) > 0 {
-		l.errorLogger.Printf(msg, args...)
-	} else {
-		l.errorLogger.Println(msg)
-	}
+// NewLogger returns an info-level JSON logger on stdout.
+func NewLogger() Logger {
+	return New(Options{})
 }
 
-func (l *SimpleLogger) Debug(msg string, args ...interface{}) {
-	if len(args) > 0 {
-		l.debugLogger.Printf(msg, args...)
-	} else {
-		l.debugLogger.Println(msg)
+// ParseLevel maps a level name to its slog level, defaulting to info.
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
 	}
+	return slog.LevelInfo
 }
 
-func (l *SimpleLogger) Warn(msg string, args ...interface{}) {
-	if len(args) > 0 {
-		l.warnLogger.Printf(msg, args...)
-	} else {
-		l.warnLogger.Println(msg)
+// shortSource reports the caller as file:line instead of a nested object.
+func shortSource(_ []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.SourceKey {
+		if src, ok := a.Value.Any().(*slog.Source); ok {
+			return slog.String(slog.SourceKey, filepath.Base(src.File)+":"+strconv.Itoa(src.Line))
+		}
 	}
+	return a
 }
 
+func (s *SlogLogger) Info(msg string, args ...interface{})  { s.log(slog.LevelInfo, msg, args) }
+func (s *SlogLogger) Error(msg string, args ...interface{}) { s.log(slog.LevelError, msg, args) }
+func (s *SlogLogger) Debug(msg string, args ...interface{}) { s.log(slog.LevelDebug, msg, args) }
+func (s *SlogLogger) Warn(msg string, args ...interface{})  { s.log(slog.LevelWarn, msg, args) }
+
+func (s *SlogLogger) With(keyvals ...interface{}) Logger {
+	if len(keyvals) == 0 {
+		return s
+	}
+	return &SlogLogger{l: s.l.With(keyvals...)}
+}
 
+func (s *SlogLogger) WithContext(ctx context.Context) Logger {
+	return s.With(FieldsFromContext(ctx)...)
+}
 
+func (s *SlogLogger) log(level slog.Level, msg string, args []interface{}) {
+	ctx := context.Background()
+	if !s.l.Enabled(ctx, level) {
+		return
+	}
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	// Skip runtime.Callers, log and the level method so source is the caller.
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+	r := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	_ = s.l.Handler().Handle(ctx, r)
+}
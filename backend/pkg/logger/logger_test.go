@@ -0,0 +1,45 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/pkg/logger"
+)
+
+func TestJSONEntriesCarryContextFields(t *testing.T) {
+	var buf bytes.Buffer
+	log := logger.New(logger.Options{Level: "info", Output: &buf})
+
+	ctx := logger.ContextWith(context.Background(), logger.FieldRequestID, "req-1", logger.FieldUserID, "u1")
+	ctx = logger.ContextWith(ctx, logger.FieldUserID, "u2")
+	log.WithContext(ctx).With("job_id", "j1").Warn("sync rejected: %d", 422)
+	log.Debug("not shown")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("want one entry, got %q", buf.String())
+	}
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"level": "WARN", "msg": "sync rejected: 422",
+		"request_id": "req-1", "user_id": "u2", "job_id": "j1",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("%s = %v, want %v", k, entry[k], v)
+		}
+	}
+	if src, _ := entry["source"].(string); !strings.HasPrefix(src, "logger_test.go:") {
+		t.Errorf("source = %v", entry["source"])
+	}
+	if got := logger.RequestIDFromContext(ctx); got != "req-1" {
+		t.Errorf("request id = %q", got)
+	}
+}
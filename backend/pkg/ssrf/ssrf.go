@@ -0,0 +1,145 @@
+// Package ssrf guards outbound requests to user-supplied hosts. It mirrors the
+// crawler's internal/pkg/ssrf checks and adds a dial-time guard so DNS
+// rebinding between validation and connect can't reach private addresses.
+package ssrf
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var blockedCIDRs []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"127.0.0.0/8",
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"169.254.0.0/16",
+		"100.64.0.0/10",
+		"0.0.0.0/8",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err == nil {
+			blockedCIDRs = append(blockedCIDRs, network)
+		}
+	}
+}
+
+// ValidatePublicHTTPURL ensures raw is an http(s) URL that does not resolve to private,
+// link-local, loopback, or metadata IP addresses.
+func ValidatePublicHTTPURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL scheme must be http or https")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("URL must have a host")
+	}
+
+	lowerHost := strings.ToLower(strings.TrimSuffix(host, "."))
+	if lowerHost == "localhost" || strings.HasSuffix(lowerHost, ".localhost") {
+		return fmt.Errorf("localhost URLs are not allowed")
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if IsBlockedIP(ip) {
+			return fmt.Errorf("URL resolves to blocked IP address: %s", ip.String())
+		}
+		return nil
+	}
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve host: %w", err)
+	}
+
+	if len(ips) == 0 {
+		return fmt.Errorf("no IP addresses resolved for host")
+	}
+
+	for _, ip := range ips {
+		if IsBlockedIP(ip) {
+			return fmt.Errorf("URL resolves to blocked IP address: %s", ip.String())
+		}
+	}
+
+	return nil
+}
+
+// IsBlockedIP reports whether ip is loopback, private, link-local or otherwise
+// not a public unicast address.
+func IsBlockedIP(ip net.IP) bool {
+	ip = ip.To16()
+	if ip == nil {
+		return true
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return true
+	}
+
+	for _, network := range blockedCIDRs {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewPublicHTTPClient returns a client that refuses to connect to blocked
+// addresses (checked on the resolved IP at dial time, including redirects).
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || IsBlockedIP(ip) {
+				return fmt.Errorf("connection to %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to %s scheme is not allowed", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/startup-job-board/backend/pkg/tracing"
+)
+
+func TestClientPropagatesTraceContext(t *testing.T) {
+	var exported bytes.Buffer
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: "test", Exporter: "stdout", SampleRatio: 1, Output: &exported,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+
+	ctx, span := tracing.Start(context.Background(), "test.Outbound")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := tracing.Client(nil).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	span.End()
+
+	traceID := tracing.TraceID(ctx)
+	if traceID == "" || !strings.Contains(traceparent, traceID) {
+		t.Fatalf("traceparent %q does not carry trace %q", traceparent, traceID)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(exported.String(), `"Name":"test.Outbound"`) {
+		t.Fatalf("span not exported: %s", exported.String())
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	if _, err := tracing.Setup(context.Background(), tracing.Options{Exporter: "zipkin"}); err == nil {
+		t.Fatal("expected an error for an unknown exporter")
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WebsiteDomain returns the lowercase host of a website URL without port or
+// a leading "www.", e.g. "https://www.Acme.io/about" -> "acme.io".
+func WebsiteDomain(website string) string {
+	raw := strings.TrimSpace(website)
+	if raw == "" {
+		return ""
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	return strings.TrimPrefix(host, "www.")
+}
+
+// EmailDomain returns the lowercase domain part of an email address.
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// RelayLocalPrefix is the local-part prefix of masked job application addresses.
+const RelayLocalPrefix = "apply+"
+
+const relayTokenBytes = 10
+
+// GenerateRelayToken returns a random lowercase hex token for a job's relay address.
+// Lowercase keeps it stable when mail servers fold the local part.
+func GenerateRelayToken() (string, error) {
+	return RandomHex(relayTokenBytes)
+}
+
+// RelayAddress builds the public apply+<token>@domain address.
+func RelayAddress(token, domain string) string {
+	return RelayLocalPrefix + token + "@" + domain
+}
+
+// ParseRelayAddress extracts the token from a relay address on domain.
+// Accepts display-name forms ("Jobs <apply+abc@domain>").
+func ParseRelayAddress(raw, domain string) (string, bool) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(raw))
+	if err != nil {
+		return "", false
+	}
+	at := strings.LastIndex(addr.Address, "@")
+	if at <= 0 {
+		return "", false
+	}
+	local := strings.ToLower(addr.Address[:at])
+	if !strings.EqualFold(addr.Address[at+1:], domain) || !strings.HasPrefix(local, RelayLocalPrefix) {
+		return "", false
+	}
+	token := strings.TrimPrefix(local, RelayLocalPrefix)
+	if len(token) != relayTokenBytes*2 {
+		return "", false
+	}
+	for _, c := range token {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return "", false
+		}
+	}
+	return token, true
+}
@@ -0,0 +1,35 @@
+package utils
+
+import "testing"
+
+func TestRelayAddressRoundTrip(t *testing.T) {
+	token, err := GenerateRelayToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := RelayAddress(token, "jobs.example.com")
+	got, ok := ParseRelayAddress(addr, "jobs.example.com")
+	if !ok || got != token {
+		t.Fatalf("round trip: got %q ok=%v", got, ok)
+	}
+	got, ok = ParseRelayAddress("Jobs <APPLY+"+token+"@Jobs.Example.com>", "jobs.example.com")
+	if !ok || got != token {
+		t.Fatalf("display name / case: got %q ok=%v", got, ok)
+	}
+}
+
+func TestParseRelayAddressRejects(t *testing.T) {
+	cases := []string{
+		"",
+		"apply+0123456789abcdef0123@other.com",
+		"hello+0123456789abcdef0123@jobs.example.com",
+		"apply+short@jobs.example.com",
+		"apply+0123456789abcdef012z@jobs.example.com",
+		"not an address",
+	}
+	for _, raw := range cases {
+		if _, ok := ParseRelayAddress(raw, "jobs.example.com"); ok {
+			t.Fatalf("expected reject: %q", raw)
+		}
+	}
+}
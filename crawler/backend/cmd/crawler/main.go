@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -13,11 +14,17 @@ import (
 	"github.com/startup-job-board/crawler/internal/application/usecase/site"
 	"github.com/startup-job-board/crawler/internal/config"
 	"github.com/startup-job-board/crawler/internal/infrastructure/crawler"
+	"github.com/startup-job-board/crawler/internal/infrastructure/health"
+	"github.com/startup-job-board/crawler/internal/infrastructure/metrics"
+	"github.com/startup-job-board/crawler/internal/infrastructure/persistence/migration"
 	"github.com/startup-job-board/crawler/internal/infrastructure/persistence/postgres"
 	"github.com/startup-job-board/crawler/internal/infrastructure/scheduler"
 	"github.com/startup-job-board/crawler/internal/infrastructure/sync"
+	"github.com/startup-job-board/crawler/internal/pkg/logger"
+	"github.com/startup-job-board/crawler/internal/pkg/tracing"
 	"github.com/startup-job-board/crawler/internal/presentation/http/handler"
 	"github.com/startup-job-board/crawler/internal/presentation/http/router"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 )
 
 func main() {
@@ -27,18 +34,58 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	logger := logger.New(logger.Options{Level: cfg.LogLevel, Format: cfg.LogFormat})
+	fatal := func(msg string, args ...interface{}) {
+		logger.Error(msg, args...)
+		os.Exit(1)
+	}
+
 	env := strings.ToLower(cfg.Environment)
 	if (env == "production" || env == "prod") && cfg.CrawlerAPIToken == "" {
-		log.Fatal("CRAWLER_API_TOKEN must be set in production")
+		fatal("CRAWLER_API_TOKEN must be set in production")
 	}
 	if cfg.CrawlerAPIToken == "" {
-		log.Println("WARNING: CRAWLER_API_TOKEN is not set; API endpoints are unauthenticated")
+		logger.Warn("CRAWLER_API_TOKEN is not set; API endpoints are unauthenticated")
+	}
+
+	// Initialize tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: cfg.ServiceName,
+		Exporter:    cfg.TracingExporter,
+		SampleRatio: cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		fatal("Failed to initialize tracing: %v", err)
 	}
 
 	// Initialize database
 	db, err := config.NewDatabase(cfg.DatabaseURL)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("Failed to connect to database: %v", err)
+	}
+	if err := db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics(), gormtracing.WithoutQueryVariables())); err != nil {
+		fatal("Failed to instrument database: %v", err)
+	}
+
+	// Apply schema migrations, or run the migrate subcommand and exit
+	sqlDB, err := db.DB()
+	if err != nil {
+		fatal("Failed to access database pool: %v", err)
+	}
+	migrator, err := migration.NewMigrator(sqlDB, migration.Files, logger)
+	if err != nil {
+		fatal("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), migrator, os.Args[2:], os.Stdout); err != nil {
+			fatal("Migrate failed: %v", err)
+		}
+		return
+	}
+	if cfg.MigrateOnStart {
+		if _, err := migrator.Up(context.Background()); err != nil {
+			fatal("Failed to run migrations: %v", err)
+		}
 	}
 
 	// Initialize repositories
@@ -46,14 +93,15 @@ func main() {
 	jobRepo := postgres.NewJobRepository(db)
 	crawlLogRepo := postgres.NewCrawlLogRepository(db)
 
-	// Initialize crawler engine
-	crawlerEngine := crawler.NewEngine(jobRepo)
+	// Initialize metrics and crawler engine
+	crawlerMetrics := metrics.NewPrometheus(jobRepo)
+	crawlerEngine := crawler.NewEngine(jobRepo, crawlerMetrics)
 
 	// Initialize scheduler
-	scheduler := scheduler.NewScheduler(crawlerEngine, siteRepo)
+	scheduler := scheduler.NewScheduler(crawlerEngine, siteRepo, logger)
 
 	// Initialize sync service
-	syncService := sync.NewSyncService(cfg.BackendURL, cfg.BackendToken, jobRepo, siteRepo)
+	syncService := sync.NewSyncService(cfg.BackendURL, cfg.BackendToken, jobRepo, siteRepo, logger)
 
 	// Initialize use cases
 	createSiteUseCase := site.NewCreateSiteUseCase(siteRepo)
@@ -76,13 +124,24 @@ func main() {
 	crawlHandler := handler.NewCrawlHandler(executeCrawlUseCase)
 	crawlLogHandler := handler.NewCrawlLogHandler(getLogsUseCase, getLatestLogUseCase)
 
+	// Readiness checks; sync to the backend is optional outside production
+	healthChecker := health.NewChecker(cfg.HealthCheckTimeout)
+	healthChecker.Add(health.Database(sqlDB))
+	healthChecker.Add(health.Migrations(migrator))
+	healthChecker.Add(health.Scheduler(scheduler.Running))
+	healthChecker.Add(health.Configured("backend_sync", env == "production" || env == "prod", map[string]string{
+		"BACKEND_URL":   cfg.BackendURL,
+		"BACKEND_TOKEN": cfg.BackendToken,
+	}))
+	healthHandler := handler.NewHealthHandler(healthChecker)
+
 	// Setup router
-	r := router.SetupRouter(siteHandler, crawlHandler, crawlLogHandler, cfg.CrawlerAPIToken)
+	r := router.SetupRouter(siteHandler, crawlHandler, crawlLogHandler, healthHandler, crawlerMetrics.Handler(), cfg.CrawlerAPIToken, cfg.ServiceName)
 
 	// Start scheduler
 	ctx := context.Background()
 	if err := scheduler.Start(ctx); err != nil {
-		log.Fatalf("Failed to start scheduler: %v", err)
+		fatal("Failed to start scheduler: %v", err)
 	}
 
 	// Start sync service in background (runs periodically)
@@ -93,12 +152,12 @@ func main() {
 		for {
 			select {
 			case <-ticker.C:
-				log.Println("Starting sync...")
+				logger.Info("Starting sync...")
 				result, err := syncService.SyncJobs(ctx)
 				if err != nil {
-					log.Printf("Sync error: %v", err)
+					logger.Error("Sync error: %v", err)
 				} else {
-					log.Printf("Sync completed: %d success, %d failures", result.SuccessCount, result.FailureCount)
+					logger.Info("Sync completed: %d success, %d failures", result.SuccessCount, result.FailureCount)
 				}
 			case <-ctx.Done():
 				return
@@ -107,11 +166,11 @@ func main() {
 	}()
 
 	// Start HTTP server
+	srv := &http.Server{Addr: ":" + cfg.Port, Handler: r}
 	go func() {
-		addr := ":" + cfg.Port
-		log.Printf("Starting HTTP server on %s", addr)
-		if err := r.Run(addr); err != nil {
-			log.Fatalf("Failed to start HTTP server: %v", err)
+		logger.Info("Starting HTTP server on %s", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fatal("Failed to start HTTP server: %v", err)
 		}
 	}()
 
@@ -120,8 +179,20 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down...")
+	logger.Info("Shutting down...")
+	healthChecker.SetShuttingDown()
+	if cfg.HealthShutdownDelay > 0 {
+		time.Sleep(cfg.HealthShutdownDelay)
+	}
 	scheduler.Stop()
-	log.Println("Server stopped")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("HTTP server forced to shutdown: %v", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Warn("Failed to flush traces: %v", err)
+	}
+	logger.Info("Server stopped")
 }
 
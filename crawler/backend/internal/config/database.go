@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/startup-job-board/crawler/internal/infrastructure/persistence/gorm_model"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -57,15 +56,6 @@ func NewDatabase(databaseURL string) (*gorm.DB, error) {
 		}
 	}
 
-	// Auto migrate
-	if err := db.AutoMigrate(
-		&gorm_model.CrawlSiteModel{},
-		&gorm_model.CrawledJobModel{},
-		&gorm_model.CrawlLogModel{},
-	); err != nil {
-		return nil, err
-	}
-
 	return db, nil
 }
 
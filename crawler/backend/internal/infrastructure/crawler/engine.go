@@ -11,20 +11,34 @@ import (
 	"github.com/startup-job-board/crawler/internal/domain/entity"
 	"github.com/startup-job-board/crawler/internal/domain/repository"
 	"github.com/startup-job-board/crawler/internal/pkg/ssrf"
+	"github.com/startup-job-board/crawler/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// Metrics receives measurements from each crawl
+type Metrics interface {
+	// CrawlFinished records a crawl's outcome: success, partial or failed
+	CrawlFinished(site, status string)
+	// PageFetched records one fetch; status is 0 when no response arrived
+	PageFetched(site string, status int, duration time.Duration)
+	JobsProcessed(site string, found, saved, skipped int)
+}
+
 // Engine handles the crawling process
 type Engine struct {
 	jobRepo repository.JobRepository
+	metrics Metrics
 	client  *http.Client
 }
 
 // NewEngine creates a new crawler engine
-func NewEngine(jobRepo repository.JobRepository) *Engine {
+func NewEngine(jobRepo repository.JobRepository, metrics Metrics) *Engine {
 	return &Engine{
 		jobRepo: jobRepo,
+		metrics: metrics,
 		client: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: tracing.FetchTransport(nil),
 		},
 	}
 }
@@ -39,7 +53,34 @@ type CrawlResult struct {
 }
 
 // Crawl crawls a site for job listings
-func (e *Engine) Crawl(ctx context.Context, site *entity.CrawlSite) (*CrawlResult, error) {
+func (e *Engine) Crawl(ctx context.Context, site *entity.CrawlSite) (result *CrawlResult, err error) {
+	ctx, span := tracing.Start(ctx, "crawler.Crawl",
+		attribute.String("crawler.site.id", site.ID),
+		attribute.String("crawler.site.name", site.Name),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	result, err = e.crawl(ctx, site)
+	switch {
+	case err != nil:
+		e.metrics.CrawlFinished(site.Name, "failed")
+	case len(result.Errors) > 0:
+		e.metrics.CrawlFinished(site.Name, "partial")
+	default:
+		e.metrics.CrawlFinished(site.Name, "success")
+	}
+	if result != nil {
+		e.metrics.JobsProcessed(site.Name, result.JobsFound, result.JobsSaved, result.JobsSkipped)
+		span.SetAttributes(
+			attribute.Int("crawler.pages", result.PagesCrawled),
+			attribute.Int("crawler.jobs.found", result.JobsFound),
+			attribute.Int("crawler.jobs.saved", result.JobsSaved),
+		)
+	}
+	return result, err
+}
+
+func (e *Engine) crawl(ctx context.Context, site *entity.CrawlSite) (*CrawlResult, error) {
 	result := &CrawlResult{
 		Errors:       []string{},
 		PagesCrawled: 0,
@@ -67,7 +108,9 @@ func (e *Engine) Crawl(ctx context.Context, site *entity.CrawlSite) (*CrawlResul
 		}
 
 		// Fetch page
-		doc, err := e.fetchPage(ctx, pageURL, site.UserAgent)
+		start := time.Now()
+		doc, status, err := e.fetchPage(ctx, pageURL, site.UserAgent)
+		e.metrics.PageFetched(site.Name, status, time.Since(start))
 		if err != nil {
 			result.Errors = append(result.Errors, fmt.Sprintf("failed to fetch %s: %v", pageURL, err))
 			continue
@@ -108,15 +151,16 @@ func (e *Engine) Crawl(ctx context.Context, site *entity.CrawlSite) (*CrawlResul
 	return result, nil
 }
 
-// fetchPage fetches a page and returns a goquery document
-func (e *Engine) fetchPage(ctx context.Context, url string, userAgent string) (*goquery.Document, error) {
+// fetchPage fetches a page and returns a goquery document with the HTTP
+// status, which is 0 when no response arrived
+func (e *Engine) fetchPage(ctx context.Context, url string, userAgent string) (*goquery.Document, int, error) {
 	if err := ssrf.ValidatePublicHTTPURL(url); err != nil {
-		return nil, fmt.Errorf("blocked URL: %w", err)
+		return nil, 0, fmt.Errorf("blocked URL: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if userAgent != "" {
@@ -127,20 +171,20 @@ func (e *Engine) fetchPage(ctx context.Context, url string, userAgent string) (*
 
 	resp, err := e.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, resp.StatusCode, err
 	}
 
-	return doc, nil
+	return doc, resp.StatusCode, nil
 }
 
 // saveJob saves a job if it's not a duplicate
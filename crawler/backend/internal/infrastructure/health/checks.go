@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Database pings the connection pool
+func Database(db *sql.DB) Check {
+	return Check{Name: "database", Critical: true, Run: db.PingContext}
+}
+
+// PendingMigrations is implemented by migration.Migrator
+type PendingMigrations interface {
+	Pending(ctx context.Context) (int, error)
+}
+
+// Migrations fails while the schema is behind the binary
+func Migrations(m PendingMigrations) Check {
+	return Check{Name: "migrations", Critical: true, Run: func(ctx context.Context) error {
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("%d migrations pending", pending)
+		}
+		return nil
+	}}
+}
+
+// Scheduler fails unless the cron scheduler has been started and not stopped
+func Scheduler(running func() bool) Check {
+	return Check{Name: "scheduler", Critical: true, Run: func(context.Context) error {
+		if !running() {
+			return errors.New("scheduler is not running")
+		}
+		return nil
+	}}
+}
+
+// Configured fails when any of the settings, keyed by environment variable,
+// is empty
+func Configured(name string, critical bool, settings map[string]string) Check {
+	return Check{Name: name, Critical: critical, Run: func(context.Context) error {
+		var missing []string
+		for env, value := range settings {
+			if value == "" {
+				missing = append(missing, env)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("not configured: %s", strings.Join(missing, ", "))
+	}}
+}
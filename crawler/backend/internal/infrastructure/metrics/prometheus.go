@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/startup-job-board/crawler/internal/domain/repository"
+	"github.com/startup-job-board/crawler/internal/infrastructure/crawler"
+)
+
+// Prometheus records crawler metrics in its own registry
+type Prometheus struct {
+	registry      *prometheus.Registry
+	crawls        *prometheus.CounterVec
+	pages         *prometheus.CounterVec
+	fetchDuration *prometheus.HistogramVec
+	jobs          *prometheus.CounterVec
+}
+
+var _ crawler.Metrics = (*Prometheus)(nil)
+
+// NewPrometheus creates the collectors and exports the sync backlog
+// (crawled jobs not yet pushed to the backend) from jobRepo
+func NewPrometheus(jobRepo repository.JobRepository) *Prometheus {
+	m := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		crawls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "crawler_crawls_total",
+			Help: "Finished crawls by site and status (success, partial, failed).",
+		}, []string{"site", "status"}),
+		pages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "crawler_pages_fetched_total",
+			Help: "Page fetches by site and HTTP status; error when no response arrived.",
+		}, []string{"site", "status"}),
+		fetchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "crawler_fetch_duration_seconds",
+			Help:    "Page fetch latency by site.",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"site"}),
+		jobs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "crawler_jobs_total",
+			Help: "Jobs extracted by site and result (found, saved, skipped).",
+		}, []string{"site", "result"}),
+	}
+	backlog := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "crawler_sync_backlog_jobs",
+		Help: "Crawled jobs waiting to be synced to the backend.",
+	}, func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		count, err := jobRepo.CountUnsynced(ctx)
+		if err != nil {
+			return math.NaN()
+		}
+		return float64(count)
+	})
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.crawls, m.pages, m.fetchDuration, m.jobs, backlog,
+	)
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format
+func (m *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Prometheus) CrawlFinished(site, status string) {
+	m.crawls.WithLabelValues(site, status).Inc()
+}
+
+func (m *Prometheus) PageFetched(site string, status int, duration time.Duration) {
+	label := "error"
+	if status > 0 {
+		label = strconv.Itoa(status)
+	}
+	m.pages.WithLabelValues(site, label).Inc()
+	m.fetchDuration.WithLabelValues(site).Observe(duration.Seconds())
+}
+
+func (m *Prometheus) JobsProcessed(site string, found, saved, skipped int) {
+	m.jobs.WithLabelValues(site, "found").Add(float64(found))
+	m.jobs.WithLabelValues(site, "saved").Add(float64(saved))
+	m.jobs.WithLabelValues(site, "skipped").Add(float64(skipped))
+}
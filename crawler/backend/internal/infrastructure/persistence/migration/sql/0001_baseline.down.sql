@@ -0,0 +1,5 @@
+-- Drops every table the baseline creates; only for scratch databases.
+
+DROP TABLE IF EXISTS crawl_logs;
+DROP TABLE IF EXISTS crawled_jobs;
+DROP TABLE IF EXISTS crawl_sites;
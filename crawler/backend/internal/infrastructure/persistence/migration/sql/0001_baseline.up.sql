@@ -0,0 +1,81 @@
+-- Baseline: the schema GORM AutoMigrate produced before versioned migrations.
+-- IF NOT EXISTS lets databases created by AutoMigrate adopt it unchanged.
+
+CREATE TABLE IF NOT EXISTS crawl_sites (
+    id uuid,
+    name varchar(255) NOT NULL,
+    base_url text NOT NULL,
+    backend_startup_id uuid NOT NULL,
+    active boolean DEFAULT true,
+    schedule varchar(100) NOT NULL,
+    last_crawled_at timestamp,
+    next_crawl_at timestamp,
+    crawl_interval varchar(50) NOT NULL,
+    pagination_config jsonb NOT NULL,
+    extraction_rules jsonb NOT NULL,
+    deduplication_key varchar(50) DEFAULT 'url',
+    request_delay bigint DEFAULT 2,
+    user_agent text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_crawl_sites_deleted_at ON crawl_sites (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_crawl_sites_active ON crawl_sites (active);
+
+CREATE TABLE IF NOT EXISTS crawled_jobs (
+    id uuid,
+    site_id uuid NOT NULL,
+    external_id varchar(255),
+    detail_url text NOT NULL,
+    title varchar(500) NOT NULL,
+    description text,
+    requirements text,
+    company varchar(255),
+    location varchar(255),
+    city varchar(100),
+    country varchar(100),
+    job_type varchar(50),
+    location_type varchar(50),
+    salary_min integer,
+    salary_max integer,
+    currency varchar(3),
+    application_url text,
+    application_email varchar(255),
+    expires_at timestamp,
+    raw_html text,
+    deduplication_hash varchar(255),
+    synced boolean DEFAULT false,
+    synced_at timestamp,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_deleted_at ON crawled_jobs (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_synced ON crawled_jobs (synced);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_deduplication_hash ON crawled_jobs (deduplication_hash);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_detail_url ON crawled_jobs (detail_url);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_external_id ON crawled_jobs (external_id);
+CREATE INDEX IF NOT EXISTS idx_crawled_jobs_site_id ON crawled_jobs (site_id);
+
+CREATE TABLE IF NOT EXISTS crawl_logs (
+    id uuid,
+    site_id uuid NOT NULL,
+    status varchar(50) NOT NULL DEFAULT 'running',
+    started_at timestamp NOT NULL,
+    completed_at timestamp,
+    duration_ms integer,
+    jobs_found integer DEFAULT 0,
+    jobs_saved integer DEFAULT 0,
+    jobs_skipped integer DEFAULT 0,
+    pages_crawled integer DEFAULT 0,
+    errors jsonb DEFAULT '[]'::jsonb,
+    logs jsonb DEFAULT '[]'::jsonb,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_crawl_logs_started_at ON crawl_logs (started_at);
+CREATE INDEX IF NOT EXISTS idx_crawl_logs_status ON crawl_logs (status);
+CREATE INDEX IF NOT EXISTS idx_crawl_logs_site_id ON crawl_logs (site_id);
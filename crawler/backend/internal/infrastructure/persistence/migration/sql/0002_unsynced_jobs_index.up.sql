@@ -0,0 +1,5 @@
+-- migrate:no-transaction
+-- The sync backlog query only looks at unsynced jobs, a small slice of the table.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_crawled_jobs_unsynced
+    ON crawled_jobs (created_at)
+    WHERE synced = false AND deleted_at IS NULL;
@@ -2,12 +2,13 @@ package scheduler
 
 import (
 	"context"
-	"log"
+	"sync/atomic"
 
 	"github.com/robfig/cron/v3"
 	"github.com/startup-job-board/crawler/internal/domain/entity"
 	"github.com/startup-job-board/crawler/internal/domain/repository"
 	"github.com/startup-job-board/crawler/internal/infrastructure/crawler"
+	"github.com/startup-job-board/crawler/internal/pkg/logger"
 )
 
 // Scheduler manages cron jobs for crawling sites
@@ -16,10 +17,12 @@ type Scheduler struct {
 	crawler  *crawler.Engine
 	siteRepo repository.SiteRepository
 	entries  map[string]cron.EntryID
+	logger   logger.Logger
+	running  atomic.Bool
 }
 
 // NewScheduler creates a new scheduler
-func NewScheduler(crawler *crawler.Engine, siteRepo repository.SiteRepository) *Scheduler {
+func NewScheduler(crawler *crawler.Engine, siteRepo repository.SiteRepository, logger logger.Logger) *Scheduler {
 	c := cron.New(cron.WithSeconds()) // Use seconds for more flexibility
 
 	return &Scheduler{
@@ -27,6 +30,7 @@ func NewScheduler(crawler *crawler.Engine, siteRepo repository.SiteRepository) *
 		crawler:  crawler,
 		siteRepo: siteRepo,
 		entries:  make(map[string]cron.EntryID),
+		logger:   logger,
 	}
 }
 
@@ -42,14 +46,21 @@ func (s *Scheduler) Start(ctx context.Context) error {
 	}
 
 	s.cron.Start()
-	log.Println("Scheduler started")
+	s.running.Store(true)
+	s.logger.Info("Scheduler started")
 	return nil
 }
 
 // Stop stops the scheduler
 func (s *Scheduler) Stop() {
+	s.running.Store(false)
 	s.cron.Stop()
-	log.Println("Scheduler stopped")
+	s.logger.Info("Scheduler stopped")
+}
+
+// Running reports whether the scheduler has started and not been stopped
+func (s *Scheduler) Running() bool {
+	return s.running.Load()
 }
 
 // ScheduleSite schedules a site for crawling
@@ -61,15 +72,16 @@ func (s *Scheduler) ScheduleSite(ctx context.Context, site *entity.CrawlSite) {
 
 	// Add new cron job
 	entryID, err := s.cron.AddFunc(site.Schedule, func() {
-		log.Printf("Starting crawl for site: %s (%s)", site.Name, site.ID)
-		
+		log := s.logger.With("site_id", site.ID)
+		log.Info("Starting crawl for site: %s", site.Name)
+
 		result, err := s.crawler.Crawl(ctx, site)
 		if err != nil {
-			log.Printf("Error crawling site %s: %v", site.Name, err)
+			log.Error("Error crawling site %s: %v", site.Name, err)
 			return
 		}
 
-		log.Printf("Crawl completed for site %s: %d jobs found, %d saved, %d skipped",
+		log.Info("Crawl completed for site %s: %d jobs found, %d saved, %d skipped",
 			site.Name, result.JobsFound, result.JobsSaved, result.JobsSkipped)
 
 		// Update last crawled timestamp
@@ -77,12 +89,12 @@ func (s *Scheduler) ScheduleSite(ctx context.Context, site *entity.CrawlSite) {
 	})
 
 	if err != nil {
-		log.Printf("Error scheduling site %s: %v", site.Name, err)
+		s.logger.With("site_id", site.ID).Error("Error scheduling site %s: %v", site.Name, err)
 		return
 	}
 
 	s.entries[site.ID] = entryID
-	log.Printf("Scheduled site %s with schedule: %s", site.Name, site.Schedule)
+	s.logger.With("site_id", site.ID).Info("Scheduled site %s with schedule: %s", site.Name, site.Schedule)
 }
 
 // UnscheduleSite removes a site from the scheduler
@@ -90,7 +102,7 @@ func (s *Scheduler) UnscheduleSite(siteID string) {
 	if entryID, exists := s.entries[siteID]; exists {
 		s.cron.Remove(entryID)
 		delete(s.entries, siteID)
-		log.Printf("Unscheduled site: %s", siteID)
+		s.logger.With("site_id", siteID).Info("Unscheduled site")
 	}
 }
 
@@ -9,10 +9,17 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/startup-job-board/crawler/internal/domain/entity"
 	"github.com/startup-job-board/crawler/internal/domain/repository"
+	"github.com/startup-job-board/crawler/internal/pkg/logger"
+	"github.com/startup-job-board/crawler/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// requestIDHeader correlates a sync call with the backend request that handled it.
+const requestIDHeader = "X-Request-ID"
+
 // SyncService handles synchronization with the backend API
 type SyncService struct {
 	backendURL    string
@@ -21,6 +28,7 @@ type SyncService struct {
 	jobRepo       repository.JobRepository
 	siteRepo      repository.SiteRepository
 	batchSize     int
+	logger        logger.Logger
 }
 
 // SyncResult represents the result of a sync operation
@@ -31,25 +39,35 @@ type SyncResult struct {
 }
 
 // NewSyncService creates a new sync service
-func NewSyncService(backendURL, apiToken string, jobRepo repository.JobRepository, siteRepo repository.SiteRepository) *SyncService {
+func NewSyncService(backendURL, apiToken string, jobRepo repository.JobRepository, siteRepo repository.SiteRepository, logger logger.Logger) *SyncService {
 	return &SyncService{
 		backendURL: backendURL,
 		apiToken:   apiToken,
 		client: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: tracing.Transport(nil),
 		},
 		jobRepo:   jobRepo,
 		siteRepo: siteRepo,
 		batchSize: 50, // Default batch size
+		logger:    logger,
 	}
 }
 
 // SyncJobs syncs unsynced jobs to the backend API
-func (s *SyncService) SyncJobs(ctx context.Context) (*SyncResult, error) {
+func (s *SyncService) SyncJobs(ctx context.Context) (_ *SyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "sync.SyncJobs")
+	defer func() { tracing.End(span, err) }()
+
 	result := &SyncResult{
 		Errors: []string{},
 	}
 
+	ctx = logger.ContextWith(ctx, "sync_run", uuid.New().String())
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		ctx = logger.ContextWith(ctx, logger.FieldTraceID, traceID)
+	}
+
 	// Get unsynced jobs
 	jobs, err := s.jobRepo.FindUnsynced(ctx, 0) // 0 = no limit
 	if err != nil {
@@ -80,7 +98,9 @@ func (s *SyncService) SyncJobs(ctx context.Context) (*SyncResult, error) {
 // syncBatch syncs a batch of jobs
 func (s *SyncService) syncBatch(ctx context.Context, jobs []*entity.CrawledJob, result *SyncResult) error {
 	for _, job := range jobs {
-		if err := s.SyncJob(ctx, job); err != nil {
+		jobCtx := logger.ContextWith(ctx, logger.FieldRequestID, uuid.New().String(), "job_id", job.ID)
+		if err := s.SyncJob(jobCtx, job); err != nil {
+			s.logger.WithContext(jobCtx).Error("Job sync failed: %v", err)
 			result.Errors = append(result.Errors, fmt.Sprintf("job %s: %v", job.ID, err))
 			result.FailureCount++
 			continue
@@ -90,8 +110,13 @@ func (s *SyncService) syncBatch(ctx context.Context, jobs []*entity.CrawledJob,
 	return nil
 }
 
-// SyncJob syncs a single job to the backend API
-func (s *SyncService) SyncJob(ctx context.Context, job *entity.CrawledJob) error {
+// SyncJob syncs a single job to the backend API. The request ID carried by
+// ctx, or a fresh one, is sent as X-Request-ID so both sides log the same ID;
+// the trace context travels as traceparent.
+func (s *SyncService) SyncJob(ctx context.Context, job *entity.CrawledJob) (err error) {
+	ctx, span := tracing.Start(ctx, "sync.SyncJob", attribute.String("crawler.job.id", job.ID))
+	defer func() { tracing.End(span, err) }()
+
 	// Get site to get backend startup ID
 	site, err := s.siteRepo.FindByID(ctx, job.SiteID)
 	if err != nil {
@@ -114,6 +139,11 @@ func (s *SyncService) SyncJob(ctx context.Context, job *entity.CrawledJob) error
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+s.apiToken)
+	requestID := logger.RequestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	req.Header.Set(requestIDHeader, requestID)
 
 	// Send request
 	resp, err := s.client.Do(req)
@@ -124,7 +154,7 @@ func (s *SyncService) SyncJob(ctx context.Context, job *entity.CrawledJob) error
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("backend API error: %d - %s", resp.StatusCode, string(body))
+		return fmt.Errorf("backend API error (request %s): %d - %s", requestID, resp.StatusCode, string(body))
 	}
 
 	// Mark as synced
@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Logger writes leveled entries. Messages keep printf-style formatting;
+// structured fields are attached with With and WithContext.
+type Logger interface {
+	Info(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+	Debug(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	// With returns a logger that adds the key-value pairs to every entry.
+	With(keyvals ...interface{}) Logger
+	// WithContext returns a logger that adds the request fields carried by ctx.
+	WithContext(ctx context.Context) Logger
+}
+
+// Options configures New.
+type Options struct {
+	Level  string // debug, info, warn or error; defaults to info
+	Format string // json or text; defaults to json
+	Output io.Writer
+}
+
+type SlogLogger struct {
+	l *slog.Logger
+}
+
+// New builds a logger writing one entry per line to opts.Output (stdout by default).
+func New(opts Options) Logger {
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	handlerOpts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       ParseLevel(opts.Level),
+		ReplaceAttr: shortSource,
+	}
+	var h slog.Handler
+	if strings.EqualFold(opts.Format, "text") {
+		h = slog.NewTextHandler(out, handlerOpts)
+	} else {
+		h = slog.NewJSONHandler(out, handlerOpts)
+	}
+	return &SlogLogger{l: slog.New(h)}
+}
+
+// NewLogger returns an info-level JSON logger on stdout.
+func NewLogger() Logger {
+	return New(Options{})
+}
+
+// ParseLevel maps a level name to its slog level, defaulting to info.
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+// shortSource reports the caller as file:line instead of a nested object.
+func shortSource(_ []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.SourceKey {
+		if src, ok := a.Value.Any().(*slog.Source); ok {
+			return slog.String(slog.SourceKey, filepath.Base(src.File)+":"+strconv.Itoa(src.Line))
+		}
+	}
+	return a
+}
+
+func (s *SlogLogger) Info(msg string, args ...interface{})  { s.log(slog.LevelInfo, msg, args) }
+func (s *SlogLogger) Error(msg string, args ...interface{}) { s.log(slog.LevelError, msg, args) }
+func (s *SlogLogger) Debug(msg string, args ...interface{}) { s.log(slog.LevelDebug, msg, args) }
+func (s *SlogLogger) Warn(msg string, args ...interface{})  { s.log(slog.LevelWarn, msg, args) }
+
+func (s *SlogLogger) With(keyvals ...interface{}) Logger {
+	if len(keyvals) == 0 {
+		return s
+	}
+	return &SlogLogger{l: s.l.With(keyvals...)}
+}
+
+func (s *SlogLogger) WithContext(ctx context.Context) Logger {
+	return s.With(FieldsFromContext(ctx)...)
+}
+
+func (s *SlogLogger) log(level slog.Level, msg string, args []interface{}) {
+	ctx := context.Background()
+	if !s.l.Enabled(ctx, level) {
+		return
+	}
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	// Skip runtime.Callers, log and the level method so source is the caller.
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+	r := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	_ = s.l.Handler().Handle(ctx, r)
+}
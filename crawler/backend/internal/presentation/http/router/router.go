@@ -1,18 +1,29 @@
 package router
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/startup-job-board/crawler/internal/presentation/http/handler"
 	"github.com/startup-job-board/crawler/internal/presentation/http/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // SetupRouter sets up the HTTP router
-func SetupRouter(siteHandler *handler.SiteHandler, crawlHandler *handler.CrawlHandler, crawlLogHandler *handler.CrawlLogHandler, apiToken string) *gin.Engine {
+func SetupRouter(siteHandler *handler.SiteHandler, crawlHandler *handler.CrawlHandler, crawlLogHandler *handler.CrawlLogHandler, healthHandler *handler.HealthHandler, metricsHandler http.Handler, apiToken, serviceName string) *gin.Engine {
 	r := gin.Default()
 
+	// Trace API requests (no-op unless tracing is configured)
+	r.Use(otelgin.Middleware(serviceName, otelgin.WithGinFilter(func(c *gin.Context) bool {
+		switch c.FullPath() {
+		case "/metrics", "/health", "/livez", "/readyz":
+			return false
+		}
+		return true
+	})))
+
 	// Configure CORS
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:3001", "http://localhost:3000"},
@@ -23,10 +34,15 @@ func SetupRouter(siteHandler *handler.SiteHandler, crawlHandler *handler.CrawlHa
 		MaxAge:           12 * time.Hour,
 	}))
 
-	// Health check
+	// Health checks: /health is kept for existing probes and answers like /livez
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
+	r.GET("/livez", healthHandler.Livez)
+	r.GET("/readyz", healthHandler.Readyz)
+
+	// Prometheus metrics (same token as the API)
+	r.GET("/metrics", middleware.APIAuth(apiToken), gin.WrapH(metricsHandler))
 
 	// API routes (protected when CRAWLER_API_TOKEN is set)
 	api := r.Group("/api/v1")
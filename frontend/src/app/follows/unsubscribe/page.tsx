@@ -0,0 +1,77 @@
+'use client'
+
+import { useState, Suspense } from 'react'
+import { useSearchParams } from 'next/navigation'
+import { Header } from '@/presentation/components/layout/header'
+import { Footer } from '@/presentation/components/layout/footer'
+import { Button } from '@/presentation/components/ui/button'
+import { apiClient } from '@/infrastructure/api/api-client'
+
+function UnsubscribeContent() {
+  const searchParams = useSearchParams()
+  const token = searchParams.get('token')
+  const [done, setDone] = useState(false)
+  const [error, setError] = useState<string | null>(
+    token ? null : 'This link is incomplete. Please use the link from your email.'
+  )
+  const [isSubmitting, setIsSubmitting] = useState(false)
+
+  // Unfollowing waits for a click so mail scanners opening the link can't trigger it.
+  const unsubscribe = async () => {
+    if (!token) return
+    setIsSubmitting(true)
+    try {
+      await apiClient.unsubscribeFollows(token)
+      setDone(true)
+    } catch (err) {
+      setError((err as Error).message)
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
+  if (error) {
+    return (
+      <div className="w-full max-w-md text-center space-y-3">
+        <p className="text-error-600 text-sm">{error}</p>
+        <a href="/startups" className="text-primary-600 hover:text-primary-700 font-medium text-sm">
+          Browse startups
+        </a>
+      </div>
+    )
+  }
+
+  return (
+    <div className="w-full max-w-md text-center space-y-4">
+      <h1 className="text-2xl font-bold text-secondary-900">Stop new-job emails</h1>
+      {done ? (
+        <p className="text-secondary-700">
+          You no longer follow any startups and won&apos;t get these emails again.
+        </p>
+      ) : (
+        <>
+          <p className="text-secondary-700">
+            This unfollows every startup you follow, so no more digests are sent.
+          </p>
+          <Button onClick={unsubscribe} isLoading={isSubmitting}>
+            Unfollow all
+          </Button>
+        </>
+      )}
+    </div>
+  )
+}
+
+export default function UnsubscribeFollowsPage() {
+  return (
+    <div className="min-h-screen flex flex-col">
+      <Header />
+      <main className="flex-1 flex items-center justify-center py-12 px-4">
+        <Suspense fallback={<p className="text-secondary-600 text-sm">Loading…</p>}>
+          <UnsubscribeContent />
+        </Suspense>
+      </main>
+      <Footer />
+    </div>
+  )
+}
@@ -0,0 +1,97 @@
+'use client'
+
+import { useEffect, useState, Suspense } from 'react'
+import { useSearchParams } from 'next/navigation'
+import { Header } from '@/presentation/components/layout/header'
+import { Footer } from '@/presentation/components/layout/footer'
+import { Button } from '@/presentation/components/ui/button'
+import { apiClient } from '@/infrastructure/api/api-client'
+import { JobActionResponse } from '@/application/dto/job.dto'
+
+const actionLabels: Record<JobActionResponse['action'], { confirm: string; done: string }> = {
+  extend: { confirm: 'Extend this listing by 30 days', done: 'The listing has been extended.' },
+  fill: { confirm: 'Mark this job as filled', done: 'The job is marked as filled.' },
+  close: { confirm: 'Close this listing', done: 'The listing has been closed.' },
+}
+
+function JobActionContent() {
+  const searchParams = useSearchParams()
+  const token = searchParams.get('token')
+  const [action, setAction] = useState<JobActionResponse | null>(null)
+  const [error, setError] = useState<string | null>(null)
+  const [isApplying, setIsApplying] = useState(false)
+
+  useEffect(() => {
+    if (!token) {
+      setError('This link is incomplete. Please use the link from your email.')
+      return
+    }
+    // Only preview on load: mail scanners follow links, so the change itself
+    // needs an explicit click.
+    apiClient
+      .previewJobAction(token)
+      .then((response) => setAction(response.data))
+      .catch((err: Error) => setError(err.message))
+  }, [token])
+
+  const apply = async () => {
+    if (!token) return
+    setIsApplying(true)
+    try {
+      const response = await apiClient.applyJobAction(token)
+      setAction(response.data)
+    } catch (err) {
+      setError((err as Error).message)
+    } finally {
+      setIsApplying(false)
+    }
+  }
+
+  if (error) {
+    return (
+      <div className="w-full max-w-md text-center space-y-3">
+        <p className="text-error-600 text-sm">{error}</p>
+        <a href="/dashboard" className="text-primary-600 hover:text-primary-700 font-medium text-sm">
+          Manage your jobs in the dashboard
+        </a>
+      </div>
+    )
+  }
+
+  if (!action) {
+    return <p className="text-secondary-600 text-sm">Checking your link…</p>
+  }
+
+  const labels = actionLabels[action.action]
+  return (
+    <div className="w-full max-w-md text-center space-y-4">
+      <h1 className="text-2xl font-bold text-secondary-900">{action.title}</h1>
+      {action.applied ? (
+        <p className="text-secondary-700">{labels.done}</p>
+      ) : (
+        <Button onClick={apply} isLoading={isApplying}>
+          {labels.confirm}
+        </Button>
+      )}
+      {action.expiresAt && (
+        <p className="text-secondary-600 text-sm">
+          Expires on {new Date(action.expiresAt).toLocaleDateString()}
+        </p>
+      )}
+    </div>
+  )
+}
+
+export default function JobActionPage() {
+  return (
+    <div className="min-h-screen flex flex-col">
+      <Header />
+      <main className="flex-1 flex items-center justify-center py-12 px-4">
+        <Suspense fallback={<p className="text-secondary-600 text-sm">Loading…</p>}>
+          <JobActionContent />
+        </Suspense>
+      </main>
+      <Footer />
+    </div>
+  )
+}
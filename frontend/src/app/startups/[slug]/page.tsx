@@ -8,7 +8,7 @@ import { apiClient } from '@/infrastructure/api/api-client'
 import { JobCard } from '@/presentation/components/job/job-card'
 import { Button } from '@/presentation/components/ui/button'
 import { Card, CardContent } from '@/presentation/components/ui/card'
-import { Building2, MapPin, Calendar, Users, Briefcase, ExternalLink } from 'lucide-react'
+import { Building2, MapPin, Calendar, Users, Briefcase, ExternalLink, BadgeCheck } from 'lucide-react'
 import { buildPageMetadata, truncateTitle } from '@/lib/seo'
 
 export const revalidate = 60
@@ -106,7 +106,14 @@ export default async function StartupDetailPage({ params }: StartupDetailPagePro
                 </div>
               )}
               <div className="flex-1">
-                <h1 className="text-4xl font-bold text-secondary-900 mb-2">{startup.name}</h1>
+                <h1 className="text-4xl font-bold text-secondary-900 mb-2 flex items-center gap-2">
+                  {startup.name}
+                  {startup.verified && (
+                    <span title="Website domain verified" className="inline-flex">
+                      <BadgeCheck className="h-7 w-7 text-primary-600" aria-label="Verified" />
+                    </span>
+                  )}
+                </h1>
                 <p className="text-lg text-secondary-600 mb-4">{startup.description}</p>
                 <div className="flex flex-wrap gap-4 text-sm text-secondary-600">
                   <div className="flex items-center">
@@ -54,3 +54,13 @@ export interface JobListFilters {
 
 export type JobResponse = Job
 
+// A signed one-click action link from a job reminder email.
+export interface JobActionResponse {
+  jobId: string
+  title: string
+  action: 'extend' | 'fill' | 'close'
+  status: JobStatus
+  expiresAt?: string
+  applied: boolean
+}
+
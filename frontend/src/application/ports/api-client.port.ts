@@ -1,5 +1,5 @@
 import { AuthResponse, LoginRequest, RegisterRequest } from '../dto/auth.dto'
-import { JobResponse, CreateJobRequest, UpdateJobRequest, JobListFilters } from '../dto/job.dto'
+import { JobResponse, CreateJobRequest, UpdateJobRequest, JobListFilters, JobActionResponse } from '../dto/job.dto'
 import { StartupResponse, CreateStartupRequest, UpdateStartupRequest, StartupListFilters } from '../dto/startup.dto'
 import { CreateContactRequest, ContactResponse } from '../dto/contact.dto'
 import { CreateCheckoutRequest, CheckoutResponse, BillingStatusResponse } from '../dto/billing.dto'
@@ -21,6 +21,8 @@ export interface IApiClient {
   createJob(data: CreateJobRequest): Promise<ApiResponse<JobResponse>>
   updateJob(data: UpdateJobRequest): Promise<ApiResponse<JobResponse>>
   deleteJob(id: string): Promise<void>
+  previewJobAction(token: string): Promise<ApiResponse<JobActionResponse>>
+  applyJobAction(token: string): Promise<ApiResponse<JobActionResponse>>
 
   // Startups
   listStartups(filters?: StartupListFilters): Promise<ApiResponse<StartupResponse[]>>
@@ -28,6 +30,7 @@ export interface IApiClient {
   getStartupBySlug(slug: string): Promise<ApiResponse<StartupResponse>>
   createStartup(data: CreateStartupRequest): Promise<ApiResponse<StartupResponse>>
   updateStartup(data: UpdateStartupRequest): Promise<ApiResponse<StartupResponse>>
+  unsubscribeFollows(token: string): Promise<ApiResponse<{ unfollowed: number }>>
 
   // Files
   uploadFile(file: File): Promise<ApiResponse<{ url: string; id: string }>>
@@ -15,6 +15,8 @@ export interface Startup {
   status: StartupStatus
   plan: StartupPlan
   planExpiresAt?: string
+  verified?: boolean
+  verifiedAt?: string
   createdAt: string
   updatedAt: string
 }
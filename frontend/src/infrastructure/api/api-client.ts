@@ -1,7 +1,7 @@
 import axios, { AxiosInstance, AxiosError } from 'axios'
 import { IApiClient } from '@/application/ports/api-client.port'
 import { AuthResponse, LoginRequest, RegisterRequest } from '@/application/dto/auth.dto'
-import { JobResponse, CreateJobRequest, UpdateJobRequest, JobListFilters } from '@/application/dto/job.dto'
+import { JobResponse, CreateJobRequest, UpdateJobRequest, JobListFilters, JobActionResponse } from '@/application/dto/job.dto'
 import { StartupResponse, CreateStartupRequest, UpdateStartupRequest, StartupListFilters } from '@/application/dto/startup.dto'
 import { CreateContactRequest, ContactResponse } from '@/application/dto/contact.dto'
 import { CreateCheckoutRequest, CheckoutResponse, BillingStatusResponse } from '@/application/dto/billing.dto'
@@ -42,6 +42,8 @@ export class ApiClient implements IApiClient {
       ...startup,
       plan: startup.plan || 'free',
       planExpiresAt: startup.plan_expires_at,
+      verified: startup.verified === true,
+      verifiedAt: startup.verified_at,
     }
   }
 
@@ -319,6 +321,42 @@ export class ApiClient implements IApiClient {
     })
   }
 
+  private transformJobActionData(action: any): JobActionResponse {
+    return {
+      jobId: action.job_id,
+      title: action.title,
+      action: action.action,
+      status: action.status,
+      expiresAt: action.expires_at,
+      applied: action.applied === true,
+    }
+  }
+
+  /** Check a one-click link from a reminder email without applying it. */
+  async previewJobAction(token: string): Promise<ApiResponse<JobActionResponse>> {
+    const response = await this.request<any>({
+      method: 'GET',
+      url: '/job-actions',
+      params: { token },
+    })
+    if (response.data) {
+      response.data = this.transformJobActionData(response.data)
+    }
+    return response as ApiResponse<JobActionResponse>
+  }
+
+  async applyJobAction(token: string): Promise<ApiResponse<JobActionResponse>> {
+    const response = await this.request<any>({
+      method: 'POST',
+      url: '/job-actions',
+      data: { token },
+    })
+    if (response.data) {
+      response.data = this.transformJobActionData(response.data)
+    }
+    return response as ApiResponse<JobActionResponse>
+  }
+
   // Startup methods
   async listStartups(filters?: StartupListFilters): Promise<ApiResponse<StartupResponse[]>> {
     const response = await this.request<any>({
@@ -392,6 +430,15 @@ export class ApiClient implements IApiClient {
     return response as ApiResponse<StartupResponse>
   }
 
+  /** Apply the unsubscribe link from a follow digest email. */
+  async unsubscribeFollows(token: string): Promise<ApiResponse<{ unfollowed: number }>> {
+    return this.request<{ unfollowed: number }>({
+      method: 'POST',
+      url: '/follows/unsubscribe',
+      data: { token },
+    })
+  }
+
   // File methods
   async uploadFile(file: File): Promise<ApiResponse<{ url: string; id: string }>> {
     const formData = new FormData()